@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ChaosBudgetSpec defines the limits a ChaosBudget puts on the experiments
+// targeting the namespaces it covers.
+type ChaosBudgetSpec struct {
+	// NamespaceSelector selects the target namespaces the budget covers. An
+	// empty or missing selector covers every namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// MaxPodKillsPerHour is the number of pods that pod-kill attacks may kill
+	// in the covered namespaces within any hour, across all experiments.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPodKillsPerHour *int32 `json:"maxPodKillsPerHour,omitempty"`
+
+	// MaxConcurrentExperimentsPerNamespace is the number of experiments that
+	// may be running against each covered namespace at the same time.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentExperimentsPerNamespace *int32 `json:"maxConcurrentExperimentsPerNamespace,omitempty"`
+
+	// TeamLabel is a label of the covered namespaces whose value names the
+	// team owning them, e.g. "team". MaxActiveExperiments and
+	// MaxAttacksPerHour then apply to each team, across the namespaces
+	// carrying the same value, instead of to each namespace. Namespaces
+	// without the label count on their own.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	TeamLabel string `json:"teamLabel,omitempty"`
+
+	// MaxActiveExperiments is the number of experiments that may be active
+	// against each covered namespace, or team, at the same time. Experiments
+	// are active from their first iteration until they finish; the first
+	// iteration of further experiments is deferred.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxActiveExperiments *int32 `json:"maxActiveExperiments,omitempty"`
+
+	// MaxAttacksPerHour is the number of attack iterations experiments may
+	// execute against each covered namespace, or team, within any hour.
+	// Further iterations are deferred.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAttacksPerHour *int32 `json:"maxAttacksPerHour,omitempty"`
+}
+
+// ChaosBudgetStatus defines the observed state of ChaosBudget.
+type ChaosBudgetStatus struct {
+	// PodKills records the pods killed in the covered namespaces within the
+	// last hour, to enforce MaxPodKillsPerHour.
+	// +listType=atomic
+	// +optional
+	PodKills []PodKillRecord `json:"podKills,omitempty"`
+
+	// Attacks records the attack iterations executed within the last hour,
+	// to enforce MaxAttacksPerHour.
+	// +listType=atomic
+	// +optional
+	Attacks []AttackRecord `json:"attacks,omitempty"`
+
+	// ActiveExperiments records the experiments that reserved a slot of
+	// MaxActiveExperiments before their first iteration. Slots of experiments
+	// that finished or were deleted are released by the next reservation.
+	// +listType=atomic
+	// +optional
+	ActiveExperiments []ActiveExperimentRecord `json:"activeExperiments,omitempty"`
+}
+
+// PodKillRecord records pods killed by an iteration of an experiment.
+type PodKillRecord struct {
+	// Experiment is the namespace/name of the experiment.
+	Experiment string `json:"experiment"`
+
+	// Count is the number of pods killed.
+	Count int32 `json:"count"`
+
+	// Time is when the pods were killed.
+	Time metav1.Time `json:"time"`
+}
+
+// AttackRecord records an attack iteration of an experiment.
+type AttackRecord struct {
+	// Scope is what the attack counts against: the target namespace, or
+	// "<teamLabel>=<team>" for the team owning it.
+	Scope string `json:"scope"`
+
+	// Experiment is the namespace/name of the experiment.
+	Experiment string `json:"experiment"`
+
+	// RunID identifies the iteration, which is charged once however often
+	// it is retried.
+	// +optional
+	RunID string `json:"runID,omitempty"`
+
+	// Time is when the attack was executed.
+	Time metav1.Time `json:"time"`
+}
+
+// ActiveExperimentRecord records an experiment holding a slot of
+// MaxActiveExperiments.
+type ActiveExperimentRecord struct {
+	// Scope is what the experiment counts against: the target namespace, or
+	// "<teamLabel>=<team>" for the team owning it.
+	Scope string `json:"scope"`
+
+	// Experiment is the namespace/name of the experiment.
+	Experiment string `json:"experiment"`
+
+	// UID is the UID of the experiment.
+	UID types.UID `json:"uid"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// ChaosBudget is the Schema for the chaosbudgets API. It limits how much
+// chaos experiments may cause in the namespaces it covers; iterations that
+// would exceed one of the budgets covering their target namespace are
+// deferred.
+type ChaosBudget struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ChaosBudget
+	// +required
+	Spec ChaosBudgetSpec `json:"spec"`
+
+	// status defines the observed state of ChaosBudget
+	// +optional
+	Status ChaosBudgetStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosBudgetList contains a list of ChaosBudget
+type ChaosBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ChaosBudget `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosBudget{}, &ChaosBudgetList{})
+}
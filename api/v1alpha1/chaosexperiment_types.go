@@ -17,46 +17,1199 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ChaosExperimentSpec defines the desired state of ChaosExperiment
+// +kubebuilder:validation:XValidation:rule="!has(self.schedule) || (has(self.mode) && self.mode == 'recurring')",message="schedule requires mode recurring"
+// +kubebuilder:validation:XValidation:rule="!has(self.timeZone) || has(self.schedule) || has(self.allowedWindows)",message="timeZone requires schedule or allowedWindows"
+// +kubebuilder:validation:XValidation:rule="!(has(self.startAfter) && has(self.startTime))",message="startAfter and startTime are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.templateRef) || (has(self.target) && has(self.attack))",message="target and attack are required unless templateRef is set"
+// +kubebuilder:validation:XValidation:rule="has(self.templateRef) || !has(self.mode) || self.mode != 'recurring' || has(self.interval) || has(self.schedule) || has(self.duration)",message="recurring experiments require interval or schedule"
+type ChaosExperimentSpec struct {
+	// TemplateRef instantiates a ChaosExperimentTemplate. When the experiment
+	// is created, the defaulting webhook fills in the fields of the spec left
+	// empty from the template, with the parameter values substituted.
+	// +optional
+	TemplateRef *ExperimentTemplateRef `json:"templateRef,omitempty"`
+
+	// Target defines the selection criteria for the chaos experiment.
+	// Required unless templateRef is set.
+	// +optional
+	Target ExperimentTarget `json:"target"`
+
+	// Attack defines the type of chaos attack to perform. Required unless
+	// templateRef is set.
+	// +optional
+	Attack ExperimentAttack `json:"attack"`
+
+	// Duration specifies how long the experiment should run, counted from its
+	// first iteration. Experiments without it complete after their first
+	// iteration if they are one-shot, and run until deleted if they recur.
+	// Recurring experiments that set neither Interval nor Schedule use it as
+	// their interval instead and run until deleted; this is deprecated.
+	// This is a string representation of a Go duration (e.g., "30s", "5m").
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Interval specifies how often a recurring experiment runs an iteration.
+	// Recurring experiments require it unless they set Schedule or, deprecated,
+	// only Duration.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Jitter randomly moves each iteration of a recurring experiment by up to
+	// this much in either direction, so that iterations do not always happen
+	// at the same instant relative to each other. It does not apply to
+	// scheduled experiments.
+	// +optional
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+
+	// Suspend halts further attack iterations while true, without deleting the
+	// experiment. Faults injected before are still reverted when due.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// DryRun runs the target selection of every iteration and records what
+	// would have been attacked, in the status and as events, without
+	// attacking anything.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Seed makes the random selection of target pods reproducible: given the
+	// same matching pods, every iteration picks the same pods in the same
+	// order as the iteration with the same number of any experiment with the
+	// same seed. Without it the operator picks a seed and records it in
+	// status.seed, from where it can be copied to replay a run.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
+	// RespectPDB makes pod-kill leave alone pods whose PodDisruptionBudgets
+	// allow no further disruptions. The iteration is skipped when all selected
+	// pods are covered by such budgets. Evictions always respect them.
+	// +optional
+	RespectPDB bool `json:"respectPDB,omitempty"`
+
+	// Safeguards bound how much damage the experiment may do.
+	// +optional
+	Safeguards *Safeguards `json:"safeguards,omitempty"`
+
+	// ServiceAccountName names a ServiceAccount in the namespace of the
+	// experiment that the operator impersonates to list the target pods and
+	// to kill, patch or delete the targets. Attacks run in helper pods, which
+	// the operator starts, require the ServiceAccount to be allowed to exec
+	// into the target pod. Faults are reverted with the permissions of the
+	// operator. The operator acts with its own permissions when it is not set.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// AbortConditions abort the experiment as soon as one of them fires,
+	// reverting its active faults. They are evaluated against the Prometheus
+	// instance the operator is configured with.
+	// +listType=atomic
+	// +optional
+	AbortConditions []AbortCondition `json:"abortConditions,omitempty"`
+
+	// Hypothesis describes the steady state of the system under test. It has
+	// to hold before every iteration and is verified again afterwards; the
+	// outcome is recorded in status.verdict.
+	// +optional
+	Hypothesis *Hypothesis `json:"hypothesis,omitempty"`
+
+	// MaxIterations completes a recurring experiment after this many attack
+	// iterations. Recurring experiments run until their duration ends or they
+	// are deleted when it is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxIterations *int32 `json:"maxIterations,omitempty"`
+
+	// HistoryLimit is the number of iterations kept in status.history.
+	// Defaults to 10; 0 disables the history.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+
+	// ResultsLimit is the number of ChaosResults kept for the experiment; the
+	// oldest are deleted beyond it. Defaults to 100; 0 stops the operator from
+	// creating ChaosResults.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ResultsLimit *int32 `json:"resultsLimit,omitempty"`
+
+	// Notifications configures where the experiment reports its lifecycle:
+	// when it starts, runs an attack, completes, fails, is aborted or is
+	// restarted.
+	// +optional
+	Notifications *Notifications `json:"notifications,omitempty"`
+
+	// ConcurrencyPolicy decides what happens when an iteration of a recurring
+	// experiment comes due while helper pods of the previous one, e.g. a long
+	// pod-pause, are still running. Iterations of attacks on a container, such
+	// as cpu-stress, last until their helper pod finished and do not overlap.
+	// Defaults to "Allow".
+	// +kubebuilder:default="Allow"
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// RetryPolicy decides how the operator retries after failing to act on
+	// the experiment, e.g. when an attack fails or a target cannot be found.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// FailurePolicy decides what happens when an iteration fails for a
+	// reason that retrying right away is unlikely to fix, such as the
+	// operator being denied access or the target namespace not existing:
+	// "Retry" retries it under spec.retryPolicy, "Wait" pauses the
+	// experiment and retries until the cause is fixed, and "Terminate" fails
+	// the experiment. Defaults to "Terminate" for one-shot experiments and to
+	// "Retry" for recurring ones. Transient failures, like timeouts of the
+	// API server, are always retried, and an invalid spec always fails the
+	// experiment.
+	// +kubebuilder:validation:Enum=Retry;Wait;Terminate
+	// +optional
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// OnNoTargets decides what an iteration does when the target matches no
+	// pods: "Fail" fails it like any other iteration, "Wait" keeps the
+	// experiment where it is with the WaitingForTargets condition and runs
+	// the iteration as soon as matching pods appear, and "Skip" skips it,
+	// which completes a one-shot experiment. Defaults to "Fail".
+	// +kubebuilder:validation:Enum=Fail;Wait;Skip
+	// +optional
+	OnNoTargets NoTargetsPolicy `json:"onNoTargets,omitempty"`
+
+	// TTLSecondsAfterFinished deletes the experiment this many seconds after it
+	// completed, was aborted or failed. Finished experiments are kept until
+	// deleted by hand when it is not set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// ActiveDeadlineSeconds bounds how long the experiment may run, counted
+	// from its first iteration, whatever its mode. Once it has passed, helper
+	// pods that are still running are stopped, every active fault is reverted
+	// and an experiment that has not finished yet completes with the
+	// DeadlineExceeded reason.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// StartAfter delays the first iteration until this long after the
+	// experiment was created.
+	// +optional
+	StartAfter *metav1.Duration `json:"startAfter,omitempty"`
+
+	// StartTime delays the first iteration until this point in time.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Mode specifies the execution mode of the experiment: "one-shot" or "recurring".
+	// Defaults to the mode configured for the operator, or "one-shot".
+	// +kubebuilder:validation:Enum=one-shot;recurring
+	// +optional
+	Mode ExperimentMode `json:"mode,omitempty"`
+
+	// Schedule is a cron expression, e.g. "0 10 * * 1-5", at which a recurring
+	// experiment runs its iterations, with the semantics of a CronJob
+	// schedule. It replaces Duration as the interval between iterations.
+	// Missed runs are not caught up on; only the most recent one is made up.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// TimeZone is the IANA name of the time zone Schedule and AllowedWindows
+	// are interpreted in, e.g. "Europe/Berlin". Defaults to UTC.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// AllowedWindows restricts attack iterations to the given time windows.
+	// Iterations that come due outside of them are deferred until the next
+	// window opens. Iterations may run at any time when it is empty.
+	// +listType=atomic
+	// +optional
+	AllowedWindows []TimeWindow `json:"allowedWindows,omitempty"`
+}
+
+// Safeguards bound how much damage an experiment may do over time.
+type Safeguards struct {
+	// MaxAffectedPercentage is the percentage of the target pool, rounded
+	// down, that the experiment may affect within Window. Iterations pick
+	// fewer pods once it is reached and are skipped when none may be picked.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxAffectedPercentage *int32 `json:"maxAffectedPercentage,omitempty"`
+
+	// Window is the rolling window MaxAffectedPercentage applies to.
+	// Defaults to one hour.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// FailurePolicy decides what happens to an experiment after a failure that
+// retrying right away is unlikely to fix.
+type FailurePolicy string
+
+const (
+	// RetryOnFailure retries the failed iteration after the backoff of
+	// spec.retryPolicy, until spec.retryPolicy.maxFailures is reached.
+	RetryOnFailure FailurePolicy = "Retry"
+	// WaitOnFailure pauses the experiment with the reason of the failure and
+	// retries after the backoff until an iteration succeeds.
+	WaitOnFailure FailurePolicy = "Wait"
+	// TerminateOnFailure fails the experiment.
+	TerminateOnFailure FailurePolicy = "Terminate"
+)
+
+// NoTargetsPolicy is what an iteration does when the target matches no pods.
+type NoTargetsPolicy string
+
+const (
+	// FailOnNoTargets fails the iteration.
+	FailOnNoTargets NoTargetsPolicy = "Fail"
+	// WaitForTargets waits for matching pods to appear.
+	WaitForTargets NoTargetsPolicy = "Wait"
+	// SkipOnNoTargets skips the iteration.
+	SkipOnNoTargets NoTargetsPolicy = "Skip"
+)
+
+// RetryPolicy backs off exponentially between retries of failed attempts.
+type RetryPolicy struct {
+	// BackoffBase is the delay before the first retry. It doubles with every
+	// consecutive failure. Defaults to 30s.
+	// +optional
+	BackoffBase *metav1.Duration `json:"backoffBase,omitempty"`
+
+	// BackoffCap bounds the delay between retries. Defaults to 10m.
+	// +optional
+	BackoffCap *metav1.Duration `json:"backoffCap,omitempty"`
+
+	// MaxFailures fails the experiment for good after this many consecutive
+	// failures. Failed attempts are retried until one succeeds when it is
+	// not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxFailures *int32 `json:"maxFailures,omitempty"`
+}
+
+// AbortCondition is a Prometheus signal that aborts an experiment when it
+// fires. Exactly one of Alert and Query must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.alert) != has(self.query)",message="exactly one of alert and query must be set"
+type AbortCondition struct {
+	// Alert is the name of a Prometheus alert. The condition fires while the
+	// alert is firing.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Alert string `json:"alert,omitempty"`
+
+	// Query is a PromQL expression. The condition fires while it returns any
+	// series, like the expression of an alerting rule.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Query string `json:"query,omitempty"`
+}
+
+// Hypothesis is the steady state an experiment expects the system under test
+// to keep despite the chaos.
+type Hypothesis struct {
+	// Probes are the checks that make up the steady state. All of them have
+	// to pass for the hypothesis to hold.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Probes []Probe `json:"probes"`
+
+	// Delay is how long after an iteration the probes are run again, so that
+	// the attack has taken effect. Defaults to 30s.
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+
+	// RecoveryObjective is how soon after an attack the steady state should
+	// hold again. Recovering slower lowers status.resilience.score. Defaults
+	// to 5m.
+	// +optional
+	RecoveryObjective *metav1.Duration `json:"recoveryObjective,omitempty"`
+}
+
+// ProbePhase is when a probe runs relative to an attack iteration.
+// +kubebuilder:validation:Enum=Pre;During;Post
+type ProbePhase string
+
+const (
+	// ProbePre runs the probe before the attack. If it fails, the experiment
+	// fails without injecting chaos.
+	ProbePre ProbePhase = "Pre"
+	// ProbeDuring runs the probe right after the attack was executed, while
+	// its faults are in effect.
+	ProbeDuring ProbePhase = "During"
+	// ProbePost runs the probe once spec.hypothesis.delay has passed after
+	// the attack.
+	ProbePost ProbePhase = "Post"
+)
+
+// Probe is a single steady-state check. Exactly one of HTTP, PromQL, Command
+// and Resource must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.http), has(self.promql), has(self.command), has(self.resource)].filter(x, x).size() == 1",message="exactly one of http, promql, command and resource must be set"
+type Probe struct {
+	// Name identifies the probe in events and the status.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// When lists the phases of each iteration the probe runs in. The
+	// verdict of an iteration fails if a During or Post probe fails.
+	// Defaults to Pre and Post.
+	// +listType=set
+	// +optional
+	When []ProbePhase `json:"when,omitempty"`
+
+	// HTTP passes when a GET request returns the expected status code.
+	// +optional
+	HTTP *HTTPProbe `json:"http,omitempty"`
+
+	// PromQL passes when a query against the Prometheus instance the operator
+	// is configured with returns any series.
+	// +optional
+	PromQL *PromQLProbe `json:"promql,omitempty"`
+
+	// Command passes when a command run in a probe pod in the namespace of
+	// the experiment exits with status 0.
+	// +optional
+	Command *CommandProbe `json:"command,omitempty"`
+
+	// Resource passes when all replicas of a workload in the target namespace
+	// are ready.
+	// +optional
+	Resource *WorkloadReference `json:"resource,omitempty"`
+}
+
+// HTTPProbe checks an HTTP endpoint.
+type HTTPProbe struct {
+	// URL is the endpoint the GET request is sent to.
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// ExpectedStatus is the status code the endpoint has to return.
+	// Defaults to 200.
+	// +kubebuilder:default=200
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	// +optional
+	ExpectedStatus int32 `json:"expectedStatus,omitempty"`
+
+	// Timeout bounds the request. Defaults to 5s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// PromQLProbe checks a PromQL expression.
+type PromQLProbe struct {
+	// Query is the PromQL expression, e.g. "sum(rate(http_requests_total[1m])) > 10".
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+}
+
+// CommandProbe runs a command in a pod.
+type CommandProbe struct {
+	// Image of the probe pod.
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// Command is run in the probe pod, e.g. ["curl", "-f", "http://web/healthz"].
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Command []string `json:"command"`
+
+	// Timeout bounds the probe pod. Defaults to 60s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ConcurrencyPolicy is how overlapping iterations of a recurring experiment
+// are handled, with the semantics of the CronJob field of the same name.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent runs the new iteration next to the previous one.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+	// ForbidConcurrent skips the new iteration.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+	// ReplaceConcurrent stops the previous iteration and runs the new one.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
 )
 
-// ChaosExperimentSpec defines the desired state of ChaosExperiment
-type ChaosExperimentSpec struct {
-	// Target defines the selection criteria for the chaos experiment.
-	Target ExperimentTarget `json:"target"`
+// TimeWindow is a daily time range on selected weekdays.
+type TimeWindow struct {
+	// Days are the weekdays the window opens on. Defaults to every day.
+	// +listType=set
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day the window opens, as HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day the window closes, as HH:MM. A window whose end
+	// is not after its start closes on the next day.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+// ExperimentTarget defines the target for the chaos experiment.
+// +kubebuilder:validation:XValidation:rule="[has(self.labelSelector), has(self.selector), has(self.workload)].filter(x, x).size() == 1",message="exactly one of labelSelector, selector and workload must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.role) || has(self.leaderElection)",message="role requires leaderElection"
+type ExperimentTarget struct {
+	// Namespace is the target Kubernetes namespace.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// LabelSelector is a map of key-value pairs used to select target pods.
+	// Deprecated: use Selector, which also supports matchExpressions.
+	// +kubebuilder:validation:MinProperties=1
+	// +optional
+	LabelSelector map[string]string `json:"labelSelector,omitempty"`
+
+	// Selector selects the target pods.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Workload selects the pods managed by a workload. Its pod selector is
+	// resolved on every iteration, so the experiment keeps tracking the
+	// workload when its labels change.
+	// +optional
+	Workload *WorkloadReference `json:"workload,omitempty"`
+
+	// ExcludeLabelSelector leaves pods it matches out of the selection, even
+	// if they match the main selector.
+	// +optional
+	ExcludeLabelSelector *metav1.LabelSelector `json:"excludeLabelSelector,omitempty"`
+
+	// NodeSelector restricts the selection to pods running on nodes it
+	// matches, e.g. a single zone or node pool. Node-level attacks pick their
+	// victims from these nodes.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// Percentage of the matching pods affected in each iteration, rounded up
+	// to at least one pod. Attacks that support it pick a single random pod
+	// when it is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty"`
+
+	// OwnerKind limits the selection to pods whose top-level controller is of
+	// this kind, so that e.g. the pods of a migration Job are skipped even if
+	// they carry the same labels as those of a Deployment. Pods created by a
+	// Deployment count as owned by the Deployment, not its ReplicaSet; None
+	// selects bare pods without a controller.
+	// +kubebuilder:validation:Enum=Deployment;ReplicaSet;StatefulSet;DaemonSet;Job;None
+	// +optional
+	OwnerKind string `json:"ownerKind,omitempty"`
+
+	// FieldSelector further limits the selection by pod fields, e.g.
+	// "spec.nodeName=node-3,status.phase=Running". It supports the fields the
+	// API server supports for pods, except metadata.namespace.
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// PodConditions limits the selection to pods that meet all of the listed
+	// conditions, e.g. [Running, Ready] to skip pods that are starting up or
+	// terminating. Pods are selected regardless of their state when it is empty.
+	// +kubebuilder:validation:XValidation:rule="!(self.exists(c, c == 'Ready') && self.exists(c, c == 'NotReady'))",message="Ready and NotReady are mutually exclusive"
+	// +listType=set
+	// +optional
+	PodConditions []TargetPodCondition `json:"podConditions,omitempty"`
+
+	// Role limits the selection to the current leader or to its followers,
+	// as identified through LeaderElection.
+	// +kubebuilder:validation:Enum=leader;follower
+	// +optional
+	Role TargetRole `json:"role,omitempty"`
+
+	// LeaderElection tells how to find the leader among the matching pods.
+	// +optional
+	LeaderElection *LeaderElection `json:"leaderElection,omitempty"`
+
+	// SelectionStrategy decides which of the matching pods are picked.
+	// Defaults to the strategy configured for the operator, or "random".
+	// +kubebuilder:validation:Enum=random;oldest;newest;round-robin
+	// +optional
+	SelectionStrategy SelectionStrategy `json:"selectionStrategy,omitempty"`
+}
+
+// TargetRole is the role in leader election a target pod must have.
+type TargetRole string
+
+const (
+	// LeaderRole selects the pod holding the leadership.
+	LeaderRole TargetRole = "leader"
+	// FollowerRole selects the pods not holding the leadership.
+	FollowerRole TargetRole = "follower"
+)
+
+// LeaderElection identifies the leader of a replicated application, either
+// through its leader-election Lease or through an annotation on the pods.
+// +kubebuilder:validation:XValidation:rule="has(self.leaseName) != has(self.annotation)",message="exactly one of leaseName and annotation must be set"
+type LeaderElection struct {
+	// LeaseName is the name of the coordination.k8s.io Lease in the target
+	// namespace whose holder is the leader. The holder identity must be the
+	// pod name, optionally followed by an underscore and a unique suffix as
+	// client-go writes it.
+	// +optional
+	LeaseName string `json:"leaseName,omitempty"`
+
+	// Annotation is the key of the pod annotation that marks the leader.
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+
+	// AnnotationValue is the value of Annotation on the leader.
+	// Defaults to "true".
+	// +optional
+	AnnotationValue string `json:"annotationValue,omitempty"`
+}
+
+// TargetPodCondition is a condition a pod must meet to be selected.
+// +kubebuilder:validation:Enum=Running;Ready;NotReady
+type TargetPodCondition string
+
+const (
+	// PodRunning matches pods in the Running phase that are not terminating.
+	PodRunning TargetPodCondition = "Running"
+	// PodReady matches pods whose Ready condition is true.
+	PodReady TargetPodCondition = "Ready"
+	// PodNotReady matches pods whose Ready condition is not true.
+	PodNotReady TargetPodCondition = "NotReady"
+)
+
+// SelectionStrategy is how the target pods of an iteration are picked.
+type SelectionStrategy string
+
+const (
+	// SelectRandom picks pods uniformly at random.
+	SelectRandom SelectionStrategy = "random"
+	// SelectOldest picks the pods that were created first.
+	SelectOldest SelectionStrategy = "oldest"
+	// SelectNewest picks the pods that were created last.
+	SelectNewest SelectionStrategy = "newest"
+	// SelectRoundRobin rotates through the pods in name order, continuing
+	// after the pod recorded in status.lastSelectedPod.
+	SelectRoundRobin SelectionStrategy = "round-robin"
+)
+
+// WorkloadReference identifies a workload in the target namespace.
+type WorkloadReference struct {
+	// Kind is the kind of the workload.
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet;DaemonSet
+	Kind string `json:"kind"`
+
+	// Name is the name of the workload.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// PodSelector returns the selector for the target pods, converting the
+// deprecated LabelSelector map when Selector is not set. It must not be used
+// for workload targets, whose selector has to be looked up.
+func (t *ExperimentTarget) PodSelector() (labels.Selector, error) {
+	if t.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(t.Selector)
+		if err != nil {
+			return nil, err
+		}
+		if selector.Empty() {
+			return nil, fmt.Errorf("target selector must not be empty")
+		}
+		return selector, nil
+	}
+	if len(t.LabelSelector) == 0 {
+		return nil, fmt.Errorf("one of target labelSelector, selector and workload must be set")
+	}
+	return labels.SelectorFromSet(t.LabelSelector), nil
+}
+
+// ExcludeSelector returns the selector for pods that must be left out of the
+// selection, or nil if ExcludeLabelSelector is not set.
+func (t *ExperimentTarget) ExcludeSelector() (labels.Selector, error) {
+	if t.ExcludeLabelSelector == nil {
+		return nil, nil
+	}
+	return metav1.LabelSelectorAsSelector(t.ExcludeLabelSelector)
+}
+
+// TargetNodeSelector returns the selector for the nodes target pods must run
+// on, or nil if NodeSelector is not set.
+func (t *ExperimentTarget) TargetNodeSelector() (labels.Selector, error) {
+	if t.NodeSelector == nil {
+		return nil, nil
+	}
+	return metav1.LabelSelectorAsSelector(t.NodeSelector)
+}
+
+// ExperimentAttack defines the type of attack.
+type ExperimentAttack struct {
+	// Type of attack to perform.
+	// +kubebuilder:validation:Enum=pod-kill;container-kill;cpu-stress;memory-stress;network-partition;io-stress;node-taint;kubelet-chaos;time-skew;grpc-fault;process-kill;pod-pause;scale-chaos;image-pull-failure;config-chaos;service-blackhole;cert-expiry;network-chaos
+	Type AttackType `json:"type"`
+
+	// PodKill configures the pod-kill attack.
+	// +optional
+	PodKill *PodKillAttackSpec `json:"podKill,omitempty"`
+
+	// ContainerKill configures the container-kill attack.
+	// +optional
+	ContainerKill *ContainerKillAttackSpec `json:"containerKill,omitempty"`
+
+	// CPUStress configures the cpu-stress attack.
+	// +optional
+	CPUStress *CPUStressAttackSpec `json:"cpuStress,omitempty"`
+
+	// MemoryStress configures the memory-stress attack.
+	// +optional
+	MemoryStress *MemoryStressAttackSpec `json:"memoryStress,omitempty"`
+
+	// Partition configures the network-partition attack.
+	// +optional
+	Partition *NetworkPartitionAttackSpec `json:"partition,omitempty"`
+
+	// IOStress configures the io-stress attack.
+	// +optional
+	IOStress *IOStressAttackSpec `json:"ioStress,omitempty"`
+
+	// NodeTaint configures the node-taint attack.
+	// +optional
+	NodeTaint *NodeTaintAttackSpec `json:"nodeTaint,omitempty"`
+
+	// KubeletChaos configures the kubelet-chaos attack.
+	// +optional
+	KubeletChaos *KubeletChaosAttackSpec `json:"kubeletChaos,omitempty"`
+
+	// TimeSkew configures the time-skew attack.
+	// +optional
+	TimeSkew *TimeSkewAttackSpec `json:"timeSkew,omitempty"`
+
+	// GRPCFault configures the grpc-fault attack.
+	// +optional
+	GRPCFault *GRPCFaultAttackSpec `json:"grpcFault,omitempty"`
+
+	// ProcessKill configures the process-kill attack.
+	// +optional
+	ProcessKill *ProcessKillAttackSpec `json:"processKill,omitempty"`
+
+	// PodPause configures the pod-pause attack.
+	// +optional
+	PodPause *PodPauseAttackSpec `json:"podPause,omitempty"`
+
+	// ScaleChaos configures the scale-chaos attack.
+	// +optional
+	ScaleChaos *ScaleChaosAttackSpec `json:"scaleChaos,omitempty"`
+
+	// ImagePullFailure configures the image-pull-failure attack.
+	// +optional
+	ImagePullFailure *ImagePullFailureAttackSpec `json:"imagePullFailure,omitempty"`
+
+	// ConfigChaos configures the config-chaos attack.
+	// +optional
+	ConfigChaos *ConfigChaosAttackSpec `json:"configChaos,omitempty"`
+
+	// ServiceBlackhole configures the service-blackhole attack.
+	// +optional
+	ServiceBlackhole *ServiceBlackholeAttackSpec `json:"serviceBlackhole,omitempty"`
+
+	// CertExpiry configures the cert-expiry attack.
+	// +optional
+	CertExpiry *CertExpiryAttackSpec `json:"certExpiry,omitempty"`
+
+	// NetworkChaos configures the network-chaos attack.
+	// +optional
+	NetworkChaos *NetworkChaosAttackSpec `json:"networkChaos,omitempty"`
+}
+
+// PodKillAttackSpec defines the parameters of the pod-kill attack.
+type PodKillAttackSpec struct {
+	// DeletionMethod is how the target pod is removed: "delete" deletes it
+	// directly, "evict" goes through the Eviction API so PodDisruptionBudgets
+	// are respected. Defaults to "delete".
+	// +kubebuilder:default=delete
+	// +kubebuilder:validation:Enum=delete;evict
+	// +optional
+	DeletionMethod DeletionMethod `json:"deletionMethod,omitempty"`
+
+	// Count is the number of pods killed in each iteration. It takes
+	// precedence over target.percentage; fewer pods are killed when fewer match.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Count *int32 `json:"count,omitempty"`
+
+	// GracePeriodSeconds is the termination grace period the pod is given.
+	// Zero kills it immediately. Defaults to the grace period of the pod.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// Force kills the pods with a zero grace period, overriding
+	// GracePeriodSeconds, and then strips the finalizers of any pod that is
+	// still terminating, so that pods held back by finalizers are removed too.
+	// +optional
+	Force bool `json:"force,omitempty"`
+}
+
+// DeletionMethod is how the pod-kill attack removes a pod.
+type DeletionMethod string
+
+const (
+	// DeletePod deletes the pod directly.
+	DeletePod DeletionMethod = "delete"
+	// EvictPod evicts the pod through the Eviction API.
+	EvictPod DeletionMethod = "evict"
+)
+
+// ContainerKillAttackSpec defines the parameters of the container-kill attack.
+type ContainerKillAttackSpec struct {
+	// ContainerName is the name of the container to kill inside the target pod.
+	// Defaults to the first container of the pod.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+}
+
+// ProcessKillAttackSpec defines the parameters of the process-kill attack.
+// At most one of ProcessName and Pattern may be set; when neither is, the
+// signal is sent to PID 1 of the container.
+type ProcessKillAttackSpec struct {
+	// ContainerName is the name of the container whose processes are signalled.
+	// Defaults to the first container of the pod.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// ProcessName matches processes by their exact name as shown in
+	// /proc/<pid>/comm, which the kernel truncates to 15 characters.
+	// +optional
+	ProcessName string `json:"processName,omitempty"`
+
+	// Pattern matches processes whose full command line matches this
+	// extended regular expression.
+	// +optional
+	Pattern string `json:"pattern,omitempty"`
+
+	// Signal is the signal sent to the matched processes.
+	// +kubebuilder:default=SIGKILL
+	// +kubebuilder:validation:Enum=SIGKILL;SIGTERM;SIGINT;SIGHUP;SIGQUIT;SIGUSR1;SIGUSR2;SIGSTOP;SIGCONT
+	// +optional
+	Signal string `json:"signal,omitempty"`
+}
+
+// PodPauseAttackSpec defines the parameters of the pod-pause attack.
+type PodPauseAttackSpec struct {
+	// Method is how the processes of the pod are paused: "freeze" uses the
+	// cgroup freezer, "sigstop" sends SIGSTOP and later SIGCONT. Defaults to "freeze".
+	// +kubebuilder:default=freeze
+	// +kubebuilder:validation:Enum=freeze;sigstop
+	// +optional
+	Method PauseMethod `json:"method,omitempty"`
+
+	// Duration specifies how long the pod stays paused in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// PauseMethod is how the pod-pause attack pauses processes.
+type PauseMethod string
+
+const (
+	// FreezePause freezes the container cgroups.
+	FreezePause PauseMethod = "freeze"
+	// SigstopPause sends SIGSTOP to every process.
+	SigstopPause PauseMethod = "sigstop"
+)
+
+// CPUStressAttackSpec defines the parameters of the cpu-stress attack.
+type CPUStressAttackSpec struct {
+	// ContainerName is the name of the container whose cgroup the stressor joins.
+	// Defaults to the first container of the pod.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// Load is the CPU load, in percent, each worker tries to generate.
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Load int32 `json:"load,omitempty"`
+
+	// Workers is the number of stressor processes to start.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Workers int32 `json:"workers,omitempty"`
+
+	// Duration specifies how long the stressor runs in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// MemoryStressAttackSpec defines the parameters of the memory-stress attack.
+type MemoryStressAttackSpec struct {
+	// ContainerName is the name of the container whose cgroup the stressor joins.
+	// Defaults to the first container of the pod.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// Size is the amount of memory to allocate and keep resident, e.g. "512Mi".
+	Size resource.Quantity `json:"size"`
+
+	// Duration specifies how long the memory is held in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// IOStressAttackSpec defines the parameters of the io-stress attack.
+type IOStressAttackSpec struct {
+	// ContainerName is the name of the container whose filesystem and cgroup are stressed.
+	// Defaults to the first container of the pod.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// Path is the directory inside the target container the load is generated in,
+	// typically the mount point of the volume under test. Defaults to "/tmp".
+	// +kubebuilder:default="/tmp"
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Workers is the number of parallel jobs generating load.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Workers int32 `json:"workers,omitempty"`
+
+	// Size is the size of the file each worker reads and writes. Defaults to "256Mi".
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// IOPS caps the number of I/O operations per second of each worker.
+	// Unlimited when not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IOPS *int32 `json:"iops,omitempty"`
+
+	// Bandwidth caps the bytes per second each worker reads and writes, e.g. "50Mi".
+	// Unlimited when not set.
+	// +optional
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
+
+	// Duration specifies how long the load is generated in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// NodeTaintAttackSpec defines the parameters of the node-taint attack.
+type NodeTaintAttackSpec struct {
+	// Key is the taint key. Defaults to "chaos.shanto.dev/node-taint".
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Value is the taint value.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect is the taint effect. NoExecute evicts pods that do not tolerate the taint.
+	// +kubebuilder:default=NoSchedule
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	// +optional
+	Effect corev1.TaintEffect `json:"effect,omitempty"`
+
+	// Cordon additionally marks the node unschedulable, like "kubectl cordon".
+	// +optional
+	Cordon bool `json:"cordon,omitempty"`
+
+	// Duration specifies how long the node stays tainted in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// KubeletChaosAttackSpec defines the parameters of the kubelet-chaos attack.
+type KubeletChaosAttackSpec struct {
+	// Action is what is done to the kubelet: "stop" stops it for the duration
+	// and starts it again afterwards, "restart" restarts it once.
+	// +kubebuilder:default=stop
+	// +kubebuilder:validation:Enum=stop;restart
+	// +optional
+	Action KubeletAction `json:"action,omitempty"`
+
+	// Duration specifies how long the kubelet stays stopped in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// Ignored for the restart action.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// KubeletAction is the action the kubelet-chaos attack performs on the kubelet.
+type KubeletAction string
+
+const (
+	// KubeletStop stops the kubelet for a bounded window.
+	KubeletStop KubeletAction = "stop"
+	// KubeletRestart restarts the kubelet once.
+	KubeletRestart KubeletAction = "restart"
+)
+
+// TimeSkewAttackSpec defines the parameters of the time-skew attack.
+type TimeSkewAttackSpec struct {
+	// ContainerName is the name of the container whose clock is shifted.
+	// Defaults to the first container of the pod.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// Offset is added to the wall clock of the target processes, e.g. "5m" or "-1h".
+	Offset metav1.Duration `json:"offset"`
+
+	// Duration specifies how long the clock stays shifted in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// GRPCFaultAttackSpec defines the parameters of the grpc-fault attack.
+type GRPCFaultAttackSpec struct {
+	// Host is the service host clients use to reach the target pods, e.g.
+	// "orders" or "orders.demo.svc.cluster.local". Short names are resolved in
+	// the target namespace.
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Rules select the calls to fault and what to do with them. The first
+	// matching rule wins.
+	// +kubebuilder:validation:MinItems=1
+	Rules []GRPCFaultRule `json:"rules"`
+
+	// Duration specifies how long the faults are injected in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// GRPCFaultRule injects a status code and/or a delay into matching gRPC calls.
+type GRPCFaultRule struct {
+	// Service is the fully qualified gRPC service, e.g. "orders.v1.OrderService".
+	// Matches every service when empty.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// Method is the gRPC method within Service, e.g. "GetOrder".
+	// Matches every method of the service when empty.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Code is the gRPC status code returned instead of forwarding the call.
+	// +kubebuilder:validation:Enum=CANCELLED;UNKNOWN;INVALID_ARGUMENT;DEADLINE_EXCEEDED;NOT_FOUND;ALREADY_EXISTS;PERMISSION_DENIED;RESOURCE_EXHAUSTED;FAILED_PRECONDITION;ABORTED;OUT_OF_RANGE;UNIMPLEMENTED;INTERNAL;UNAVAILABLE;DATA_LOSS;UNAUTHENTICATED
+	// +optional
+	Code string `json:"code,omitempty"`
+
+	// Delay is added to matching calls before they are forwarded or aborted.
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+
+	// Percentage of matching calls the rule applies to.
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage int32 `json:"percentage,omitempty"`
+}
+
+// ScaleChaosAttackSpec defines the parameters of the scale-chaos attack.
+type ScaleChaosAttackSpec struct {
+	// Kind is the kind of the workload named by Name. Defaults to Deployment.
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the workload in the target namespace. Defaults to
+	// the workload that owns a randomly picked target pod.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ScaleDownBy is the number of replicas to remove. The workload is scaled
+	// to zero when it is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ScaleDownBy *int32 `json:"scaleDownBy,omitempty"`
+
+	// Duration specifies how long the workload stays scaled down in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
 
-	// Attack defines the type of chaos attack to perform.
-	Attack ExperimentAttack `json:"attack"`
+// ImagePullFailureAttackSpec defines the parameters of the image-pull-failure attack.
+type ImagePullFailureAttackSpec struct {
+	// Kind is the kind of the workload named by Name. Defaults to Deployment.
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet
+	// +optional
+	Kind string `json:"kind,omitempty"`
 
-	// Duration specifies how long the experiment should run.
-	// This is a string representation of a Go duration (e.g., "30s", "5m").
+	// Name is the name of the workload in the target namespace. Defaults to
+	// the workload that owns a randomly picked target pod.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ContainerName is the name of the container whose image is replaced.
+	// Defaults to the first container of the pod template.
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+
+	// Image is the unpullable image to roll out. Defaults to the original
+	// image with its tag replaced by "chaos-image-pull-failure".
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Duration specifies how long the broken image stays in place in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
 	// +optional
 	Duration *metav1.Duration `json:"duration,omitempty"`
+}
 
-	// Mode specifies the execution mode of the experiment: "one-shot" or "recurring".
-	// Defaults to "one-shot".
-	// +kubebuilder:default="one-shot"
-	// +kubebuilder:validation:Enum=one-shot;recurring
+// ConfigChaosAttackSpec defines the parameters of the config-chaos attack.
+type ConfigChaosAttackSpec struct {
+	// Kind is the kind of the object to remove.
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	Kind string `json:"kind"`
+
+	// Name is the name of the object in the target namespace.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Action is what happens to the object: "delete" deletes it, "rename"
+	// moves it to "<name>-chaos-renamed". Defaults to "delete".
+	// +kubebuilder:default=delete
+	// +kubebuilder:validation:Enum=delete;rename
 	// +optional
-	Mode ExperimentMode `json:"mode,omitempty"`
+	Action ConfigChaosAction `json:"action,omitempty"`
+
+	// Duration specifies how long the object stays missing in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
-// ExperimentTarget defines the target for the chaos experiment.
-type ExperimentTarget struct {
-	// Namespace is the target Kubernetes namespace.
+// ConfigChaosAction is what the config-chaos attack does to the object.
+type ConfigChaosAction string
+
+const (
+	// ConfigDelete deletes the object.
+	ConfigDelete ConfigChaosAction = "delete"
+	// ConfigRename moves the object to a different name.
+	ConfigRename ConfigChaosAction = "rename"
+)
+
+// ServiceBlackholeAttackSpec defines the parameters of the service-blackhole attack.
+type ServiceBlackholeAttackSpec struct {
+	// ServiceName is the name of the Service in the target namespace.
 	// +kubebuilder:validation:MinLength=1
-	Namespace string `json:"namespace"`
+	ServiceName string `json:"serviceName"`
 
-	// LabelSelector is a map of key-value pairs used to select target pods.
-	// +kubebuilder:validation:MinProperties=1
-	LabelSelector map[string]string `json:"labelSelector"`
+	// Duration specifies how long the Service has no endpoints in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
-// ExperimentAttack defines the type of attack.
-type ExperimentAttack struct {
-	// Type of attack to perform. Currently, only "pod-kill" is supported.
-	// +kubebuilder:validation:Enum=pod-kill
-	Type AttackType `json:"type"`
+// CertExpiryAttackSpec defines the parameters of the cert-expiry attack.
+type CertExpiryAttackSpec struct {
+	// SecretName is the name of the kubernetes.io/tls Secret in the target namespace.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+
+	// ValidFor is how long the replacement certificate is valid for. When it
+	// is not set, the replacement certificate has already expired.
+	// +optional
+	ValidFor *metav1.Duration `json:"validFor,omitempty"`
+
+	// Duration specifies how long the replacement certificate stays in place in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// NetworkChaosAttackSpec defines the parameters of the network-chaos attack.
+// Percentages apply to packets leaving the target pods; at least one effect
+// must be set.
+type NetworkChaosAttackSpec struct {
+	// Interface is the network interface inside the target pods to degrade.
+	// +kubebuilder:default=eth0
+	// +optional
+	Interface string `json:"interface,omitempty"`
+
+	// Latency is added to every outgoing packet.
+	// +optional
+	Latency *metav1.Duration `json:"latency,omitempty"`
+
+	// Jitter varies the added latency by up to this much in either direction.
+	// +optional
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+
+	// Loss is the percentage of packets dropped.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Loss int32 `json:"loss,omitempty"`
+
+	// Corrupt is the percentage of packets with a random bit error.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Corrupt int32 `json:"corrupt,omitempty"`
+
+	// Duplicate is the percentage of packets sent twice.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Duplicate int32 `json:"duplicate,omitempty"`
+
+	// Reorder is the percentage of packets sent immediately, ahead of the
+	// delayed ones. Requires Latency.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Reorder int32 `json:"reorder,omitempty"`
+
+	// Duration specifies how long the network is degraded in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// NetworkPartitionAttackSpec defines the parameters of the network-partition attack.
+type NetworkPartitionAttackSpec struct {
+	// PeerSelector selects the pods the target pods are cut off from.
+	// Traffic is dropped in both directions between every target and every peer.
+	PeerSelector metav1.LabelSelector `json:"peerSelector"`
+
+	// PeerNamespace is the namespace of the peer pods.
+	// Defaults to the target namespace.
+	// +optional
+	PeerNamespace string `json:"peerNamespace,omitempty"`
+
+	// Duration specifies how long the partition lasts in each iteration.
+	// Defaults to the experiment duration, or one minute when that is not set.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
 // AttackType represents the type of chaos attack.
@@ -65,6 +1218,57 @@ type AttackType string
 const (
 	// PodKillAttack represents the pod-kill chaos attack.
 	PodKillAttack AttackType = "pod-kill"
+	// ContainerKillAttack represents the container-kill chaos attack, which
+	// SIGKILLs a single container and leaves the rest of the pod running.
+	ContainerKillAttack AttackType = "container-kill"
+	// CPUStressAttack represents the cpu-stress chaos attack, which burns CPU
+	// inside the cgroup of a target container.
+	CPUStressAttack AttackType = "cpu-stress"
+	// MemoryStressAttack represents the memory-stress chaos attack, which
+	// allocates memory inside the cgroup of a target container.
+	MemoryStressAttack AttackType = "memory-stress"
+	// NetworkPartitionAttack represents the network-partition chaos attack,
+	// which drops all traffic between the target pods and a group of peer pods.
+	NetworkPartitionAttack AttackType = "network-partition"
+	// IOStressAttack represents the io-stress chaos attack, which generates
+	// read/write load on a path inside a target container.
+	IOStressAttack AttackType = "io-stress"
+	// NodeTaintAttack represents the node-taint chaos attack, which taints
+	// and optionally cordons the node of a target pod for a bounded window.
+	NodeTaintAttack AttackType = "node-taint"
+	// KubeletChaosAttack represents the kubelet-chaos chaos attack, which stops
+	// or restarts the kubelet on the node of a target pod.
+	KubeletChaosAttack AttackType = "kubelet-chaos"
+	// TimeSkewAttack represents the time-skew chaos attack, which shifts the
+	// wall clock seen by the processes of a target container.
+	TimeSkewAttack AttackType = "time-skew"
+	// GRPCFaultAttack represents the grpc-fault chaos attack, which makes the
+	// service mesh fail or delay gRPC calls to the target pods.
+	GRPCFaultAttack AttackType = "grpc-fault"
+	// ProcessKillAttack represents the process-kill chaos attack, which sends
+	// a signal to selected processes inside a target container.
+	ProcessKillAttack AttackType = "process-kill"
+	// PodPauseAttack represents the pod-pause chaos attack, which pauses every
+	// process of a target pod for a bounded window and then resumes them.
+	PodPauseAttack AttackType = "pod-pause"
+	// ScaleChaosAttack represents the scale-chaos chaos attack, which scales a
+	// Deployment or StatefulSet down for a bounded window.
+	ScaleChaosAttack AttackType = "scale-chaos"
+	// ImagePullFailureAttack represents the image-pull-failure chaos attack,
+	// which rolls out an unpullable image to a workload for a bounded window.
+	ImagePullFailureAttack AttackType = "image-pull-failure"
+	// ConfigChaosAttack represents the config-chaos chaos attack, which
+	// removes a ConfigMap or Secret for a bounded window.
+	ConfigChaosAttack AttackType = "config-chaos"
+	// ServiceBlackholeAttack represents the service-blackhole chaos attack,
+	// which empties the endpoints of a Service without touching its pods.
+	ServiceBlackholeAttack AttackType = "service-blackhole"
+	// CertExpiryAttack represents the cert-expiry chaos attack, which swaps a
+	// TLS Secret for an expired or short-lived certificate.
+	CertExpiryAttack AttackType = "cert-expiry"
+	// NetworkChaosAttack represents the network-chaos chaos attack, which
+	// degrades the network of the target pods with netem.
+	NetworkChaosAttack AttackType = "network-chaos"
 )
 
 // ExperimentMode represents the execution mode of the experiment.
@@ -73,26 +1277,157 @@ type ExperimentMode string
 const (
 	// OneShotMode means the experiment runs once and then completes.
 	OneShotMode ExperimentMode = "one-shot"
-	// RecurringMode means the experiment runs repeatedly based on its duration.
+	// RecurringMode means the experiment runs an iteration every interval, or
+	// on its schedule, until its duration is over.
 	RecurringMode ExperimentMode = "recurring"
 )
 
 // ChaosExperimentStatus defines the observed state of ChaosExperiment.
 type ChaosExperimentStatus struct {
 	// Phase indicates the current state of the chaos experiment.
-	// Possible values are "Pending", "Running", "Completed", "Failed".
-	// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
+	// Possible values are "Pending", "Running", "Paused", "Completed",
+	// "Aborted", "Failed".
+	// +kubebuilder:validation:Enum=Pending;Running;Paused;Completed;Aborted;Failed
 	// +optional
 	Phase ExperimentPhase `json:"phase,omitempty"`
 
+	// Reason is a CamelCase reason for the current phase, such as Suspended
+	// for a paused experiment or AbortRequested for an aborted one. It is
+	// empty while the experiment is pending or running as planned.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ObservedGeneration is the generation of the spec the operator has
+	// acted on.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// SpecHash identifies the spec the current run was started with. An
+	// experiment whose spec changes in a way that alters its run is
+	// restarted.
+	// +optional
+	SpecHash string `json:"specHash,omitempty"`
+
+	// StartTime records when the experiment ran its first iteration.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
 	// LastRunTime records the last time the experiment performed an action.
 	// +optional
 	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
 
+	// NextScheduledTime is when a recurring experiment runs its next iteration.
+	// +optional
+	NextScheduledTime *metav1.Time `json:"nextScheduledTime,omitempty"`
+
+	// Verdict is the outcome of the most recent check of spec.hypothesis:
+	// "Passed" if the steady state held, "Failed" if it did not.
+	// +kubebuilder:validation:Enum=Passed;Failed
+	// +optional
+	Verdict Verdict `json:"verdict,omitempty"`
+
+	// HypothesisCheckTime is when spec.hypothesis is verified again after the
+	// most recent iteration.
+	// +optional
+	HypothesisCheckTime *metav1.Time `json:"hypothesisCheckTime,omitempty"`
+
+	// DuringProbeTime is when the probes that run during the attack are due
+	// after the most recent iteration.
+	// +optional
+	DuringProbeTime *metav1.Time `json:"duringProbeTime,omitempty"`
+
+	// ProbeResults records the outcome of each probe in the most recent check
+	// of spec.hypothesis.
+	// +listType=atomic
+	// +optional
+	ProbeResults []ProbeResult `json:"probeResults,omitempty"`
+
+	// Resilience scores how well the system under test withstood the attacks
+	// of the experiment across all of its iterations.
+	// +optional
+	Resilience *ResilienceScore `json:"resilience,omitempty"`
+
 	// Message provides a human-readable status or error message.
 	// +optional
 	Message string `json:"message,omitempty"`
 
+	// CompletionTime records when the experiment finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// IterationsCompleted counts the attack iterations the experiment has run.
+	// +optional
+	IterationsCompleted int32 `json:"iterationsCompleted,omitempty"`
+
+	// RunID is the unique ID of the iteration in progress. The helper pods
+	// and events of the iteration carry it, and it moves to status.history
+	// once the iteration is recorded. An iteration interrupted after it
+	// started acting is finished under the same ID instead of attacking
+	// again.
+	// +optional
+	RunID string `json:"runID,omitempty"`
+
+	// ConsecutiveFailures counts the failed attempts since the last
+	// successful attack iteration. It sets the backoff of spec.retryPolicy.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// LastIteration records what the most recent attack iteration did.
+	// +optional
+	LastIteration *IterationResult `json:"lastIteration,omitempty"`
+
+	// LastAffectedTargets lists the pods, nodes or other objects the most
+	// recent attack iteration acted on.
+	// +listType=atomic
+	// +optional
+	LastAffectedTargets []AffectedTarget `json:"lastAffectedTargets,omitempty"`
+
+	// History records the most recent iterations, oldest first, up to
+	// spec.historyLimit of them.
+	// +listType=atomic
+	// +optional
+	History []IterationRecord `json:"history,omitempty"`
+
+	// LastResult is the name of the ChaosResult recording the most recent
+	// attack iteration.
+	// +optional
+	LastResult string `json:"lastResult,omitempty"`
+
+	// ReportRef names the ConfigMap holding the report of the experiment,
+	// written once it has finished. The report is stored as JSON under
+	// report.json and rendered as HTML under report.html.
+	// +optional
+	ReportRef *corev1.LocalObjectReference `json:"reportRef,omitempty"`
+
+	// LastSelectedPod is the name of the last pod picked by the round-robin
+	// selection strategy.
+	// +optional
+	LastSelectedPod string `json:"lastSelectedPod,omitempty"`
+
+	// Seed is the seed the target pods are selected with: spec.seed, or the
+	// one the operator picked for the first iteration.
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
+	// SelectionOrder lists the names of the pods picked by the latest
+	// iteration, in the order they were picked.
+	// +listType=atomic
+	// +optional
+	SelectionOrder []string `json:"selectionOrder,omitempty"`
+
+	// AffectedPods lists the pods picked as targets within the window of
+	// spec.safeguards, to enforce its maxAffectedPercentage.
+	// +listType=atomic
+	// +optional
+	AffectedPods []AffectedPod `json:"affectedPods,omitempty"`
+
+	// ActiveFaults lists the changes the operator made to cluster objects that
+	// still have to be reverted. Entries are written before the change is made,
+	// so a restarted controller can always find and revert them.
+	// +listType=atomic
+	// +optional
+	ActiveFaults []InjectedFault `json:"activeFaults,omitempty"`
+
 	// conditions represent the current state of the ChaosExperiment resource.
 	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
 	//
@@ -108,22 +1443,453 @@ type ChaosExperimentStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// AffectedPod records a pod picked as a target and when.
+type AffectedPod struct {
+	// Name is the namespace/name of the pod.
+	Name string `json:"name"`
+
+	// Time is when the pod was picked.
+	Time metav1.Time `json:"time"`
+}
+
+// ResilienceScore summarizes the outcome of the iterations of an experiment.
+type ResilienceScore struct {
+	// Score ranges from 0 to 100, higher meaning more resilient. The pass rate
+	// of spec.hypothesis after attacks makes up 60 points, the mean recovery
+	// time measured against spec.hypothesis.recoveryObjective 20 points, and
+	// the share of iterations not aborted by spec.abortConditions 20 points.
+	Score int32 `json:"score"`
+
+	// Iterations counts the iterations that attacked, failed to or were
+	// aborted.
+	// +optional
+	Iterations int32 `json:"iterations,omitempty"`
+
+	// Checks counts the checks of spec.hypothesis after attacks.
+	// +optional
+	Checks int32 `json:"checks,omitempty"`
+
+	// ChecksPassed counts the checks of spec.hypothesis after attacks that
+	// passed.
+	// +optional
+	ChecksPassed int32 `json:"checksPassed,omitempty"`
+
+	// SafeguardTriggers counts the iterations aborted by spec.abortConditions.
+	// +optional
+	SafeguardTriggers int32 `json:"safeguardTriggers,omitempty"`
+
+	// Recoveries counts the attacks after which the steady state was seen to
+	// hold again.
+	// +optional
+	Recoveries int32 `json:"recoveries,omitempty"`
+
+	// MeanRecoveryTime is the mean time from an attack until the steady state
+	// was seen to hold again.
+	// +optional
+	MeanRecoveryTime *metav1.Duration `json:"meanRecoveryTime,omitempty"`
+
+	// RecoveringSince is when the attack ran after which the steady state has
+	// not held yet.
+	// +optional
+	RecoveringSince *metav1.Time `json:"recoveringSince,omitempty"`
+}
+
+// Verdict is the outcome of a steady-state hypothesis check.
+type Verdict string
+
+const (
+	// VerdictPassed indicates the steady state held.
+	VerdictPassed Verdict = "Passed"
+	// VerdictFailed indicates the steady state did not hold.
+	VerdictFailed Verdict = "Failed"
+)
+
+// ProbeResult records the outcome of a single probe.
+type ProbeResult struct {
+	// Name is the name of the probe.
+	Name string `json:"name"`
+
+	// Phase is the phase of the iteration the probe ran in.
+	// +optional
+	Phase ProbePhase `json:"phase,omitempty"`
+
+	// Passed is true if the probe passed.
+	Passed bool `json:"passed"`
+
+	// Message explains why the probe failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Time is when the probe was run.
+	Time metav1.Time `json:"time"`
+}
+
+// IterationResult records what a single attack iteration did.
+type IterationResult struct {
+	// Time is when the iteration ran.
+	Time metav1.Time `json:"time"`
+
+	// RunID is the unique ID of the iteration.
+	// +optional
+	RunID string `json:"runID,omitempty"`
+
+	// Targets lists the affected pods as namespace/name, and other affected
+	// objects as kind namespace/name.
+	// +listType=atomic
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+
+	// DryRun is true if the iteration was a dry run and Targets lists what
+	// would have been affected.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Skipped is the number of selected pods the iteration left alone, e.g.
+	// because evicting them would have violated a PodDisruptionBudget.
+	// +optional
+	Skipped int32 `json:"skipped,omitempty"`
+
+	// Failures lists the selected targets the iteration failed to act on.
+	// +listType=atomic
+	// +optional
+	Failures []TargetFailure `json:"failures,omitempty"`
+}
+
+// TargetFailure records why an iteration failed to act on one of its targets.
+type TargetFailure struct {
+	// Target is the pod as namespace/name.
+	Target string `json:"target"`
+
+	// Error is what went wrong.
+	Error string `json:"error"`
+}
+
+// NotificationEvent is a lifecycle event of an experiment that can be
+// notified.
+// +kubebuilder:validation:Enum=Started;AttackExecuted;Completed;Failed;Aborted;Restarted
+type NotificationEvent string
+
+const (
+	// NotifyStarted is sent when the experiment runs its first iteration.
+	NotifyStarted NotificationEvent = "Started"
+	// NotifyAttackExecuted is sent after every attack iteration.
+	NotifyAttackExecuted NotificationEvent = "AttackExecuted"
+	// NotifyCompleted is sent when the experiment completes.
+	NotifyCompleted NotificationEvent = "Completed"
+	// NotifyFailed is sent when an iteration fails.
+	NotifyFailed NotificationEvent = "Failed"
+	// NotifyAborted is sent when an abort condition stops the experiment.
+	NotifyAborted NotificationEvent = "Aborted"
+	// NotifyRestarted is sent when a change to the spec restarts the
+	// experiment.
+	NotifyRestarted NotificationEvent = "Restarted"
+)
+
+// Notifications configures the channels an experiment notifies.
+type Notifications struct {
+	// Slack posts messages to a Slack incoming webhook.
+	// +optional
+	Slack *SlackNotification `json:"slack,omitempty"`
+
+	// Webhook posts the events to an HTTP endpoint, e.g. an event bus or
+	// incident tooling.
+	// +optional
+	Webhook *WebhookNotification `json:"webhook,omitempty"`
+
+	// PagerDuty opens an incident through the PagerDuty Events API v2 when
+	// the experiment fails or is aborted.
+	// +optional
+	PagerDuty *PagerDutyNotification `json:"pagerDuty,omitempty"`
+
+	// Grafana writes an annotation through the Grafana HTTP API each time an
+	// attack executes.
+	// +optional
+	Grafana *GrafanaAnnotation `json:"grafana,omitempty"`
+}
+
+// SlackNotification posts messages to a Slack incoming webhook.
+type SlackNotification struct {
+	// WebhookURLSecretRef selects the key of a Secret in the namespace of
+	// the experiment that holds the URL of the incoming webhook.
+	WebhookURLSecretRef corev1.SecretKeySelector `json:"webhookURLSecretRef"`
+
+	// Events lists the events to post. Defaults to all of them.
+	// +listType=set
+	// +optional
+	Events []NotificationEvent `json:"events,omitempty"`
+
+	// Template is a Go template for the message text. It is executed with
+	// the fields .Event, .Experiment, .Namespace, .Attack, .Phase,
+	// .Iteration, .Targets and .Message. The default names the experiment,
+	// the event and the message.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
+// WebhookFormat is how events are posted to a webhook.
+// +kubebuilder:validation:Enum=CloudEvents;JSON
+type WebhookFormat string
+
+const (
+	// CloudEventsFormat posts CloudEvents 1.0 in structured mode.
+	CloudEventsFormat WebhookFormat = "CloudEvents"
+	// JSONFormat posts the plain event as JSON.
+	JSONFormat WebhookFormat = "JSON"
+)
+
+// WebhookNotification posts events to an HTTP endpoint.
+type WebhookNotification struct {
+	// URL is the endpoint the events are posted to.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// Format is "CloudEvents" to post CloudEvents 1.0 in structured mode,
+	// with the event as their data, or "JSON" to post the plain event.
+	// Defaults to "CloudEvents".
+	// +optional
+	Format WebhookFormat `json:"format,omitempty"`
+
+	// AuthorizationSecretRef selects the key of a Secret in the namespace of
+	// the experiment holding the value of the Authorization header, e.g.
+	// "Bearer <token>".
+	// +optional
+	AuthorizationSecretRef *corev1.SecretKeySelector `json:"authorizationSecretRef,omitempty"`
+
+	// Events lists the events to post. Defaults to all of them.
+	// +listType=set
+	// +optional
+	Events []NotificationEvent `json:"events,omitempty"`
+}
+
+// ExperimentTemplateRef refers to a ChaosExperimentTemplate and sets the
+// values of its parameters.
+type ExperimentTemplateRef struct {
+	// Name of the ChaosExperimentTemplate.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Parameters sets the values of the parameters of the template by name.
+	// Parameters without a value take their default.
+	// +optional
+	Parameters map[string]intstr.IntOrString `json:"parameters,omitempty"`
+}
+
+// PagerDutyNotification opens PagerDuty incidents for events of the
+// experiment.
+type PagerDutyNotification struct {
+	// RoutingKeySecretRef selects the key of a Secret in the namespace of the
+	// experiment that holds the integration key of the PagerDuty service.
+	RoutingKeySecretRef corev1.SecretKeySelector `json:"routingKeySecretRef"`
+
+	// Severity is the severity of the incidents. Defaults to "error".
+	// +kubebuilder:validation:Enum=critical;error;warning;info
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
+	// Events lists the events that open an incident. Defaults to Failed and
+	// Aborted.
+	// +listType=set
+	// +optional
+	Events []NotificationEvent `json:"events,omitempty"`
+}
+
+// GrafanaAnnotation marks executed attacks on Grafana dashboards.
+type GrafanaAnnotation struct {
+	// URL is the base URL of Grafana, e.g. "https://grafana.example.com".
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// APITokenSecretRef selects the key of a Secret in the namespace of the
+	// experiment that holds a service account token allowed to write
+	// annotations.
+	APITokenSecretRef corev1.SecretKeySelector `json:"apiTokenSecretRef"`
+
+	// DashboardUID limits the annotations to one dashboard. Without it they
+	// are organization-wide and show on every dashboard that queries them by
+	// tag.
+	// +optional
+	DashboardUID string `json:"dashboardUID,omitempty"`
+
+	// Tags are added to the tags derived from the experiment, the attack type
+	// and the affected targets.
+	// +listType=set
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// AffectedTarget identifies a pod, node or other object an attack iteration
+// acted on.
+type AffectedTarget struct {
+	// Kind is the kind of the target, e.g. Pod, Node or Deployment.
+	Kind string `json:"kind"`
+
+	// Name is the name of the target.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the target. It is empty for nodes.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// NodeName is the node a target pod was running on.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+}
+
+// IterationOutcome is the result of an attack iteration.
+type IterationOutcome string
+
+const (
+	// IterationSucceeded indicates the iteration carried out its attack.
+	IterationSucceeded IterationOutcome = "Succeeded"
+	// IterationSkipped indicates the iteration came due but attacked nothing.
+	IterationSkipped IterationOutcome = "Skipped"
+	// IterationAborted indicates an abort condition stopped the iteration.
+	IterationAborted IterationOutcome = "Aborted"
+	// IterationFailed indicates the iteration could not carry out its attack.
+	IterationFailed IterationOutcome = "Failed"
+)
+
+// IterationRecord is an entry of the iteration history.
+type IterationRecord struct {
+	// Time is when the iteration ran.
+	Time metav1.Time `json:"time"`
+
+	// RunID is the unique ID of the iteration.
+	// +optional
+	RunID string `json:"runID,omitempty"`
+
+	// Attack is the type of the attack the iteration ran.
+	Attack AttackType `json:"attack"`
+
+	// Targets lists what the iteration affected, like in status.lastIteration.
+	// +listType=atomic
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+
+	// DryRun is true if the iteration was a dry run.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Result is the outcome of the iteration.
+	// +kubebuilder:validation:Enum=Succeeded;Skipped;Aborted;Failed
+	Result IterationOutcome `json:"result"`
+
+	// Error explains why the iteration did not succeed.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// InjectedFault records a change the operator made to a cluster object as
+// part of an attack, together with what is needed to revert it.
+type InjectedFault struct {
+	// Attack is the type of the attack that injected the fault.
+	Attack AttackType `json:"attack"`
+
+	// Kind is the kind of the modified object, e.g. "Node".
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the modified object, empty for cluster-scoped objects.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the modified object.
+	Name string `json:"name"`
+
+	// Original holds the state needed to revert the fault, encoded by the attack that injected it.
+	// +optional
+	Original string `json:"original,omitempty"`
+
+	// InjectedAt is when the fault was injected.
+	InjectedAt metav1.Time `json:"injectedAt"`
+
+	// RevertAt is when the fault is due to be reverted.
+	RevertAt metav1.Time `json:"revertAt"`
+}
+
 // ExperimentPhase represents the current phase of the chaos experiment.
 type ExperimentPhase string
 
 const (
 	// ExperimentPending indicates the experiment is waiting to start.
 	ExperimentPending ExperimentPhase = "Pending"
-	// ExperimentRunning indicates the experiment is currently active.
+	// ExperimentRunning indicates the experiment is currently active. Recurring
+	// experiments stay Running from their first iteration until they finish,
+	// whatever the outcome of each iteration.
 	ExperimentRunning ExperimentPhase = "Running"
+	// ExperimentPaused indicates the experiment runs no iterations for now,
+	// because it is suspended, a safeguard holds it back or it waits for the
+	// cause of a failure to be fixed. It goes back to
+	// Running, or to Pending if it has not run an iteration yet, once that
+	// ends.
+	ExperimentPaused ExperimentPhase = "Paused"
 	// ExperimentCompleted indicates the experiment has finished successfully.
 	ExperimentCompleted ExperimentPhase = "Completed"
-	// ExperimentFailed indicates the experiment encountered an unrecoverable error.
+	// ExperimentAborted indicates the experiment was stopped by one of its
+	// abort conditions or on request.
+	ExperimentAborted ExperimentPhase = "Aborted"
+	// ExperimentFailed indicates the experiment encountered an unrecoverable
+	// error, or a recurring experiment ran out of retries.
 	ExperimentFailed ExperimentPhase = "Failed"
 )
 
+const (
+	// ConditionPaused is the condition type that is true while spec.suspend
+	// halts the experiment.
+	ConditionPaused = "Paused"
+	// ConditionTargetProtected is the condition type that is true when the
+	// operator refuses to attack the target namespace.
+	ConditionTargetProtected = "TargetProtected"
+	// ConditionBlocked is the condition type that is true while the operator
+	// requires namespaces to opt in to chaos and the target namespace has not.
+	ConditionBlocked = "Blocked"
+	// ConditionTargetsFound is the condition type that is true when the
+	// last iteration found targets to attack.
+	ConditionTargetsFound = "TargetsFound"
+	// ConditionWaitingForTargets is the condition type that is true while an
+	// experiment with spec.onNoTargets set to Wait waits for matching pods.
+	ConditionWaitingForTargets = "WaitingForTargets"
+	// ConditionAttackSucceeded is the condition type that is true when the
+	// last iteration carried out its attack, and false when it failed to.
+	ConditionAttackSucceeded = "AttackSucceeded"
+	// ConditionSafeguardsSatisfied is the condition type that is false when
+	// the last iteration was held back by spec.safeguards, a ChaosBudget or
+	// a PodDisruptionBudget.
+	ConditionSafeguardsSatisfied = "SafeguardsSatisfied"
+	// ConditionCompleted is the condition type that is true once the
+	// experiment has run to completion.
+	ConditionCompleted = "Completed"
+	// ConditionPolicyDenied is the condition type that is true when the
+	// policy endpoint of the operator denied the last attack.
+	ConditionPolicyDenied = "PolicyDenied"
+	// ConditionRetriesExhausted is the condition type that is true once the
+	// experiment failed spec.retryPolicy.maxFailures times in a row and is
+	// not retried any more.
+	ConditionRetriesExhausted = "RetriesExhausted"
+)
+
+// ServiceLabel names the service an experiment exercises, to aggregate the
+// resilience scores of its experiments. Experiments without it are counted
+// towards their target workload, or else their target namespace.
+const ServiceLabel = "chaos.shanto.dev/service"
+
+// CreatedByAnnotation records the user who created an experiment. The
+// defaulting webhook sets it on creation and keeps it from being changed.
+const CreatedByAnnotation = "chaos.shanto.dev/created-by"
+
+// AbortAnnotation requests an experiment to be aborted. Its value is the
+// reason, which may be empty. The experiment stops as it does when one of its
+// abort conditions fires, even while it is suspended.
+const AbortAnnotation = "chaos.shanto.dev/abort"
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cex
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.reason`,priority=1
+// +kubebuilder:printcolumn:name="Attack",type=string,JSONPath=`.spec.attack.type`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`
+// +kubebuilder:printcolumn:name="Last Run",type=date,JSONPath=`.status.lastRunTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // ChaosExperiment is the Schema for the chaosexperiments API
 type ChaosExperiment struct {
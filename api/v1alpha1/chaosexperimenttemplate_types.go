@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ParameterType is the type of a template parameter.
+// +kubebuilder:validation:Enum=String;Integer;Duration
+type ParameterType string
+
+const (
+	// StringParameter takes any string.
+	StringParameter ParameterType = "String"
+	// IntegerParameter takes a whole number, e.g. a percentage.
+	IntegerParameter ParameterType = "Integer"
+	// DurationParameter takes a Go duration, e.g. "5m".
+	DurationParameter ParameterType = "Duration"
+)
+
+// TemplateParameter declares a parameter of a ChaosExperimentTemplate.
+// +kubebuilder:validation:XValidation:rule="self.type == 'Integer' || (!has(self.minimum) && !has(self.maximum))",message="minimum and maximum require type Integer"
+type TemplateParameter struct {
+	// Name of the parameter, referenced as "$(name)" in the experiment.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// Description tells users what the parameter does.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Type of the parameter. Defaults to "String".
+	// +kubebuilder:default="String"
+	// +optional
+	Type ParameterType `json:"type,omitempty"`
+
+	// Default is the value of the parameter when an experiment does not set
+	// it. Parameters without a default are required.
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// Minimum is the smallest value an Integer parameter accepts.
+	// +optional
+	Minimum *int64 `json:"minimum,omitempty"`
+
+	// Maximum is the largest value an Integer parameter accepts.
+	// +optional
+	Maximum *int64 `json:"maximum,omitempty"`
+}
+
+// ChaosExperimentTemplateSpec defines a reusable experiment.
+type ChaosExperimentTemplateSpec struct {
+	// Description tells users what the experiment does.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Parameters declares the parameters of the template.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Parameters []TemplateParameter `json:"parameters,omitempty"`
+
+	// Experiment is the ChaosExperimentSpec of the experiments instantiated
+	// from the template. Strings in it may reference parameters as
+	// "$(name)"; a string that is only a reference to an Integer parameter is
+	// replaced by the number.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Experiment runtime.RawExtension `json:"experiment"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ChaosExperimentTemplate is the Schema for the chaosexperimenttemplates API.
+// It publishes a vetted experiment with typed parameters that
+// ChaosExperiments instantiate through spec.templateRef.
+type ChaosExperimentTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the experiment and its parameters
+	// +required
+	Spec ChaosExperimentTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosExperimentTemplateList contains a list of ChaosExperimentTemplate
+type ChaosExperimentTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ChaosExperimentTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosExperimentTemplate{}, &ChaosExperimentTemplateList{})
+}
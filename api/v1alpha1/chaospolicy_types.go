@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ChaosPolicySpec defines what the experiments targeting the namespaces a
+// ChaosPolicy covers are permitted to do.
+type ChaosPolicySpec struct {
+	// NamespaceSelector selects the target namespaces the policy covers. An
+	// empty or missing selector covers every namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AllowedAttacks lists the attack types experiments may run. Every
+	// attack type is permitted when it is empty.
+	// +listType=set
+	// +optional
+	AllowedAttacks []AttackType `json:"allowedAttacks,omitempty"`
+
+	// MaxPercentage is the largest target.percentage experiments may set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxPercentage *int32 `json:"maxPercentage,omitempty"`
+
+	// MaxPodKillCount is the largest attack.podKill.count experiments may
+	// set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxPodKillCount *int32 `json:"maxPodKillCount,omitempty"`
+
+	// TimeZone is the IANA name of the time zone AllowedWindows are
+	// interpreted in, e.g. "Europe/Berlin". Defaults to UTC.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// AllowedWindows restricts the attack iterations of experiments to the
+	// given time windows, on top of their own spec.allowedWindows.
+	// Iterations may run at any time when it is empty.
+	// +listType=atomic
+	// +optional
+	AllowedWindows []TimeWindow `json:"allowedWindows,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ChaosPolicy is the Schema for the chaospolicies API. It restricts the
+// attack types, blast radius and times of the experiments targeting the
+// namespaces it covers. Experiments that violate one of the policies
+// covering their target namespace are rejected on admission, and their
+// iterations are skipped if a policy changes after they were admitted.
+type ChaosPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines what the covered experiments are permitted to do
+	// +required
+	Spec ChaosPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosPolicyList contains a list of ChaosPolicy
+type ChaosPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ChaosPolicy `json:"items"`
+}
+
+// Covers reports whether the policy covers a namespace with the given
+// labels. A policy with an invalid selector covers every namespace, so that
+// a typo does not lift its restrictions.
+func (p *ChaosPolicy) Covers(namespaceLabels map[string]string) bool {
+	if p.Spec.NamespaceSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.NamespaceSelector)
+	return err != nil || selector.Matches(labels.Set(namespaceLabels))
+}
+
+// Violations returns how the experiment spec violates the attack types and
+// blast radius the policy permits. Its windows are not checked, as they
+// restrict when iterations run rather than what they do.
+func (p *ChaosPolicy) Violations(spec *ChaosExperimentSpec) []string {
+	var violations []string
+	if len(p.Spec.AllowedAttacks) > 0 && !slices.Contains(p.Spec.AllowedAttacks, spec.Attack.Type) {
+		violations = append(violations, fmt.Sprintf("attack type %s is not allowed", spec.Attack.Type))
+	}
+	if limit := p.Spec.MaxPercentage; limit != nil && spec.Target.Percentage != nil && *spec.Target.Percentage > *limit {
+		violations = append(violations, fmt.Sprintf("target.percentage %d exceeds %d", *spec.Target.Percentage, *limit))
+	}
+	if limit := p.Spec.MaxPodKillCount; limit != nil && spec.Attack.PodKill != nil && spec.Attack.PodKill.Count != nil && *spec.Attack.PodKill.Count > *limit {
+		violations = append(violations, fmt.Sprintf("attack.podKill.count %d exceeds %d", *spec.Attack.PodKill.Count, *limit))
+	}
+	return violations
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosPolicy{}, &ChaosPolicyList{})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChaosResultSpec records what an attack iteration of a ChaosExperiment did.
+type ChaosResultSpec struct {
+	// Experiment is the name of the experiment that ran the iteration.
+	Experiment string `json:"experiment"`
+
+	// Attack is the type of the attack the iteration ran.
+	Attack AttackType `json:"attack"`
+
+	// Iteration is the number of the iteration, counting from 1.
+	Iteration int32 `json:"iteration"`
+
+	// RunID is the unique ID of the iteration.
+	// +optional
+	RunID string `json:"runID,omitempty"`
+
+	// Time is when the iteration ran.
+	Time metav1.Time `json:"time"`
+
+	// Targets lists the affected pods as namespace/name, and other affected
+	// objects as kind namespace/name.
+	// +listType=atomic
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+
+	// DryRun is true if the iteration was a dry run and Targets lists what
+	// would have been affected.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Skipped is the number of selected pods the iteration left alone.
+	// +optional
+	Skipped int32 `json:"skipped,omitempty"`
+
+	// Failures lists the selected targets the iteration failed to act on.
+	// +listType=atomic
+	// +optional
+	Failures []TargetFailure `json:"failures,omitempty"`
+
+	// Result is the outcome of the iteration.
+	// +kubebuilder:validation:Enum=Succeeded;Failed
+	Result IterationOutcome `json:"result"`
+
+	// Error explains why the iteration failed.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ChaosResultStatus records the check of the experiment's steady-state
+// hypothesis that followed the iteration.
+type ChaosResultStatus struct {
+	// Verdict is "Passed" if the steady state held after the iteration, and
+	// "Failed" if it did not. It is not set while the check is pending or if
+	// the experiment has no hypothesis.
+	// +kubebuilder:validation:Enum=Passed;Failed
+	// +optional
+	Verdict Verdict `json:"verdict,omitempty"`
+
+	// ProbeResults records the outcome of each probe of the check.
+	// +listType=atomic
+	// +optional
+	ProbeResults []ProbeResult `json:"probeResults,omitempty"`
+
+	// VerificationTime is when the hypothesis was checked.
+	// +optional
+	VerificationTime *metav1.Time `json:"verificationTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ChaosResult is the Schema for the chaosresults API. The operator creates
+// one for every attack iteration of a ChaosExperiment, owned by the
+// experiment, as a lasting record for audits and post-incident reviews.
+type ChaosResult struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec records what the iteration did
+	// +required
+	Spec ChaosResultSpec `json:"spec"`
+
+	// status records the hypothesis check that followed the iteration
+	// +optional
+	Status ChaosResultStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosResultList contains a list of ChaosResult
+type ChaosResultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ChaosResult `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosResult{}, &ChaosResultList{})
+}
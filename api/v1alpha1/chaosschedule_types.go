@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduleLabel is set on the experiments a ChaosSchedule creates to the name
+// of the schedule.
+const ScheduleLabel = "chaos.shanto.dev/schedule"
+
+// ScheduledTimeAnnotation is set on the experiments a ChaosSchedule creates to
+// the time they were scheduled for, in RFC 3339 format.
+const ScheduledTimeAnnotation = "chaos.shanto.dev/scheduled-at"
+
+// ExperimentTemplateMetadata holds the labels and annotations of the
+// experiments created from a template.
+type ExperimentTemplateMetadata struct {
+	// Labels are added to the experiments.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the experiments.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ExperimentTemplate describes the experiments a ChaosSchedule creates.
+type ExperimentTemplate struct {
+	// Metadata of the experiments.
+	// +optional
+	Metadata ExperimentTemplateMetadata `json:"metadata,omitempty"`
+
+	// Spec of the experiments.
+	Spec ChaosExperimentSpec `json:"spec"`
+}
+
+// ChaosScheduleSpec defines the desired state of ChaosSchedule
+// +kubebuilder:validation:XValidation:rule="has(self.schedule) != has(self.interval)",message="exactly one of schedule and interval is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.timeZone) || has(self.schedule)",message="timeZone requires schedule"
+type ChaosScheduleSpec struct {
+	// Schedule is a cron expression with five fields, or a macro such as
+	// "@daily", that says when runs start.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// TimeZone is the IANA name of the time zone the schedule is interpreted
+	// in. Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Interval starts a run at a fixed interval, counted from the creation of
+	// the schedule.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// ExperimentTemplate is the experiment created for every run.
+	ExperimentTemplate ExperimentTemplate `json:"experimentTemplate"`
+
+	// ConcurrencyPolicy says what happens when a run is due while earlier
+	// runs are still active: "Allow" starts it alongside them, "Forbid"
+	// skips it and "Replace" deletes them, which reverts their faults, and
+	// starts it. Defaults to "Forbid".
+	// +kubebuilder:default="Forbid"
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend stops new runs from starting. Active runs are not affected.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// SuccessfulRunsHistoryLimit is how many completed runs are kept.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulRunsHistoryLimit *int32 `json:"successfulRunsHistoryLimit,omitempty"`
+
+	// FailedRunsHistoryLimit is how many failed or aborted runs are kept.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedRunsHistoryLimit *int32 `json:"failedRunsHistoryLimit,omitempty"`
+}
+
+// ChaosScheduleStatus defines the observed state of ChaosSchedule.
+type ChaosScheduleStatus struct {
+	// Active lists the runs that have not finished yet.
+	// +listType=atomic
+	// +optional
+	Active []corev1.ObjectReference `json:"active,omitempty"`
+
+	// LastScheduleTime is when the last run was due.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastSuccessfulTime is when the last successful run completed.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// NextScheduleTime is when the next run is due.
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// Message describes the current state of the schedule.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ChaosSchedule is the Schema for the chaosschedules API. It creates a
+// ChaosExperiment from its template for every run, the way a CronJob creates
+// Jobs, and keeps a limited history of finished runs.
+type ChaosSchedule struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines when runs start and what they do
+	// +required
+	Spec ChaosScheduleSpec `json:"spec"`
+
+	// status defines the observed state of ChaosSchedule
+	// +optional
+	Status ChaosScheduleStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosScheduleList contains a list of ChaosSchedule
+type ChaosScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ChaosSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaosSchedule{}, &ChaosScheduleList{})
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GameDayLabel is set on the experiments a GameDay creates to the name of the
+// game day.
+const GameDayLabel = "chaos.shanto.dev/gameday"
+
+// GameDayPhase is the stage a GameDay is in.
+type GameDayPhase string
+
+const (
+	// GameDayScheduled means the window has not opened yet.
+	GameDayScheduled GameDayPhase = "Scheduled"
+	// GameDayRunning means the experiments are running.
+	GameDayRunning GameDayPhase = "Running"
+	// GameDayHalted means the game day was stopped early.
+	GameDayHalted GameDayPhase = "Halted"
+	// GameDayCompleted means every experiment finished or the window closed.
+	GameDayCompleted GameDayPhase = "Completed"
+)
+
+// Participant is someone taking part in a GameDay.
+type Participant struct {
+	// Name of the participant.
+	Name string `json:"name"`
+
+	// Role of the participant, e.g. "facilitator" or "observer".
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// Contact is how to reach the participant, e.g. an email address.
+	// +optional
+	Contact string `json:"contact,omitempty"`
+}
+
+// GameDayExperiment is an experiment of a GameDay.
+type GameDayExperiment struct {
+	// Name of the experiment within the game day. The experiment is created
+	// as "<gameday>-<name>".
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Template of the experiment.
+	Template ExperimentTemplate `json:"template"`
+}
+
+// GameDaySpec defines the desired state of GameDay
+// +kubebuilder:validation:XValidation:rule="self.endTime > self.startTime",message="endTime must be after startTime"
+type GameDaySpec struct {
+	// Description of the game day, e.g. the hypothesis it tests.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Participants lists who takes part in the game day.
+	// +listType=atomic
+	// +optional
+	Participants []Participant `json:"participants,omitempty"`
+
+	// StartTime is when the experiments are created.
+	StartTime metav1.Time `json:"startTime"`
+
+	// EndTime is when the window closes. Experiments still running then are
+	// deleted, which reverts their faults.
+	EndTime metav1.Time `json:"endTime"`
+
+	// Experiments run during the game day.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Experiments []GameDayExperiment `json:"experiments"`
+
+	// Halt stops the game day: experiments still running are deleted, which
+	// reverts their faults, and the report is generated.
+	// +optional
+	Halt bool `json:"halt,omitempty"`
+
+	// HaltOnFailure halts the game day as soon as one of its experiments
+	// fails or is aborted. Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	HaltOnFailure *bool `json:"haltOnFailure,omitempty"`
+}
+
+// GameDayExperimentStatus is the state of an experiment of a GameDay.
+type GameDayExperimentStatus struct {
+	// Name of the experiment within the game day.
+	Name string `json:"name"`
+
+	// Phase of the experiment.
+	// +optional
+	Phase ExperimentPhase `json:"phase,omitempty"`
+
+	// IterationsCompleted is the number of attack iterations the experiment
+	// completed.
+	// +optional
+	IterationsCompleted int32 `json:"iterationsCompleted,omitempty"`
+
+	// Verdict is the last verdict of the experiment's hypothesis.
+	// +optional
+	Verdict Verdict `json:"verdict,omitempty"`
+
+	// Message is the last status message of the experiment.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// GameDayReport summarizes a finished GameDay.
+type GameDayReport struct {
+	// GeneratedTime is when the report was generated.
+	GeneratedTime metav1.Time `json:"generatedTime"`
+
+	// Experiments is the number of experiments of the game day, and
+	// Completed, Failed, Aborted and Stopped count them by outcome; Stopped
+	// experiments were still running when the game day ended.
+	Experiments int32 `json:"experiments"`
+	Completed   int32 `json:"completed"`
+	Failed      int32 `json:"failed"`
+	Aborted     int32 `json:"aborted"`
+	Stopped     int32 `json:"stopped"`
+
+	// Iterations is the number of attack iterations the experiments ran, and
+	// FailedIterations how many of those failed, as far as their history
+	// records.
+	Iterations       int32 `json:"iterations"`
+	FailedIterations int32 `json:"failedIterations"`
+
+	// HypothesesFailed is the number of experiments whose steady-state
+	// hypothesis did not hold.
+	HypothesesFailed int32 `json:"hypothesesFailed"`
+
+	// AffectedTargets lists every target the experiments affected.
+	// +listType=atomic
+	// +optional
+	AffectedTargets []string `json:"affectedTargets,omitempty"`
+
+	// Summary is a sentence describing the outcome.
+	Summary string `json:"summary"`
+}
+
+// GameDayStatus defines the observed state of GameDay.
+type GameDayStatus struct {
+	// Phase is the stage the game day is in.
+	// +optional
+	Phase GameDayPhase `json:"phase,omitempty"`
+
+	// Message describes the current state of the game day.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTime is when the experiments were created.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the game day ended.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Experiments is the state of each experiment.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Experiments []GameDayExperimentStatus `json:"experiments,omitempty"`
+
+	// Report summarizes the game day once it ended.
+	// +optional
+	Report *GameDayReport `json:"report,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// GameDay is the Schema for the gamedays API. It runs several experiments in
+// a shared time window, halts them all when one fails or on request, and
+// reports on the outcome when it ends.
+type GameDay struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the window and experiments of the game day
+	// +required
+	Spec GameDaySpec `json:"spec"`
+
+	// status defines the observed state of GameDay
+	// +optional
+	Status GameDayStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// GameDayList contains a list of GameDay
+type GameDayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []GameDay `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GameDay{}, &GameDayList{})
+}
@@ -21,10 +21,267 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AbortCondition) DeepCopyInto(out *AbortCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AbortCondition.
+func (in *AbortCondition) DeepCopy() *AbortCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AbortCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveExperimentRecord) DeepCopyInto(out *ActiveExperimentRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveExperimentRecord.
+func (in *ActiveExperimentRecord) DeepCopy() *ActiveExperimentRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveExperimentRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffectedPod) DeepCopyInto(out *AffectedPod) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffectedPod.
+func (in *AffectedPod) DeepCopy() *AffectedPod {
+	if in == nil {
+		return nil
+	}
+	out := new(AffectedPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffectedTarget) DeepCopyInto(out *AffectedTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffectedTarget.
+func (in *AffectedTarget) DeepCopy() *AffectedTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AffectedTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttackRecord) DeepCopyInto(out *AttackRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttackRecord.
+func (in *AttackRecord) DeepCopy() *AttackRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(AttackRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUStressAttackSpec) DeepCopyInto(out *CPUStressAttackSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUStressAttackSpec.
+func (in *CPUStressAttackSpec) DeepCopy() *CPUStressAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUStressAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertExpiryAttackSpec) DeepCopyInto(out *CertExpiryAttackSpec) {
+	*out = *in
+	if in.ValidFor != nil {
+		in, out := &in.ValidFor, &out.ValidFor
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertExpiryAttackSpec.
+func (in *CertExpiryAttackSpec) DeepCopy() *CertExpiryAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertExpiryAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosBudget) DeepCopyInto(out *ChaosBudget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosBudget.
+func (in *ChaosBudget) DeepCopy() *ChaosBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosBudget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosBudgetList) DeepCopyInto(out *ChaosBudgetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosBudget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosBudgetList.
+func (in *ChaosBudgetList) DeepCopy() *ChaosBudgetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosBudgetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosBudgetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosBudgetSpec) DeepCopyInto(out *ChaosBudgetSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxPodKillsPerHour != nil {
+		in, out := &in.MaxPodKillsPerHour, &out.MaxPodKillsPerHour
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentExperimentsPerNamespace != nil {
+		in, out := &in.MaxConcurrentExperimentsPerNamespace, &out.MaxConcurrentExperimentsPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxActiveExperiments != nil {
+		in, out := &in.MaxActiveExperiments, &out.MaxActiveExperiments
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAttacksPerHour != nil {
+		in, out := &in.MaxAttacksPerHour, &out.MaxAttacksPerHour
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosBudgetSpec.
+func (in *ChaosBudgetSpec) DeepCopy() *ChaosBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosBudgetStatus) DeepCopyInto(out *ChaosBudgetStatus) {
+	*out = *in
+	if in.PodKills != nil {
+		in, out := &in.PodKills, &out.PodKills
+		*out = make([]PodKillRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Attacks != nil {
+		in, out := &in.Attacks, &out.Attacks
+		*out = make([]AttackRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ActiveExperiments != nil {
+		in, out := &in.ActiveExperiments, &out.ActiveExperiments
+		*out = make([]ActiveExperimentRecord, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosBudgetStatus.
+func (in *ChaosBudgetStatus) DeepCopy() *ChaosBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosExperiment) DeepCopyInto(out *ChaosExperiment) {
 	*out = *in
@@ -87,13 +344,104 @@ func (in *ChaosExperimentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosExperimentSpec) DeepCopyInto(out *ChaosExperimentSpec) {
 	*out = *in
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(ExperimentTemplateRef)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Target.DeepCopyInto(&out.Target)
-	out.Attack = in.Attack
+	in.Attack.DeepCopyInto(&out.Attack)
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Safeguards != nil {
+		in, out := &in.Safeguards, &out.Safeguards
+		*out = new(Safeguards)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AbortConditions != nil {
+		in, out := &in.AbortConditions, &out.AbortConditions
+		*out = make([]AbortCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hypothesis != nil {
+		in, out := &in.Hypothesis, &out.Hypothesis
+		*out = new(Hypothesis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxIterations != nil {
+		in, out := &in.MaxIterations, &out.MaxIterations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResultsLimit != nil {
+		in, out := &in.ResultsLimit, &out.ResultsLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(Notifications)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StartAfter != nil {
+		in, out := &in.StartAfter, &out.StartAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedWindows != nil {
+		in, out := &in.AllowedWindows, &out.AllowedWindows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosExperimentSpec.
@@ -109,10 +457,88 @@ func (in *ChaosExperimentSpec) DeepCopy() *ChaosExperimentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosExperimentStatus) DeepCopyInto(out *ChaosExperimentStatus) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 	if in.LastRunTime != nil {
 		in, out := &in.LastRunTime, &out.LastRunTime
 		*out = (*in).DeepCopy()
 	}
+	if in.NextScheduledTime != nil {
+		in, out := &in.NextScheduledTime, &out.NextScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	if in.HypothesisCheckTime != nil {
+		in, out := &in.HypothesisCheckTime, &out.HypothesisCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DuringProbeTime != nil {
+		in, out := &in.DuringProbeTime, &out.DuringProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ProbeResults != nil {
+		in, out := &in.ProbeResults, &out.ProbeResults
+		*out = make([]ProbeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resilience != nil {
+		in, out := &in.Resilience, &out.Resilience
+		*out = new(ResilienceScore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastIteration != nil {
+		in, out := &in.LastIteration, &out.LastIteration
+		*out = new(IterationResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastAffectedTargets != nil {
+		in, out := &in.LastAffectedTargets, &out.LastAffectedTargets
+		*out = make([]AffectedTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]IterationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReportRef != nil {
+		in, out := &in.ReportRef, &out.ReportRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SelectionOrder != nil {
+		in, out := &in.SelectionOrder, &out.SelectionOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AffectedPods != nil {
+		in, out := &in.AffectedPods, &out.AffectedPods
+		*out = make([]AffectedPod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ActiveFaults != nil {
+		in, out := &in.ActiveFaults, &out.ActiveFaults
+		*out = make([]InjectedFault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -133,38 +559,1748 @@ func (in *ChaosExperimentStatus) DeepCopy() *ChaosExperimentStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExperimentAttack) DeepCopyInto(out *ExperimentAttack) {
+func (in *ChaosExperimentTemplate) DeepCopyInto(out *ChaosExperimentTemplate) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentAttack.
-func (in *ExperimentAttack) DeepCopy() *ExperimentAttack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosExperimentTemplate.
+func (in *ChaosExperimentTemplate) DeepCopy() *ChaosExperimentTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ExperimentAttack)
+	out := new(ChaosExperimentTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExperimentTarget) DeepCopyInto(out *ExperimentTarget) {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosExperimentTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosExperimentTemplateList) DeepCopyInto(out *ChaosExperimentTemplateList) {
 	*out = *in
-	if in.LabelSelector != nil {
-		in, out := &in.LabelSelector, &out.LabelSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosExperimentTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentTarget.
-func (in *ExperimentTarget) DeepCopy() *ExperimentTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosExperimentTemplateList.
+func (in *ChaosExperimentTemplateList) DeepCopy() *ChaosExperimentTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ExperimentTarget)
+	out := new(ChaosExperimentTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosExperimentTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosExperimentTemplateSpec) DeepCopyInto(out *ChaosExperimentTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TemplateParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Experiment.DeepCopyInto(&out.Experiment)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosExperimentTemplateSpec.
+func (in *ChaosExperimentTemplateSpec) DeepCopy() *ChaosExperimentTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosExperimentTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicy) DeepCopyInto(out *ChaosPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicy.
+func (in *ChaosPolicy) DeepCopy() *ChaosPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicyList) DeepCopyInto(out *ChaosPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicyList.
+func (in *ChaosPolicyList) DeepCopy() *ChaosPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosPolicySpec) DeepCopyInto(out *ChaosPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedAttacks != nil {
+		in, out := &in.AllowedAttacks, &out.AllowedAttacks
+		*out = make([]AttackType, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxPercentage != nil {
+		in, out := &in.MaxPercentage, &out.MaxPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPodKillCount != nil {
+		in, out := &in.MaxPodKillCount, &out.MaxPodKillCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedWindows != nil {
+		in, out := &in.AllowedWindows, &out.AllowedWindows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicySpec.
+func (in *ChaosPolicySpec) DeepCopy() *ChaosPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosResult) DeepCopyInto(out *ChaosResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResult.
+func (in *ChaosResult) DeepCopy() *ChaosResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosResultList) DeepCopyInto(out *ChaosResultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResultList.
+func (in *ChaosResultList) DeepCopy() *ChaosResultList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosResultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosResultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosResultSpec) DeepCopyInto(out *ChaosResultSpec) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]TargetFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResultSpec.
+func (in *ChaosResultSpec) DeepCopy() *ChaosResultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosResultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosResultStatus) DeepCopyInto(out *ChaosResultStatus) {
+	*out = *in
+	if in.ProbeResults != nil {
+		in, out := &in.ProbeResults, &out.ProbeResults
+		*out = make([]ProbeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VerificationTime != nil {
+		in, out := &in.VerificationTime, &out.VerificationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosResultStatus.
+func (in *ChaosResultStatus) DeepCopy() *ChaosResultStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosResultStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosSchedule) DeepCopyInto(out *ChaosSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosSchedule.
+func (in *ChaosSchedule) DeepCopy() *ChaosSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosScheduleList) DeepCopyInto(out *ChaosScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosScheduleList.
+func (in *ChaosScheduleList) DeepCopy() *ChaosScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosScheduleSpec) DeepCopyInto(out *ChaosScheduleSpec) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ExperimentTemplate.DeepCopyInto(&out.ExperimentTemplate)
+	if in.SuccessfulRunsHistoryLimit != nil {
+		in, out := &in.SuccessfulRunsHistoryLimit, &out.SuccessfulRunsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedRunsHistoryLimit != nil {
+		in, out := &in.FailedRunsHistoryLimit, &out.FailedRunsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosScheduleSpec.
+func (in *ChaosScheduleSpec) DeepCopy() *ChaosScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosScheduleStatus) DeepCopyInto(out *ChaosScheduleStatus) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosScheduleStatus.
+func (in *ChaosScheduleStatus) DeepCopy() *ChaosScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommandProbe) DeepCopyInto(out *CommandProbe) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommandProbe.
+func (in *CommandProbe) DeepCopy() *CommandProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(CommandProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigChaosAttackSpec) DeepCopyInto(out *ConfigChaosAttackSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigChaosAttackSpec.
+func (in *ConfigChaosAttackSpec) DeepCopy() *ConfigChaosAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigChaosAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerKillAttackSpec) DeepCopyInto(out *ContainerKillAttackSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerKillAttackSpec.
+func (in *ContainerKillAttackSpec) DeepCopy() *ContainerKillAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerKillAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentAttack) DeepCopyInto(out *ExperimentAttack) {
+	*out = *in
+	if in.PodKill != nil {
+		in, out := &in.PodKill, &out.PodKill
+		*out = new(PodKillAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerKill != nil {
+		in, out := &in.ContainerKill, &out.ContainerKill
+		*out = new(ContainerKillAttackSpec)
+		**out = **in
+	}
+	if in.CPUStress != nil {
+		in, out := &in.CPUStress, &out.CPUStress
+		*out = new(CPUStressAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemoryStress != nil {
+		in, out := &in.MemoryStress, &out.MemoryStress
+		*out = new(MemoryStressAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Partition != nil {
+		in, out := &in.Partition, &out.Partition
+		*out = new(NetworkPartitionAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOStress != nil {
+		in, out := &in.IOStress, &out.IOStress
+		*out = new(IOStressAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeTaint != nil {
+		in, out := &in.NodeTaint, &out.NodeTaint
+		*out = new(NodeTaintAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeletChaos != nil {
+		in, out := &in.KubeletChaos, &out.KubeletChaos
+		*out = new(KubeletChaosAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeSkew != nil {
+		in, out := &in.TimeSkew, &out.TimeSkew
+		*out = new(TimeSkewAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCFault != nil {
+		in, out := &in.GRPCFault, &out.GRPCFault
+		*out = new(GRPCFaultAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProcessKill != nil {
+		in, out := &in.ProcessKill, &out.ProcessKill
+		*out = new(ProcessKillAttackSpec)
+		**out = **in
+	}
+	if in.PodPause != nil {
+		in, out := &in.PodPause, &out.PodPause
+		*out = new(PodPauseAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleChaos != nil {
+		in, out := &in.ScaleChaos, &out.ScaleChaos
+		*out = new(ScaleChaosAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullFailure != nil {
+		in, out := &in.ImagePullFailure, &out.ImagePullFailure
+		*out = new(ImagePullFailureAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigChaos != nil {
+		in, out := &in.ConfigChaos, &out.ConfigChaos
+		*out = new(ConfigChaosAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceBlackhole != nil {
+		in, out := &in.ServiceBlackhole, &out.ServiceBlackhole
+		*out = new(ServiceBlackholeAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertExpiry != nil {
+		in, out := &in.CertExpiry, &out.CertExpiry
+		*out = new(CertExpiryAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkChaos != nil {
+		in, out := &in.NetworkChaos, &out.NetworkChaos
+		*out = new(NetworkChaosAttackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentAttack.
+func (in *ExperimentAttack) DeepCopy() *ExperimentAttack {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentAttack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentTarget) DeepCopyInto(out *ExperimentTarget) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workload != nil {
+		in, out := &in.Workload, &out.Workload
+		*out = new(WorkloadReference)
+		**out = **in
+	}
+	if in.ExcludeLabelSelector != nil {
+		in, out := &in.ExcludeLabelSelector, &out.ExcludeLabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodConditions != nil {
+		in, out := &in.PodConditions, &out.PodConditions
+		*out = make([]TargetPodCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(LeaderElection)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentTarget.
+func (in *ExperimentTarget) DeepCopy() *ExperimentTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentTemplate) DeepCopyInto(out *ExperimentTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentTemplate.
+func (in *ExperimentTemplate) DeepCopy() *ExperimentTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentTemplateMetadata) DeepCopyInto(out *ExperimentTemplateMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentTemplateMetadata.
+func (in *ExperimentTemplateMetadata) DeepCopy() *ExperimentTemplateMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentTemplateMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentTemplateRef) DeepCopyInto(out *ExperimentTemplateRef) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]intstr.IntOrString, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentTemplateRef.
+func (in *ExperimentTemplateRef) DeepCopy() *ExperimentTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCFaultAttackSpec) DeepCopyInto(out *GRPCFaultAttackSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]GRPCFaultRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCFaultAttackSpec.
+func (in *GRPCFaultAttackSpec) DeepCopy() *GRPCFaultAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCFaultAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCFaultRule) DeepCopyInto(out *GRPCFaultRule) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCFaultRule.
+func (in *GRPCFaultRule) DeepCopy() *GRPCFaultRule {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCFaultRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameDay) DeepCopyInto(out *GameDay) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameDay.
+func (in *GameDay) DeepCopy() *GameDay {
+	if in == nil {
+		return nil
+	}
+	out := new(GameDay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GameDay) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameDayExperiment) DeepCopyInto(out *GameDayExperiment) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameDayExperiment.
+func (in *GameDayExperiment) DeepCopy() *GameDayExperiment {
+	if in == nil {
+		return nil
+	}
+	out := new(GameDayExperiment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameDayExperimentStatus) DeepCopyInto(out *GameDayExperimentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameDayExperimentStatus.
+func (in *GameDayExperimentStatus) DeepCopy() *GameDayExperimentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GameDayExperimentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameDayList) DeepCopyInto(out *GameDayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GameDay, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameDayList.
+func (in *GameDayList) DeepCopy() *GameDayList {
+	if in == nil {
+		return nil
+	}
+	out := new(GameDayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GameDayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameDayReport) DeepCopyInto(out *GameDayReport) {
+	*out = *in
+	in.GeneratedTime.DeepCopyInto(&out.GeneratedTime)
+	if in.AffectedTargets != nil {
+		in, out := &in.AffectedTargets, &out.AffectedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameDayReport.
+func (in *GameDayReport) DeepCopy() *GameDayReport {
+	if in == nil {
+		return nil
+	}
+	out := new(GameDayReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameDaySpec) DeepCopyInto(out *GameDaySpec) {
+	*out = *in
+	if in.Participants != nil {
+		in, out := &in.Participants, &out.Participants
+		*out = make([]Participant, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]GameDayExperiment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HaltOnFailure != nil {
+		in, out := &in.HaltOnFailure, &out.HaltOnFailure
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameDaySpec.
+func (in *GameDaySpec) DeepCopy() *GameDaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GameDaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameDayStatus) DeepCopyInto(out *GameDayStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]GameDayExperimentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Report != nil {
+		in, out := &in.Report, &out.Report
+		*out = new(GameDayReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameDayStatus.
+func (in *GameDayStatus) DeepCopy() *GameDayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GameDayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaAnnotation) DeepCopyInto(out *GrafanaAnnotation) {
+	*out = *in
+	in.APITokenSecretRef.DeepCopyInto(&out.APITokenSecretRef)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaAnnotation.
+func (in *GrafanaAnnotation) DeepCopy() *GrafanaAnnotation {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaAnnotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProbe) DeepCopyInto(out *HTTPProbe) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbe.
+func (in *HTTPProbe) DeepCopy() *HTTPProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hypothesis) DeepCopyInto(out *Hypothesis) {
+	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]Probe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RecoveryObjective != nil {
+		in, out := &in.RecoveryObjective, &out.RecoveryObjective
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hypothesis.
+func (in *Hypothesis) DeepCopy() *Hypothesis {
+	if in == nil {
+		return nil
+	}
+	out := new(Hypothesis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOStressAttackSpec) DeepCopyInto(out *IOStressAttackSpec) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOStressAttackSpec.
+func (in *IOStressAttackSpec) DeepCopy() *IOStressAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IOStressAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullFailureAttackSpec) DeepCopyInto(out *ImagePullFailureAttackSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullFailureAttackSpec.
+func (in *ImagePullFailureAttackSpec) DeepCopy() *ImagePullFailureAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullFailureAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InjectedFault) DeepCopyInto(out *InjectedFault) {
+	*out = *in
+	in.InjectedAt.DeepCopyInto(&out.InjectedAt)
+	in.RevertAt.DeepCopyInto(&out.RevertAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectedFault.
+func (in *InjectedFault) DeepCopy() *InjectedFault {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectedFault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IterationRecord) DeepCopyInto(out *IterationRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IterationRecord.
+func (in *IterationRecord) DeepCopy() *IterationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(IterationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IterationResult) DeepCopyInto(out *IterationResult) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]TargetFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IterationResult.
+func (in *IterationResult) DeepCopy() *IterationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(IterationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletChaosAttackSpec) DeepCopyInto(out *KubeletChaosAttackSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletChaosAttackSpec.
+func (in *KubeletChaosAttackSpec) DeepCopy() *KubeletChaosAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletChaosAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeaderElection) DeepCopyInto(out *LeaderElection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderElection.
+func (in *LeaderElection) DeepCopy() *LeaderElection {
+	if in == nil {
+		return nil
+	}
+	out := new(LeaderElection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryStressAttackSpec) DeepCopyInto(out *MemoryStressAttackSpec) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStressAttackSpec.
+func (in *MemoryStressAttackSpec) DeepCopy() *MemoryStressAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStressAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkChaosAttackSpec) DeepCopyInto(out *NetworkChaosAttackSpec) {
+	*out = *in
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosAttackSpec.
+func (in *NetworkChaosAttackSpec) DeepCopy() *NetworkChaosAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkChaosAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPartitionAttackSpec) DeepCopyInto(out *NetworkPartitionAttackSpec) {
+	*out = *in
+	in.PeerSelector.DeepCopyInto(&out.PeerSelector)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPartitionAttackSpec.
+func (in *NetworkPartitionAttackSpec) DeepCopy() *NetworkPartitionAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPartitionAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTaintAttackSpec) DeepCopyInto(out *NodeTaintAttackSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaintAttackSpec.
+func (in *NodeTaintAttackSpec) DeepCopy() *NodeTaintAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTaintAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notifications) DeepCopyInto(out *Notifications) {
+	*out = *in
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(PagerDutyNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Grafana != nil {
+		in, out := &in.Grafana, &out.Grafana
+		*out = new(GrafanaAnnotation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notifications.
+func (in *Notifications) DeepCopy() *Notifications {
+	if in == nil {
+		return nil
+	}
+	out := new(Notifications)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyNotification) DeepCopyInto(out *PagerDutyNotification) {
+	*out = *in
+	in.RoutingKeySecretRef.DeepCopyInto(&out.RoutingKeySecretRef)
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyNotification.
+func (in *PagerDutyNotification) DeepCopy() *PagerDutyNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Participant) DeepCopyInto(out *Participant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Participant.
+func (in *Participant) DeepCopy() *Participant {
+	if in == nil {
+		return nil
+	}
+	out := new(Participant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodKillAttackSpec) DeepCopyInto(out *PodKillAttackSpec) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodKillAttackSpec.
+func (in *PodKillAttackSpec) DeepCopy() *PodKillAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodKillAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodKillRecord) DeepCopyInto(out *PodKillRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodKillRecord.
+func (in *PodKillRecord) DeepCopy() *PodKillRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(PodKillRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPauseAttackSpec) DeepCopyInto(out *PodPauseAttackSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPauseAttackSpec.
+func (in *PodPauseAttackSpec) DeepCopy() *PodPauseAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPauseAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Probe) DeepCopyInto(out *Probe) {
+	*out = *in
+	if in.When != nil {
+		in, out := &in.When, &out.When
+		*out = make([]ProbePhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromQL != nil {
+		in, out := &in.PromQL, &out.PromQL
+		*out = new(PromQLProbe)
+		**out = **in
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = new(CommandProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(WorkloadReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
+func (in *Probe) DeepCopy() *Probe {
+	if in == nil {
+		return nil
+	}
+	out := new(Probe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeResult) DeepCopyInto(out *ProbeResult) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeResult.
+func (in *ProbeResult) DeepCopy() *ProbeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessKillAttackSpec) DeepCopyInto(out *ProcessKillAttackSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessKillAttackSpec.
+func (in *ProcessKillAttackSpec) DeepCopy() *ProcessKillAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessKillAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromQLProbe) DeepCopyInto(out *PromQLProbe) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromQLProbe.
+func (in *PromQLProbe) DeepCopy() *PromQLProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(PromQLProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResilienceScore) DeepCopyInto(out *ResilienceScore) {
+	*out = *in
+	if in.MeanRecoveryTime != nil {
+		in, out := &in.MeanRecoveryTime, &out.MeanRecoveryTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RecoveringSince != nil {
+		in, out := &in.RecoveringSince, &out.RecoveringSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResilienceScore.
+func (in *ResilienceScore) DeepCopy() *ResilienceScore {
+	if in == nil {
+		return nil
+	}
+	out := new(ResilienceScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.BackoffBase != nil {
+		in, out := &in.BackoffBase, &out.BackoffBase
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BackoffCap != nil {
+		in, out := &in.BackoffCap, &out.BackoffCap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxFailures != nil {
+		in, out := &in.MaxFailures, &out.MaxFailures
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Safeguards) DeepCopyInto(out *Safeguards) {
+	*out = *in
+	if in.MaxAffectedPercentage != nil {
+		in, out := &in.MaxAffectedPercentage, &out.MaxAffectedPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Safeguards.
+func (in *Safeguards) DeepCopy() *Safeguards {
+	if in == nil {
+		return nil
+	}
+	out := new(Safeguards)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleChaosAttackSpec) DeepCopyInto(out *ScaleChaosAttackSpec) {
+	*out = *in
+	if in.ScaleDownBy != nil {
+		in, out := &in.ScaleDownBy, &out.ScaleDownBy
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleChaosAttackSpec.
+func (in *ScaleChaosAttackSpec) DeepCopy() *ScaleChaosAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleChaosAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBlackholeAttackSpec) DeepCopyInto(out *ServiceBlackholeAttackSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBlackholeAttackSpec.
+func (in *ServiceBlackholeAttackSpec) DeepCopy() *ServiceBlackholeAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBlackholeAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackNotification) DeepCopyInto(out *SlackNotification) {
+	*out = *in
+	in.WebhookURLSecretRef.DeepCopyInto(&out.WebhookURLSecretRef)
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackNotification.
+func (in *SlackNotification) DeepCopy() *SlackNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetFailure) DeepCopyInto(out *TargetFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetFailure.
+func (in *TargetFailure) DeepCopy() *TargetFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateParameter) DeepCopyInto(out *TemplateParameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateParameter.
+func (in *TemplateParameter) DeepCopy() *TemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSkewAttackSpec) DeepCopyInto(out *TimeSkewAttackSpec) {
+	*out = *in
+	out.Offset = in.Offset
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSkewAttackSpec.
+func (in *TimeSkewAttackSpec) DeepCopy() *TimeSkewAttackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSkewAttackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookNotification) DeepCopyInto(out *WebhookNotification) {
+	*out = *in
+	if in.AuthorizationSecretRef != nil {
+		in, out := &in.AuthorizationSecretRef, &out.AuthorizationSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookNotification.
+func (in *WebhookNotification) DeepCopy() *WebhookNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadReference) DeepCopyInto(out *WorkloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadReference.
+func (in *WorkloadReference) DeepCopy() *WorkloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadReference)
 	in.DeepCopyInto(out)
 	return out
 }
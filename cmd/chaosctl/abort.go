@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// abort requests experiments to be aborted by setting their AbortAnnotation.
+func abort(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("abort", "[flags] NAME...", "Aborts experiments and reverts their faults.", stderr)
+	namespace := namespaceFlag(flags, "default", "The namespace of the experiments.")
+	reason := flags.String("reason", "", "Why the experiments are aborted, recorded in their status.")
+	newClient := clusterFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return fail(stderr, err)
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{chaosv1alpha1.AbortAnnotation: *reason},
+		},
+	})
+	if err != nil {
+		return fail(stderr, err)
+	}
+	code := 0
+	for _, name := range flags.Args() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		experiment.Namespace = *namespace
+		experiment.Name = name
+		if err := c.Patch(context.Background(), experiment, client.RawPatch(types.MergePatchType, patch)); err != nil {
+			code = fail(stderr, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(stdout, "chaosexperiment %s/%s aborted\n", *namespace, name)
+	}
+	return code
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/runner"
+)
+
+// history prints the runs recorded in the history of an experiment. With
+// --follow it keeps printing new runs until the experiment has finished.
+func history(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("history", "[flags] NAME", "Prints the run history of an experiment.", stderr)
+	namespace := namespaceFlag(flags, "default", "The namespace of the experiment.")
+	follow := flags.Bool("follow", false, "Keep printing new runs until the experiment has finished.")
+	flags.BoolVar(follow, "f", false, "Shorthand for --follow.")
+	pollInterval := flags.Duration("poll-interval", 5*time.Second, "How often the experiment is checked on with --follow.")
+	newClient := clusterFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return fail(stderr, err)
+	}
+	ctx := ctrl.SetupSignalHandler()
+	key := client.ObjectKey{Namespace: *namespace, Name: flags.Arg(0)}
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TIME\tATTACK\tRESULT\tTARGETS\tERROR")
+	var printed time.Time
+	for {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		if err := c.Get(ctx, key, experiment); err != nil {
+			return fail(stderr, err)
+		}
+		for _, run := range experiment.Status.History {
+			if !run.Time.After(printed) {
+				continue
+			}
+			printed = run.Time.Time
+			attack := string(run.Attack)
+			if run.DryRun {
+				attack += " (dry run)"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", run.Time.UTC().Format(time.RFC3339), attack, run.Result,
+				strings.Join(run.Targets, ","), run.Error)
+		}
+		if err := w.Flush(); err != nil {
+			return fail(stderr, err)
+		}
+		if !*follow || runner.Finished(experiment) {
+			return 0
+		}
+		select {
+		case <-ctx.Done():
+			return 0
+		case <-time.After(*pollInterval):
+		}
+	}
+}
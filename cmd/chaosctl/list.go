@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// list prints a table of the experiments in one or all namespaces.
+func list(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("list", "[flags]", "Lists experiments across namespaces.", stderr)
+	namespace := namespaceFlag(flags, "", "Only list the experiments in this namespace. Defaults to all namespaces.")
+	selector := flags.String("selector", "", "Only list experiments whose labels match this selector.")
+	phase := flags.String("phase", "", "Only list experiments in these phases, separated by commas.")
+	newClient := clusterFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	opts := []client.ListOption{}
+	if *namespace != "" {
+		opts = append(opts, client.InNamespace(*namespace))
+	}
+	if *selector != "" {
+		parsed, err := labels.Parse(*selector)
+		if err != nil {
+			return fail(stderr, err)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: parsed})
+	}
+	c, err := newClient()
+	if err != nil {
+		return fail(stderr, err)
+	}
+	experiments := &chaosv1alpha1.ChaosExperimentList{}
+	if err := c.List(context.Background(), experiments, opts...); err != nil {
+		return fail(stderr, err)
+	}
+	var phases []string
+	if *phase != "" {
+		phases = strings.Split(*phase, ",")
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tPHASE\tATTACK\tITERATIONS\tVERDICT\tLAST RUN\tAGE")
+	for _, experiment := range experiments.Items {
+		if phases != nil && !slices.Contains(phases, string(experiment.Status.Phase)) {
+			continue
+		}
+		lastRun := "<none>"
+		if experiment.Status.LastRunTime != nil {
+			lastRun = age(experiment.Status.LastRunTime.Time)
+		}
+		verdict := string(experiment.Status.Verdict)
+		if verdict == "" {
+			verdict = "<none>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n", experiment.Namespace, experiment.Name, experiment.Status.Phase,
+			experiment.Spec.Attack.Type, experiment.Status.IterationsCompleted, verdict, lastRun, age(experiment.CreationTimestamp.Time))
+	}
+	if err := w.Flush(); err != nil {
+		return fail(stderr, err)
+	}
+	return 0
+}
+
+// age formats the time since t in its largest unit, like kubectl does.
+func age(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return shortDuration(time.Since(t))
+}
+
+// shortDuration formats a duration in its largest whole unit, like kubectl.
+func shortDuration(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command chaosctl inspects and controls ChaosExperiments from the command
+// line, and validates experiment manifests without a cluster.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(chaosv1alpha1.AddToScheme(scheme))
+}
+
+// command is a subcommand. It returns the exit code.
+type command struct {
+	summary string
+	run     func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = map[string]command{
+	"list":     {"List experiments across namespaces.", list},
+	"history":  {"Print the run history of an experiment, and follow it with -f.", history},
+	"abort":    {"Abort experiments.", abort},
+	"report":   {"Print the report of a finished experiment.", report},
+	"validate": {"Validate experiment manifests offline.", validate},
+	"simulate": {"Show which pods an experiment would affect and when.", simulate},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		if os.Args[1] != "help" && os.Args[1] != "-h" && os.Args[1] != "--help" {
+			_, _ = fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+		}
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	os.Exit(cmd.run(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// usage lists the subcommands.
+func usage(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "Usage: chaosctl COMMAND [flags] [ARGS]")
+	_, _ = fmt.Fprintln(w, "Commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_, _ = fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].summary)
+	}
+	_, _ = fmt.Fprintln(w, "Run chaosctl COMMAND -h for the flags of a command.")
+}
+
+// newFlagSet returns the flags of a subcommand.
+func newFlagSet(name, usage, summary string, stderr io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: chaosctl "+name+" "+usage)
+		_, _ = fmt.Fprintln(stderr, summary)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// namespaceFlag registers --namespace and its shorthand -n.
+func namespaceFlag(flags *flag.FlagSet, value, usage string) *string {
+	namespace := flags.String("namespace", value, usage)
+	flags.StringVar(namespace, "n", value, "Shorthand for --namespace.")
+	return namespace
+}
+
+// clusterFlags registers --kubeconfig and returns a function creating the
+// client once the flags are parsed.
+func clusterFlags(flags *flag.FlagSet) func() (client.Client, error) {
+	config.RegisterFlags(flags)
+	return func() (client.Client, error) {
+		cfg, err := config.GetConfig()
+		if err != nil {
+			return nil, err
+		}
+		return client.New(cfg, client.Options{Scheme: scheme})
+	}
+}
+
+// fail prints err and returns the exit code 1.
+func fail(stderr io.Writer, err error) int {
+	_, _ = fmt.Fprintln(stderr, "error:", err)
+	return 1
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/controller"
+)
+
+// report prints the report the operator wrote for a finished experiment, as
+// text, or as the JSON or HTML stored in its ConfigMap.
+func report(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("report", "[flags] NAME", "Prints the report of a finished experiment.", stderr)
+	namespace := namespaceFlag(flags, "default", "The namespace of the experiment.")
+	output := flags.String("output", "text", "The format of the report: text, json or html.")
+	flags.StringVar(output, "o", "text", "Shorthand for --output.")
+	newClient := clusterFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	if *output != "text" && *output != "json" && *output != "html" {
+		return fail(stderr, fmt.Errorf("unknown output format %q", *output))
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return fail(stderr, err)
+	}
+	ctx := context.Background()
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: *namespace, Name: flags.Arg(0)}, experiment); err != nil {
+		return fail(stderr, err)
+	}
+	if experiment.Status.ReportRef == nil {
+		return fail(stderr, fmt.Errorf("chaosexperiment %s/%s has no report yet", experiment.Namespace, experiment.Name))
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: experiment.Namespace, Name: experiment.Status.ReportRef.Name}, configMap); err != nil {
+		return fail(stderr, err)
+	}
+
+	switch *output {
+	case "json":
+		_, err = fmt.Fprintln(stdout, configMap.Data[controller.ReportJSONKey])
+	case "html":
+		_, err = fmt.Fprint(stdout, configMap.Data[controller.ReportHTMLKey])
+	default:
+		summary := &controller.ExperimentReport{}
+		if err = json.Unmarshal([]byte(configMap.Data[controller.ReportJSONKey]), summary); err == nil {
+			err = writeReport(stdout, summary)
+		}
+	}
+	if err != nil {
+		return fail(stderr, err)
+	}
+	return 0
+}
+
+// writeReport renders a report as text.
+func writeReport(out io.Writer, report *controller.ExperimentReport) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Experiment:\t%s/%s\n", report.Namespace, report.Experiment)
+	_, _ = fmt.Fprintf(w, "Attack:\t%s\n", report.Attack)
+	_, _ = fmt.Fprintf(w, "Phase:\t%s\n", report.Phase)
+	if report.Verdict != "" {
+		_, _ = fmt.Fprintf(w, "Verdict:\t%s\n", report.Verdict)
+	}
+	if report.Message != "" {
+		_, _ = fmt.Fprintf(w, "Message:\t%s\n", report.Message)
+	}
+	if report.StartTime != nil {
+		_, _ = fmt.Fprintf(w, "Started:\t%s\n", report.StartTime.UTC().Format(time.RFC3339))
+	}
+	if report.CompletionTime != nil {
+		_, _ = fmt.Fprintf(w, "Finished:\t%s\n", report.CompletionTime.UTC().Format(time.RFC3339))
+	}
+	_, _ = fmt.Fprintf(w, "Iterations:\t%d\n", report.Iterations)
+	if len(report.Targets) > 0 {
+		_, _ = fmt.Fprintf(w, "Targets:\t%s\n", strings.Join(report.Targets, ", "))
+	}
+	if report.Resilience != nil {
+		_, _ = fmt.Fprintf(w, "Resilience score:\t%d\n", report.Resilience.Score)
+	}
+	if len(report.ProbeResults) > 0 {
+		_, _ = fmt.Fprintln(w, "\nPROBE\tPHASE\tPASSED\tMESSAGE")
+		for _, result := range report.ProbeResults {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%t\t%s\n", result.Name, result.Phase, result.Passed, result.Message)
+		}
+	}
+	if len(report.Timeline) > 0 {
+		_, _ = fmt.Fprintln(w, "\nTIME\tATTACK\tRESULT\tTARGETS\tERROR")
+		for _, run := range report.Timeline {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", run.Time.UTC().Format(time.RFC3339), run.Attack, run.Result,
+				strings.Join(run.Targets, ","), run.Error)
+		}
+	}
+	if len(report.Events) > 0 {
+		_, _ = fmt.Fprintln(w, "\nTIME\tTYPE\tREASON\tMESSAGE")
+		for _, event := range report.Events {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", event.Time.UTC().Format(time.RFC3339), event.Type, event.Reason, event.Message)
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/controller"
+	webhookv1alpha1 "kubechaos-operator/internal/webhook/v1alpha1"
+)
+
+// simulate prints which targets the iterations of an experiment would affect
+// and when, without creating it. The cluster is only read.
+func simulate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("simulate", "[flags] -f FILE", "Shows which pods an experiment would affect and when, without creating it.", stderr)
+	file := flags.String("f", "", "The manifest of the experiment; - reads standard input.")
+	namespace := namespaceFlag(flags, "default", "The namespace of the experiment if its manifest does not set one.")
+	runs := flags.Int("runs", 5, "How many iterations to simulate at most.")
+	protectedNamespaces := flags.String("protected-namespaces", strings.Join(controller.DefaultProtectedNamespaces, ","),
+		"The namespaces protected by the operator, separated by commas.")
+	requireOptIn := flags.Bool("require-namespace-opt-in", false,
+		"Whether the operator requires target namespaces to be labeled "+controller.OptInLabel+"=true.")
+	newClient := clusterFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *file == "" || flags.NArg() > 0 || *runs < 1 {
+		flags.Usage()
+		return 2
+	}
+
+	experiment, err := readExperiment(*file, stdin)
+	if err != nil {
+		return fail(stderr, fmt.Errorf("%s: %w", *file, err))
+	}
+	if experiment.Namespace == "" {
+		experiment.Namespace = *namespace
+	}
+	c, err := newClient()
+	if err != nil {
+		return fail(stderr, err)
+	}
+	ctx := context.Background()
+	defaulter := &webhookv1alpha1.ChaosExperimentCustomDefaulter{Client: c}
+	if err := defaulter.Default(ctx, experiment); err != nil {
+		return fail(stderr, err)
+	}
+
+	var protected []string
+	for _, name := range strings.Split(*protectedNamespaces, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			protected = append(protected, name)
+		}
+	}
+	reconciler := &controller.ChaosExperimentReconciler{
+		Client:                c,
+		Scheme:                scheme,
+		ProtectedNamespaces:   protected,
+		RequireNamespaceOptIn: *requireOptIn,
+	}
+	simulation, err := reconciler.Simulate(ctx, experiment, time.Now(), *runs)
+	if err != nil {
+		return fail(stderr, err)
+	}
+	if simulation.Blocked != "" {
+		_, _ = fmt.Fprintf(stdout, "%s/%s would not run: %s\n", experiment.Namespace, experiment.Name, simulation.Blocked)
+		return 0
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, "RUN\tTIME\tIN\tTARGETS")
+	for i, run := range simulation.Runs {
+		targets := strings.Join(run.Targets, ", ")
+		if run.Skipped != "" {
+			targets = "none: " + run.Skipped
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i+1, run.Time.Local().Format(time.RFC3339), shortDuration(max(time.Until(run.Time), 0)), targets)
+	}
+	if err := w.Flush(); err != nil {
+		return fail(stderr, err)
+	}
+	if experiment.Spec.DryRun {
+		_, _ = fmt.Fprintln(stdout, "The experiment is a dry run and would only record these targets.")
+	}
+	return 0
+}
+
+// readExperiment reads the single ChaosExperiment in a file, or in stdin if
+// the path is "-".
+func readExperiment(path string, stdin io.Reader) (*chaosv1alpha1.ChaosExperiment, error) {
+	objects, err := readManifests(path, stdin)
+	if err != nil {
+		return nil, err
+	}
+	var experiment *chaosv1alpha1.ChaosExperiment
+	for _, object := range objects {
+		if object["kind"] != "ChaosExperiment" {
+			continue
+		}
+		if experiment != nil {
+			return nil, errors.New("more than one ChaosExperiment")
+		}
+		experiment = &chaosv1alpha1.ChaosExperiment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object, experiment); err != nil {
+			return nil, err
+		}
+	}
+	if experiment == nil {
+		return nil, errors.New("no ChaosExperiment")
+	}
+	return experiment, nil
+}
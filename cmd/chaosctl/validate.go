@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/config/crd"
+	"kubechaos-operator/internal/validation"
+	webhookv1alpha1 "kubechaos-operator/internal/webhook/v1alpha1"
+)
+
+// manifest is an object read from a file.
+type manifest struct {
+	file   string
+	object map[string]any
+}
+
+// validate checks manifests against the schemas of the CustomResourceDefinitions
+// of the operator, and ChaosExperiments also against the defaulting webhook:
+// templates are instantiated from the ChaosExperimentTemplates among the
+// manifests, and the defaulted experiment is checked again. It needs no
+// cluster.
+func validate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := newFlagSet("validate", "[FILE...]", "Validates manifests offline; - or no FILE reads standard input.", stderr)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	schemas, err := validation.Load(crd.Bases)
+	if err != nil {
+		return fail(stderr, err)
+	}
+	var manifests []manifest
+	templates := templateReader{}
+	for _, file := range files {
+		objects, err := readManifests(file, stdin)
+		if err != nil {
+			return fail(stderr, fmt.Errorf("%s: %w", file, err))
+		}
+		for _, object := range objects {
+			manifests = append(manifests, manifest{file: file, object: object})
+			if object["kind"] == "ChaosExperimentTemplate" {
+				template := &chaosv1alpha1.ChaosExperimentTemplate{}
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object, template); err == nil {
+					templates[template.Name] = template
+				}
+			}
+		}
+	}
+
+	code := 0
+	for _, m := range manifests {
+		apiVersion, _ := m.object["apiVersion"].(string)
+		kind, _ := m.object["kind"].(string)
+		metadata, _ := m.object["metadata"].(map[string]any)
+		name, _ := metadata["name"].(string)
+		if name == "" {
+			name, _ = metadata["generateName"].(string)
+		}
+		gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+		if !schemas.Knows(gvk) {
+			_, _ = fmt.Fprintf(stdout, "%s: %s: skipped, not a resource of the operator\n", m.file, strings.TrimSpace(kind+" "+name))
+			continue
+		}
+
+		problems := []string{}
+		for _, err := range schemas.Validate(runtime.DeepCopyJSON(m.object)) {
+			problems = append(problems, err.Error())
+		}
+		if len(problems) == 0 && gvk == chaosv1alpha1.GroupVersion.WithKind("ChaosExperiment") {
+			problems = append(problems, validateDefaulted(schemas, templates, m.object)...)
+		}
+		if len(problems) == 0 {
+			_, _ = fmt.Fprintf(stdout, "%s: %s %s: valid\n", m.file, kind, name)
+			continue
+		}
+		code = 1
+		_, _ = fmt.Fprintf(stdout, "%s: %s %s: invalid\n", m.file, kind, name)
+		for _, problem := range problems {
+			_, _ = fmt.Fprintf(stdout, "  - %s\n", problem)
+		}
+	}
+	return code
+}
+
+// validateDefaulted applies the defaulting webhook to an experiment and
+// checks the result against the schema again.
+func validateDefaulted(schemas *validation.Schemas, templates templateReader, object map[string]any) []string {
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(runtime.DeepCopyJSON(object), experiment); err != nil {
+		return []string{err.Error()}
+	}
+	defaulter := &webhookv1alpha1.ChaosExperimentCustomDefaulter{Client: templates}
+	if err := defaulter.Default(context.Background(), experiment); err != nil {
+		return []string{"admission webhook: " + err.Error()}
+	}
+	defaulted, err := runtime.DefaultUnstructuredConverter.ToUnstructured(experiment)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	delete(defaulted, "status")
+	var problems []string
+	for _, err := range schemas.Validate(defaulted) {
+		problems = append(problems, "after defaulting: "+err.Error())
+	}
+	return problems
+}
+
+// readManifests reads the YAML or JSON documents in a file, or in stdin if
+// the path is "-".
+func readManifests(path string, stdin io.Reader) ([]map[string]any, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	var objects []map[string]any
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return nil, err
+		}
+		object := map[string]any{}
+		if err := json.Unmarshal(encoded, &object); err != nil {
+			return nil, err
+		}
+		if len(object) > 0 {
+			objects = append(objects, object)
+		}
+	}
+}
+
+// templateReader serves the ChaosExperimentTemplates among the manifests to
+// the defaulting webhook.
+type templateReader map[string]*chaosv1alpha1.ChaosExperimentTemplate
+
+func (r templateReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	template, ok := r[key.Name]
+	if !ok {
+		return apierrors.NewNotFound(chaosv1alpha1.GroupVersion.WithResource("chaosexperimenttemplates").GroupResource(), key.Name)
+	}
+	template.DeepCopyInto(obj.(*chaosv1alpha1.ChaosExperimentTemplate))
+	return nil
+}
+
+func (r templateReader) List(context.Context, client.ObjectList, ...client.ListOption) error {
+	return errors.New("listing is not supported offline")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"kubechaos-operator/internal/litmus"
+)
+
+// importLitmus implements the import-litmus subcommand: it converts the
+// LitmusChaos manifests in the given files, or standard input, and prints the
+// resulting ChaosExperiments as YAML. It returns the exit code.
+func importLitmus(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("import-litmus", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	namespace := flags.String("namespace", "", "The namespace of the experiments. Defaults to the namespace of their ChaosEngine.")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: manager import-litmus [--namespace NAMESPACE] [FILE...]")
+		_, _ = fmt.Fprintln(stderr, "Converts LitmusChaos ChaosEngine and ChaosExperiment manifests into ChaosExperiments.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	var readers []io.Reader
+	for _, path := range flags.Args() {
+		file, err := os.Open(path)
+		if err != nil {
+			_, _ = fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer file.Close() //nolint:errcheck
+		readers = append(readers, file, strings.NewReader("\n---\n"))
+	}
+	if len(readers) == 0 {
+		readers = append(readers, stdin)
+	}
+
+	result, err := litmus.Convert(io.MultiReader(readers...))
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "error:", err)
+		return 1
+	}
+	for _, warning := range result.Warnings {
+		_, _ = fmt.Fprintln(stderr, "warning:", warning)
+	}
+	for i := range result.Experiments {
+		experiment := &result.Experiments[i]
+		if *namespace != "" {
+			experiment.Namespace = *namespace
+		}
+		data, err := yaml.Marshal(experiment)
+		if err != nil {
+			_, _ = fmt.Fprintln(stderr, "error:", err)
+			return 1
+		}
+		_, _ = fmt.Fprintf(stdout, "---\n%s", data)
+	}
+	return 0
+}
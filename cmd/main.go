@@ -17,18 +17,27 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"crypto/ed25519"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -36,7 +45,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/analysis"
+	"kubechaos-operator/internal/api"
+	"kubechaos-operator/internal/audit"
 	"kubechaos-operator/internal/controller"
+	"kubechaos-operator/internal/management"
+	webhookv1alpha1 "kubechaos-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -54,6 +68,16 @@ func init() {
 
 // nolint:gocyclo
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import-litmus" {
+		os.Exit(importLitmus(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "run-experiment" {
+		os.Exit(runExperiment(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "verify-audit-log" {
+		os.Exit(verifyAuditLog(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
+
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
@@ -61,6 +85,27 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var helperImage string
+	var protectedNamespaces string
+	var watchNamespaces string
+	var prometheusURL string
+	var policyURL string
+	var requireNamespaceOptIn bool
+	var defaultMode, defaultSelectionStrategy string
+	var defaultGracePeriodSeconds int64
+	var defaultMaxAffectedPercentage int
+	var defaultSafeguardWindow time.Duration
+	var auditLogPath string
+	var auditSigningKeySecret string
+	var analysisAddr string
+	var apiAddr string
+	var grpcAddr, grpcCertPath string
+	var maxConcurrentReconciles int
+	var podListPageSize int64
+	var leaseDuration, renewDeadline, retryPeriod time.Duration
+	var podCacheLabels string
+	var podKillWorkers int
+	var experimentWorkers, scheduleWorkers, gameDayWorkers int
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -68,6 +113,12 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&leaseDuration, "leader-elect-lease-duration", 15*time.Second,
+		"How long a new leader waits before taking over from a leader that stopped renewing its lease.")
+	flag.DurationVar(&renewDeadline, "leader-elect-renew-deadline", 10*time.Second,
+		"How long the leader keeps trying to renew its lease before it steps down. Must be less than the lease duration.")
+	flag.DurationVar(&retryPeriod, "leader-elect-retry-period", 2*time.Second,
+		"How often leader election clients try to acquire or renew the lease.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
@@ -79,6 +130,65 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"How many objects each controller reconciles at once, unless its own worker count is set.")
+	flag.IntVar(&experimentWorkers, "chaosexperiment-workers", 4,
+		"How many ChaosExperiments are reconciled at once. Zero uses --max-concurrent-reconciles.")
+	flag.IntVar(&scheduleWorkers, "chaosschedule-workers", 0,
+		"How many ChaosSchedules are reconciled at once. Zero uses --max-concurrent-reconciles.")
+	flag.IntVar(&gameDayWorkers, "gameday-workers", 0,
+		"How many GameDays are reconciled at once. Zero uses --max-concurrent-reconciles.")
+	flag.IntVar(&podKillWorkers, "pod-kill-workers", controller.DefaultPodKillWorkers,
+		"The number of pods a pod-kill iteration deletes or evicts at once.")
+	flag.Int64Var(&podListPageSize, "pod-list-page-size", 0,
+		"If set, target pods are listed from the API server in pages of this many pods instead of from the cache, "+
+			"and pods are picked at random without holding all of them. Zero lists them from the cache.")
+	flag.StringVar(&podCacheLabels, "pod-cache-labels", "",
+		"Comma-separated key=value labels. If set, only pods with these labels are cached and can be targeted, "+
+			"which keeps the operator small on large clusters. Helper pods get the labels too.")
+	flag.StringVar(&helperImage, "chaos-helper-image", controller.DefaultHelperImage,
+		"The image used for the privileged helper pods that run attacks inside target containers.")
+	flag.StringVar(&protectedNamespaces, "protected-namespaces", strings.Join(controller.DefaultProtectedNamespaces, ","),
+		"Comma separated list of namespaces that experiments are not allowed to target.")
+	flag.StringVar(&watchNamespaces, "watch-namespaces", "",
+		"Comma separated list of namespaces the operator watches and acts in, so that it only needs Roles in them. "+
+			"Experiments targeting other namespaces fail. Empty watches the whole cluster.")
+	flag.StringVar(&prometheusURL, "prometheus-url", "",
+		"The base URL of the Prometheus API that abort conditions of experiments are evaluated against.")
+	flag.StringVar(&policyURL, "policy-url", "",
+		"The endpoint of an OPA-style policy every attack is authorized against, e.g. http://opa:8181/v1/data/chaos/allow. "+
+			"Attacks the policy denies are skipped. Empty authorizes every attack.")
+	flag.BoolVar(&requireNamespaceOptIn, "require-namespace-opt-in", false,
+		"If set, experiments only run against namespaces labeled "+controller.OptInLabel+"=true.")
+	flag.StringVar(&defaultMode, "default-mode", string(chaosv1alpha1.OneShotMode),
+		"The mode the defaulting webhook sets on experiments that do not specify one.")
+	flag.StringVar(&defaultSelectionStrategy, "default-selection-strategy", string(chaosv1alpha1.SelectRandom),
+		"The target selection strategy the defaulting webhook sets on experiments that do not specify one.")
+	flag.Int64Var(&defaultGracePeriodSeconds, "default-grace-period-seconds", -1,
+		"The grace period the defaulting webhook sets on pod-kill attacks that do not specify one. "+
+			"Negative values leave the grace period of the pod in place.")
+	flag.IntVar(&defaultMaxAffectedPercentage, "default-max-affected-percentage", 0,
+		"The safeguards.maxAffectedPercentage the defaulting webhook sets on experiments that do not specify one. "+
+			"Zero leaves it unset.")
+	flag.DurationVar(&defaultSafeguardWindow, "default-safeguard-window", 0,
+		"The safeguards.window the defaulting webhook sets together with --default-max-affected-percentage.")
+	flag.StringVar(&auditLogPath, "audit-log", "",
+		"The file every change the operator makes to the cluster is appended to as JSON lines, or - for standard output. "+
+			"Empty disables the audit log.")
+	flag.StringVar(&auditSigningKeySecret, "audit-signing-key-secret", "",
+		"The namespace/name of a Secret whose "+audit.SigningKeySecretKey+" key holds a PEM-encoded Ed25519 private key "+
+			"every audit record is signed with. Empty leaves the records unsigned.")
+	flag.StringVar(&analysisAddr, "analysis-bind-address", "0",
+		"The address the endpoint for Argo Rollouts analysis and Flagger webhooks binds to, e.g. :8082. "+
+			"Leave as 0 to disable it.")
+	flag.StringVar(&apiAddr, "api-bind-address", "0",
+		"The address the read-only API over experiments, results and reports binds to, e.g. :8083. "+
+			"Leave as 0 to disable it.")
+	flag.StringVar(&grpcAddr, "grpc-bind-address", "0",
+		"The address the gRPC management API binds to, e.g. :9090. Leave as 0 to disable it.")
+	flag.StringVar(&grpcCertPath, "grpc-cert-path", "",
+		"The directory that contains the certificate tls.crt and key tls.key of the gRPC management API. "+
+			"If empty, the API is served without TLS.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -154,37 +264,148 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	podLabels, err := labels.ConvertSelectorToLabelsMap(podCacheLabels)
+	if err != nil {
+		setupLog.Error(err, "invalid pod cache labels", "pod-cache-labels", podCacheLabels)
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  controller.CacheOptions(podLabels, splitList(watchNamespaces)),
+		Client:                 client.Options{Cache: &client.CacheOptions{DisableFor: controller.UncachedObjects}},
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "134d7cf7.shanto.dev",
-		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
-		// when the Manager ends. This requires the binary to immediately end when the
-		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
-		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
+		LeaseDuration:          &leaseDuration,
+		RenewDeadline:          &renewDeadline,
+		RetryPeriod:            &retryPeriod,
+		// The leader steps down when the manager stops, so a standby replica
+		// takes over right away instead of waiting for the lease to expire.
+		// This is safe because the program ends as soon as the manager stops.
+		LeaderElectionReleaseOnCancel: true,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	// Attribute every change the operator makes to its field manager.
+	wrapClient := func(c client.Client) client.Client {
+		return client.WithFieldOwner(c, controller.FieldManager)
+	}
+	if auditSigningKeySecret != "" && auditLogPath == "" {
+		setupLog.Error(nil, "--audit-signing-key-secret requires --audit-log")
+		os.Exit(1)
+	}
+	if auditLogPath != "" {
+		auditLog, err := audit.Open(auditLogPath)
+		if err != nil {
+			setupLog.Error(err, "unable to open audit log", "path", auditLogPath)
+			os.Exit(1)
+		}
+		if auditSigningKeySecret != "" {
+			key, err := signingKey(mgr.GetAPIReader(), auditSigningKeySecret)
+			if err != nil {
+				setupLog.Error(err, "unable to load audit signing key", "secret", auditSigningKeySecret)
+				os.Exit(1)
+			}
+			auditLog.SignWith(key)
+		}
+		withFieldOwner := wrapClient
+		wrapClient = func(c client.Client) client.Client {
+			return audit.NewClient(withFieldOwner(c), auditLog)
+		}
+	}
+	experimentClient := wrapClient(mgr.GetClient())
+	// Experiments with a ServiceAccount act on their targets as it.
+	impersonatingClients := &controller.ImpersonatingClients{
+		Config:  mgr.GetConfig(),
+		Options: client.Options{Scheme: mgr.GetScheme(), Mapper: mgr.GetRESTMapper()},
+		Wrap:    wrapClient,
+	}
+
 	if err := (&controller.ChaosExperimentReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:                  experimentClient,
+		Scheme:                  mgr.GetScheme(),
+		HelperImage:             helperImage,
+		ProtectedNamespaces:     splitList(protectedNamespaces),
+		WatchNamespaces:         splitList(watchNamespaces),
+		PrometheusURL:           prometheusURL,
+		PolicyURL:               policyURL,
+		RequireNamespaceOptIn:   requireNamespaceOptIn,
+		APIReader:               mgr.GetAPIReader(),
+		PodListPageSize:         podListPageSize,
+		PodCacheLabels:          podLabels,
+		PodKillWorkers:          podKillWorkers,
+		ImpersonatingClient:     impersonatingClients.For,
+		MaxConcurrentReconciles: workers(experimentWorkers, maxConcurrentReconciles),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ChaosExperiment")
 		os.Exit(1)
 	}
+	if err := (&controller.ChaosScheduleReconciler{
+		Client:                  experimentClient,
+		Scheme:                  mgr.GetScheme(),
+		MaxConcurrentReconciles: workers(scheduleWorkers, maxConcurrentReconciles),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ChaosSchedule")
+		os.Exit(1)
+	}
+	if err := (&controller.GameDayReconciler{
+		Client:                  experimentClient,
+		Scheme:                  mgr.GetScheme(),
+		MaxConcurrentReconciles: workers(gameDayWorkers, maxConcurrentReconciles),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GameDay")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		defaults := webhookv1alpha1.ExperimentDefaults{
+			Mode:              chaosv1alpha1.ExperimentMode(defaultMode),
+			SelectionStrategy: chaosv1alpha1.SelectionStrategy(defaultSelectionStrategy),
+		}
+		if defaultGracePeriodSeconds >= 0 {
+			defaults.GracePeriodSeconds = &defaultGracePeriodSeconds
+		}
+		if defaultMaxAffectedPercentage > 0 {
+			percentage := int32(defaultMaxAffectedPercentage)
+			defaults.Safeguards = &chaosv1alpha1.Safeguards{MaxAffectedPercentage: &percentage}
+			if defaultSafeguardWindow > 0 {
+				defaults.Safeguards.Window = &metav1.Duration{Duration: defaultSafeguardWindow}
+			}
+		}
+		if err := webhookv1alpha1.SetupChaosExperimentWebhookWithManager(mgr, defaults); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ChaosExperiment")
+			os.Exit(1)
+		}
+	}
+	if analysisAddr != "" && analysisAddr != "0" {
+		if err := mgr.Add(&analysis.Server{Client: experimentClient, Addr: analysisAddr}); err != nil {
+			setupLog.Error(err, "unable to add analysis endpoint")
+			os.Exit(1)
+		}
+	}
+	if apiAddr != "" && apiAddr != "0" {
+		if err := mgr.Add(&api.Server{Reader: mgr.GetClient(), Addr: apiAddr}); err != nil {
+			setupLog.Error(err, "unable to add API server")
+			os.Exit(1)
+		}
+	}
+	if grpcAddr != "" && grpcAddr != "0" {
+		if err := mgr.Add(&management.Server{
+			Client:  experimentClient,
+			Reviews: mgr.GetClient(),
+			Addr:    grpcAddr,
+			CertDir: grpcCertPath,
+		}); err != nil {
+			setupLog.Error(err, "unable to add management API")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -202,3 +423,41 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// workers returns the worker count of a controller, falling back to the
+// default when the controller does not set its own.
+func workers(count, fallback int) int {
+	if count > 0 {
+		return count
+	}
+	return max(fallback, 1)
+}
+
+// signingKey reads the audit signing key from the Secret named by ref, as
+// namespace/name.
+func signingKey(reader client.Reader, ref string) (ed25519.PrivateKey, error) {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" {
+		return nil, fmt.Errorf("%q is not of the form namespace/name", ref)
+	}
+	secret := &corev1.Secret{}
+	if err := reader.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, err
+	}
+	data, ok := secret.Data[audit.SigningKeySecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no key %s", ref, audit.SigningKeySecretKey)
+	}
+	return audit.ParsePrivateKey(data)
+}
+
+// splitList splits a comma separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
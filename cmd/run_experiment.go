@@ -0,0 +1,155 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/runner"
+)
+
+// runExperiment implements the run-experiment subcommand, the entrypoint of
+// workflow steps and CI Jobs that run an experiment: it creates the
+// ChaosExperiment read from a file, or standard input, prints its status as
+// it changes, waits until it has finished and exits with 0 if it completed and
+// its hypothesis held, and 1 otherwise. It returns the exit code.
+func runExperiment(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("run-experiment", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	file := flags.String("f", "-", "The file holding the ChaosExperiment manifest, or - for standard input.")
+	namespace := flags.String("namespace", "", "The namespace the experiment is created in. Defaults to the namespace of the manifest, or default.")
+	timeout := flags.Duration("timeout", time.Hour, "How long to wait for the experiment to finish before deleting it. Zero waits forever.")
+	pollInterval := flags.Duration("poll-interval", 5*time.Second, "How often the experiment is checked on.")
+	deleteAfter := flags.Bool("delete", false, "Delete the experiment once it has finished.")
+	replace := flags.Bool("replace", false, "Delete an experiment of the same name first, so that the same manifest can be run again.")
+	quiet := flags.Bool("quiet", false, "Do not print the status of the experiment while waiting.")
+	outputDir := flags.String("output-dir", "", "A directory the name, phase, verdict and message of the experiment are written to, "+
+		"one file each, e.g. for the output parameters of an Argo Workflows step.")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: manager run-experiment [flags]")
+		_, _ = fmt.Fprintln(stderr, "Creates a ChaosExperiment, waits for it to finish and exits with 1 unless it completed with its hypothesis holding.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	experiment, err := readExperiment(*file, stdin)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "error:", err)
+		return 1
+	}
+	if *namespace != "" {
+		experiment.Namespace = *namespace
+	}
+	if experiment.Namespace == "" {
+		experiment.Namespace = "default"
+	}
+
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "error:", err)
+		return 1
+	}
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "error:", err)
+		return 1
+	}
+
+	options := runner.Options{
+		PollInterval: *pollInterval,
+		Timeout:      *timeout,
+		Delete:       *deleteAfter,
+		Replace:      *replace,
+	}
+	if !*quiet {
+		options.OnUpdate = func(experiment *chaosv1alpha1.ChaosExperiment) {
+			_, _ = fmt.Fprintf(stdout, "%s phase=%s iterations=%d verdict=%s %s\n", time.Now().UTC().Format(time.RFC3339),
+				experiment.Status.Phase, experiment.Status.IterationsCompleted, experiment.Status.Verdict, experiment.Status.Message)
+		}
+	}
+	outcome, err := runner.Run(ctrl.SetupSignalHandler(), c, experiment, options)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, "error:", err)
+		return 1
+	}
+	_, _ = fmt.Fprintf(stdout, "experiment %s/%s finished: phase=%s verdict=%s iterations=%d\n%s\n",
+		experiment.Namespace, outcome.Name, outcome.Phase, outcome.Verdict, outcome.Iterations, outcome.Message)
+	if *outputDir != "" {
+		if err := writeOutcome(*outputDir, outcome); err != nil {
+			_, _ = fmt.Fprintln(stderr, "error:", err)
+			return 1
+		}
+	}
+	if !outcome.Succeeded() {
+		return 1
+	}
+	return 0
+}
+
+// readExperiment reads a ChaosExperiment manifest from a file, or from stdin
+// if the path is "-".
+func readExperiment(path string, stdin io.Reader) (*chaosv1alpha1.ChaosExperiment, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	if err := yaml.UnmarshalStrict(data, experiment); err != nil {
+		return nil, fmt.Errorf("decoding experiment: %w", err)
+	}
+	if experiment.APIVersion != chaosv1alpha1.GroupVersion.String() || experiment.Kind != "ChaosExperiment" {
+		return nil, fmt.Errorf("manifest is a %s %s, not a %s ChaosExperiment", experiment.APIVersion, experiment.Kind, chaosv1alpha1.GroupVersion)
+	}
+	experiment.ResourceVersion = ""
+	return experiment, nil
+}
+
+// writeOutcome writes the fields of the outcome to one file each in dir.
+func writeOutcome(dir string, outcome *runner.Outcome) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, value := range map[string]string{
+		"name":    outcome.Name,
+		"phase":   string(outcome.Phase),
+		"verdict": string(outcome.Verdict),
+		"message": outcome.Message,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(value), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
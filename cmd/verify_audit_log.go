@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/ed25519"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"kubechaos-operator/internal/audit"
+)
+
+// verifyAuditLog implements the verify-audit-log subcommand: it checks the
+// hash chain, and with --public-key the signatures, of the audit logs in the
+// given files, or standard input. It returns the exit code.
+func verifyAuditLog(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("verify-audit-log", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	publicKeyPath := flags.String("public-key", "", "A PEM-encoded Ed25519 public key every record has to be signed with.")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: manager verify-audit-log [--public-key FILE] [FILE...]")
+		_, _ = fmt.Fprintln(stderr, "Verifies that audit logs written with --audit-log were not modified.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	var key ed25519.PublicKey
+	if *publicKeyPath != "" {
+		data, err := os.ReadFile(*publicKeyPath)
+		if err != nil {
+			_, _ = fmt.Fprintln(stderr, "error:", err)
+			return 1
+		}
+		if key, err = audit.ParsePublicKey(data); err != nil {
+			_, _ = fmt.Fprintf(stderr, "error: %s: %v\n", *publicKeyPath, err)
+			return 1
+		}
+	}
+
+	verify := func(name string, in io.Reader) bool {
+		count, err := audit.Verify(in, key)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "%s: %v\n", name, err)
+			return false
+		}
+		_, _ = fmt.Fprintf(stdout, "%s: %d records verified\n", name, count)
+		return true
+	}
+	if flags.NArg() == 0 {
+		if !verify("-", stdin) {
+			return 1
+		}
+		return 0
+	}
+	code := 0
+	for _, path := range flags.Args() {
+		file, err := os.Open(path)
+		if err != nil {
+			_, _ = fmt.Fprintln(stderr, "error:", err)
+			code = 1
+			continue
+		}
+		if !verify(path, file) {
+			code = 1
+		}
+		_ = file.Close()
+	}
+	return code
+}
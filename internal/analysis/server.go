@@ -0,0 +1,187 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package analysis serves an HTTP endpoint that runs a ChaosExperiment from
+// a ChaosExperimentTemplate and answers with its verdict, for Argo Rollouts
+// analysis and Flagger webhooks to gate promotion on.
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/runner"
+)
+
+// AnalysisOfAnnotation records the namespace/name of the canary an
+// experiment was run for.
+const AnalysisOfAnnotation = "chaos.shanto.dev/analysis-of"
+
+var log = logf.Log.WithName("analysis")
+
+// Payload is the body of a request. It has the shape of the payload Flagger
+// sends to webhooks; Metadata supplies the parameters of the template.
+type Payload struct {
+	Name      string            `json:"name,omitempty"`
+	Namespace string            `json:"namespace,omitempty"`
+	Phase     string            `json:"phase,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// Response is the body of a response.
+type Response struct {
+	Experiment string                        `json:"experiment,omitempty"`
+	Phase      chaosv1alpha1.ExperimentPhase `json:"phase,omitempty"`
+	Verdict    chaosv1alpha1.Verdict         `json:"verdict,omitempty"`
+	Iterations int32                         `json:"iterations,omitempty"`
+	Message    string                        `json:"message,omitempty"`
+	Succeeded  bool                          `json:"succeeded"`
+	Error      string                        `json:"error,omitempty"`
+}
+
+// Server runs experiments on request. It implements manager.Runnable.
+type Server struct {
+	// Client creates and watches the experiments.
+	Client client.Client
+
+	// Addr is the address the server listens on, e.g. ":8082".
+	Addr string
+
+	// PollInterval is how often running experiments are checked on.
+	// Defaults to 5s.
+	PollInterval time.Duration
+}
+
+// NeedLeaderElection lets every replica of the operator serve requests.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves requests until ctx is done.
+func (s *Server) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	log.Info("Serving analysis endpoint", "Addr", s.Addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Handler returns the handler of the endpoint. Each POST to
+// /rollouts/{namespace}/{template} or /flagger/{namespace}/{template} runs an
+// experiment from the template in the namespace and answers once it has
+// finished. Responses to Argo Rollouts always have status 200, so that the
+// analysis evaluates the body; responses to Flagger have status 200 only if
+// the experiment succeeded.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /rollouts/{namespace}/{template}", func(w http.ResponseWriter, req *http.Request) {
+		s.serve(w, req, false)
+	})
+	mux.HandleFunc("POST /flagger/{namespace}/{template}", func(w http.ResponseWriter, req *http.Request) {
+		s.serve(w, req, true)
+	})
+	return mux
+}
+
+// serve runs an experiment for a request. If the wait is cut short, e.g.
+// because the caller gave up, the experiment is deleted.
+func (s *Server) serve(w http.ResponseWriter, req *http.Request, gate bool) {
+	payload := Payload{}
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil && !errors.Is(err, io.EOF) {
+		respond(w, http.StatusBadRequest, Response{Error: "decoding payload: " + err.Error()})
+		return
+	}
+
+	experiment := experimentFor(req.PathValue("namespace"), req.PathValue("template"), payload)
+	outcome, err := runner.Run(req.Context(), s.Client, experiment, runner.Options{PollInterval: s.PollInterval})
+	if err != nil {
+		log.Error(err, "Failed to run experiment for analysis", "Namespace", experiment.Namespace, "Template", req.PathValue("template"))
+		status := http.StatusInternalServerError
+		// Rejections of the experiment, e.g. for an unknown template, are
+		// passed on.
+		var apiStatus apierrors.APIStatus
+		if errors.As(err, &apiStatus) && apiStatus.Status().Code >= 400 {
+			status = int(apiStatus.Status().Code)
+		}
+		respond(w, status, Response{Experiment: experiment.Name, Error: err.Error()})
+		return
+	}
+
+	response := Response{
+		Experiment: outcome.Name,
+		Phase:      outcome.Phase,
+		Verdict:    outcome.Verdict,
+		Iterations: outcome.Iterations,
+		Message:    outcome.Message,
+		Succeeded:  outcome.Succeeded(),
+	}
+	status := http.StatusOK
+	if gate && !response.Succeeded {
+		status = http.StatusPreconditionFailed
+	}
+	respond(w, status, response)
+}
+
+// experimentFor builds the experiment run for a request.
+func experimentFor(namespace, template string, payload Payload) *chaosv1alpha1.ChaosExperiment {
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	experiment.Namespace = namespace
+	experiment.GenerateName = template + "-"
+	if payload.Name != "" {
+		canaryNamespace := payload.Namespace
+		if canaryNamespace == "" {
+			canaryNamespace = namespace
+		}
+		experiment.Annotations = map[string]string{AnalysisOfAnnotation: canaryNamespace + "/" + payload.Name}
+	}
+	ref := &chaosv1alpha1.ExperimentTemplateRef{Name: template}
+	for name, value := range payload.Metadata {
+		if ref.Parameters == nil {
+			ref.Parameters = map[string]intstr.IntOrString{}
+		}
+		ref.Parameters[name] = intstr.FromString(value)
+	}
+	experiment.Spec.TemplateRef = ref
+	return experiment
+}
+
+// respond writes the response as JSON.
+func respond(w http.ResponseWriter, status int, response Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// stubClient finishes every experiment it creates with the given status.
+type stubClient struct {
+	client.Client
+	created *chaosv1alpha1.ChaosExperiment
+	status  chaosv1alpha1.ChaosExperimentStatus
+}
+
+func (c *stubClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	experiment := obj.(*chaosv1alpha1.ChaosExperiment)
+	if experiment.Spec.TemplateRef.Name == "missing" {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "chaos.shanto.dev", Resource: "chaosexperimenttemplates"}, "missing")
+	}
+	experiment.Name = experiment.GenerateName + "abcde"
+	c.created = experiment.DeepCopy()
+	return nil
+}
+
+func (c *stubClient) Get(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	obj.(*chaosv1alpha1.ChaosExperiment).Status = c.status
+	return nil
+}
+
+var _ = Describe("Analysis endpoint", func() {
+	post := func(c *stubClient, path, body string) (*http.Response, Response) {
+		server := httptest.NewServer((&Server{Client: c, PollInterval: time.Millisecond}).Handler())
+		defer server.Close()
+		resp, err := http.Post(server.URL+path, "application/json", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close() //nolint:errcheck
+		response := Response{}
+		Expect(json.NewDecoder(resp.Body).Decode(&response)).To(Succeed())
+		return resp, response
+	}
+
+	It("should run an experiment from the template for the canary", func() {
+		c := &stubClient{status: chaosv1alpha1.ChaosExperimentStatus{Phase: chaosv1alpha1.ExperimentCompleted, Verdict: chaosv1alpha1.VerdictPassed}}
+		resp, response := post(c, "/flagger/shop/pod-kill", `{"name":"checkout","namespace":"shop","phase":"Progressing","metadata":{"percentage":"50"}}`)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(response.Experiment).To(Equal("pod-kill-abcde"))
+		Expect(response.Succeeded).To(BeTrue())
+
+		Expect(c.created.Namespace).To(Equal("shop"))
+		Expect(c.created.Annotations).To(HaveKeyWithValue(AnalysisOfAnnotation, "shop/checkout"))
+		Expect(c.created.Spec.TemplateRef.Name).To(Equal("pod-kill"))
+		Expect(c.created.Spec.TemplateRef.Parameters).To(HaveKeyWithValue("percentage", intstr.FromString("50")))
+	})
+
+	It("should gate Flagger on the verdict and let Argo Rollouts evaluate it", func() {
+		c := &stubClient{status: chaosv1alpha1.ChaosExperimentStatus{Phase: chaosv1alpha1.ExperimentCompleted, Verdict: chaosv1alpha1.VerdictFailed}}
+		resp, response := post(c, "/flagger/shop/pod-kill", "")
+		Expect(resp.StatusCode).To(Equal(http.StatusPreconditionFailed))
+		Expect(response.Verdict).To(Equal(chaosv1alpha1.VerdictFailed))
+
+		resp, response = post(c, "/rollouts/shop/pod-kill", "")
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(response.Succeeded).To(BeFalse())
+	})
+
+	It("should pass on rejections of the experiment", func() {
+		resp, response := post(&stubClient{}, "/rollouts/shop/missing", "")
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(response.Error).To(ContainSubstring("not found"))
+	})
+})
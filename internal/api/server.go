@@ -0,0 +1,316 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package api serves a read-only HTTP API over the experiments, their runs,
+// results and reports, for portals that display chaos activity without
+// access to the Kubernetes API.
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/controller"
+)
+
+const (
+	// defaultLimit is the page size of lists that do not ask for one.
+	defaultLimit = 100
+	// maxLimit bounds the page size of lists.
+	maxLimit = 500
+)
+
+var log = logf.Log.WithName("api")
+
+// List is the body of responses to list requests. Continue is set if there
+// are more items, and is passed as the continue parameter to get them.
+type List[T any] struct {
+	Items    []T    `json:"items"`
+	Continue string `json:"continue,omitempty"`
+}
+
+// Error is the body of error responses.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// Server serves the API. It implements manager.Runnable.
+type Server struct {
+	// Reader reads the experiments, results and reports.
+	Reader client.Reader
+
+	// Addr is the address the server listens on, e.g. ":8083".
+	Addr string
+}
+
+// NeedLeaderElection lets every replica of the operator serve requests.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves requests until ctx is done.
+func (s *Server) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	log.Info("Serving API", "Addr", s.Addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Handler returns the handler of the API:
+//
+//	GET /api/v1/experiments                                  experiments
+//	GET /api/v1/namespaces/{namespace}/experiments/{name}         an experiment
+//	GET /api/v1/namespaces/{namespace}/experiments/{name}/runs    its iterations
+//	GET /api/v1/namespaces/{namespace}/experiments/{name}/report  its report
+//	GET /api/v1/results                                      ChaosResults
+//	GET /api/v1/namespaces/{namespace}/results/{name}             a ChaosResult
+//
+// Lists are filtered by the namespace and labelSelector parameters, and by
+// phase, attack and verdict for experiments, and experiment and result for
+// results. They are ordered by namespace and name and paginated with the
+// limit and continue parameters.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/experiments", s.listExperiments)
+	mux.HandleFunc("GET /api/v1/namespaces/{namespace}/experiments/{name}", s.getExperiment)
+	mux.HandleFunc("GET /api/v1/namespaces/{namespace}/experiments/{name}/runs", s.getRuns)
+	mux.HandleFunc("GET /api/v1/namespaces/{namespace}/experiments/{name}/report", s.getReport)
+	mux.HandleFunc("GET /api/v1/results", s.listResults)
+	mux.HandleFunc("GET /api/v1/namespaces/{namespace}/results/{name}", s.getResult)
+	return mux
+}
+
+func (s *Server) listExperiments(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	list := &chaosv1alpha1.ChaosExperimentList{}
+	if !s.list(w, req, list) {
+		return
+	}
+	items := slices.DeleteFunc(list.Items, func(experiment chaosv1alpha1.ChaosExperiment) bool {
+		return !matches(query.Get("phase"), string(experiment.Status.Phase)) ||
+			!matches(query.Get("attack"), string(experiment.Spec.Attack.Type)) ||
+			!matches(query.Get("verdict"), string(experiment.Status.Verdict))
+	})
+	for i := range items {
+		items[i].ManagedFields = nil
+	}
+	page(w, req, items, func(experiment chaosv1alpha1.ChaosExperiment) string {
+		return experiment.Namespace + "/" + experiment.Name
+	})
+}
+
+func (s *Server) getExperiment(w http.ResponseWriter, req *http.Request) {
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	if !s.get(w, req, experiment) {
+		return
+	}
+	experiment.ManagedFields = nil
+	respond(w, http.StatusOK, experiment)
+}
+
+func (s *Server) getRuns(w http.ResponseWriter, req *http.Request) {
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	if !s.get(w, req, experiment) {
+		return
+	}
+	runs := experiment.Status.History
+	if runs == nil {
+		runs = []chaosv1alpha1.IterationRecord{}
+	}
+	respond(w, http.StatusOK, List[chaosv1alpha1.IterationRecord]{Items: runs})
+}
+
+// getReport serves the report of the experiment as JSON, or as HTML with the
+// parameter format=html.
+func (s *Server) getReport(w http.ResponseWriter, req *http.Request) {
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	if !s.get(w, req, experiment) {
+		return
+	}
+	if experiment.Status.ReportRef == nil {
+		respond(w, http.StatusNotFound, Error{Error: "the experiment has no report yet"})
+		return
+	}
+	configMap := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: experiment.Namespace, Name: experiment.Status.ReportRef.Name}
+	if err := s.Reader.Get(req.Context(), key, configMap); err != nil {
+		respondError(w, err)
+		return
+	}
+	if req.URL.Query().Get("format") == "html" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(configMap.Data[controller.ReportHTMLKey]))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(configMap.Data[controller.ReportJSONKey]))
+}
+
+func (s *Server) listResults(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	list := &chaosv1alpha1.ChaosResultList{}
+	if !s.list(w, req, list) {
+		return
+	}
+	items := slices.DeleteFunc(list.Items, func(result chaosv1alpha1.ChaosResult) bool {
+		return !matches(query.Get("experiment"), result.Spec.Experiment) ||
+			!matches(query.Get("result"), string(result.Spec.Result))
+	})
+	for i := range items {
+		items[i].ManagedFields = nil
+	}
+	page(w, req, items, func(result chaosv1alpha1.ChaosResult) string {
+		return result.Namespace + "/" + result.Name
+	})
+}
+
+func (s *Server) getResult(w http.ResponseWriter, req *http.Request) {
+	result := &chaosv1alpha1.ChaosResult{}
+	if !s.get(w, req, result) {
+		return
+	}
+	result.ManagedFields = nil
+	respond(w, http.StatusOK, result)
+}
+
+// list lists the objects selected by the namespace and labelSelector
+// parameters. It reports whether it succeeded; if not, the error response has
+// been written.
+func (s *Server) list(w http.ResponseWriter, req *http.Request, list client.ObjectList) bool {
+	query := req.URL.Query()
+	var opts []client.ListOption
+	if namespace := query.Get("namespace"); namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	if labelSelector := query.Get("labelSelector"); labelSelector != "" {
+		selector, err := labels.Parse(labelSelector)
+		if err != nil {
+			respond(w, http.StatusBadRequest, Error{Error: "invalid labelSelector: " + err.Error()})
+			return false
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+	if err := s.Reader.List(req.Context(), list, opts...); err != nil {
+		respondError(w, err)
+		return false
+	}
+	return true
+}
+
+// get reads the object named by the path. It reports whether it succeeded;
+// if not, the error response has been written.
+func (s *Server) get(w http.ResponseWriter, req *http.Request, obj client.Object) bool {
+	key := client.ObjectKey{Namespace: req.PathValue("namespace"), Name: req.PathValue("name")}
+	if err := s.Reader.Get(req.Context(), key, obj); err != nil {
+		respondError(w, err)
+		return false
+	}
+	return true
+}
+
+// page writes the page of items selected by the limit and continue
+// parameters. The continue token is the key of the last item of the previous
+// page, so pages stay consistent while items come and go.
+func page[T any](w http.ResponseWriter, req *http.Request, items []T, key func(T) string) {
+	query := req.URL.Query()
+	limit := defaultLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			respond(w, http.StatusBadRequest, Error{Error: "limit must be a positive integer"})
+			return
+		}
+		limit = min(parsed, maxLimit)
+	}
+
+	slices.SortFunc(items, func(a, b T) int {
+		return strings.Compare(key(a), key(b))
+	})
+	if token := query.Get("continue"); token != "" {
+		after, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			respond(w, http.StatusBadRequest, Error{Error: "invalid continue token"})
+			return
+		}
+		start, _ := slices.BinarySearchFunc(items, string(after), func(item T, after string) int {
+			if key(item) <= after {
+				return -1
+			}
+			return 1
+		})
+		items = items[start:]
+	}
+
+	response := List[T]{Items: items}
+	if len(items) > limit {
+		response.Items = items[:limit]
+		response.Continue = base64.RawURLEncoding.EncodeToString([]byte(key(items[limit-1])))
+	}
+	if response.Items == nil {
+		response.Items = []T{}
+	}
+	respond(w, http.StatusOK, response)
+}
+
+// matches reports whether value is one of the comma separated values of a
+// filter parameter, or the filter is empty.
+func matches(filter, value string) bool {
+	return filter == "" || slices.Contains(strings.Split(filter, ","), value)
+}
+
+// respondError writes the response for an error of the Kubernetes API.
+func respondError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if apierrors.IsNotFound(err) {
+		status = http.StatusNotFound
+	} else {
+		log.Error(err, "Failed to read from the cache")
+	}
+	respond(w, status, Error{Error: err.Error()})
+}
+
+// respond writes the body as JSON.
+func respond(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
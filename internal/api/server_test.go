@@ -0,0 +1,155 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// stubReader serves a fixed set of experiments and report ConfigMaps.
+type stubReader struct {
+	experiments []chaosv1alpha1.ChaosExperiment
+	configMaps  []corev1.ConfigMap
+}
+
+func (r *stubReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	switch obj := obj.(type) {
+	case *chaosv1alpha1.ChaosExperiment:
+		for _, experiment := range r.experiments {
+			if experiment.Namespace == key.Namespace && experiment.Name == key.Name {
+				experiment.DeepCopyInto(obj)
+				return nil
+			}
+		}
+	case *corev1.ConfigMap:
+		for _, configMap := range r.configMaps {
+			if configMap.Namespace == key.Namespace && configMap.Name == key.Name {
+				configMap.DeepCopyInto(obj)
+				return nil
+			}
+		}
+	}
+	return apierrors.NewNotFound(schema.GroupResource{}, key.Name)
+}
+
+func (r *stubReader) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if list, ok := list.(*chaosv1alpha1.ChaosExperimentList); ok {
+		for _, experiment := range r.experiments {
+			if listOpts.Namespace == "" || experiment.Namespace == listOpts.Namespace {
+				list.Items = append(list.Items, *experiment.DeepCopy())
+			}
+		}
+	}
+	return nil
+}
+
+var _ = Describe("API", func() {
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		experiment := func(namespace, name string, phase chaosv1alpha1.ExperimentPhase) chaosv1alpha1.ChaosExperiment {
+			return chaosv1alpha1.ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+				Spec:       chaosv1alpha1.ChaosExperimentSpec{Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack}},
+				Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: phase},
+			}
+		}
+		finished := experiment("shop", "checkout", chaosv1alpha1.ExperimentCompleted)
+		finished.Status.ReportRef = &corev1.LocalObjectReference{Name: "checkout-report"}
+		finished.Status.History = []chaosv1alpha1.IterationRecord{{Attack: chaosv1alpha1.PodKillAttack, Result: chaosv1alpha1.IterationSucceeded}}
+		reader := &stubReader{
+			experiments: []chaosv1alpha1.ChaosExperiment{
+				experiment("shop", "search", chaosv1alpha1.ExperimentRunning),
+				finished,
+				experiment("billing", "invoices", chaosv1alpha1.ExperimentRunning),
+			},
+			configMaps: []corev1.ConfigMap{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "checkout-report"},
+				Data:       map[string]string{"report.json": `{"experiment":"checkout"}`, "report.html": "<html></html>"},
+			}},
+		}
+		server = httptest.NewServer((&Server{Reader: reader}).Handler())
+		DeferCleanup(server.Close)
+	})
+
+	get := func(path string, body any) *http.Response {
+		resp, err := http.Get(server.URL + path)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(resp.Body.Close)
+		if body != nil {
+			Expect(json.NewDecoder(resp.Body).Decode(body)).To(Succeed())
+		}
+		return resp
+	}
+
+	names := func(list List[chaosv1alpha1.ChaosExperiment]) []string {
+		var names []string
+		for _, experiment := range list.Items {
+			names = append(names, experiment.Namespace+"/"+experiment.Name)
+		}
+		return names
+	}
+
+	It("should list experiments in pages, ordered by namespace and name", func() {
+		first := List[chaosv1alpha1.ChaosExperiment]{}
+		get("/api/v1/experiments?limit=2", &first)
+		Expect(names(first)).To(Equal([]string{"billing/invoices", "shop/checkout"}))
+		Expect(first.Continue).NotTo(BeEmpty())
+
+		second := List[chaosv1alpha1.ChaosExperiment]{}
+		get("/api/v1/experiments?limit=2&continue="+first.Continue, &second)
+		Expect(names(second)).To(Equal([]string{"shop/search"}))
+		Expect(second.Continue).To(BeEmpty())
+	})
+
+	It("should filter experiments", func() {
+		list := List[chaosv1alpha1.ChaosExperiment]{}
+		get("/api/v1/experiments?namespace=shop&phase=Running", &list)
+		Expect(names(list)).To(Equal([]string{"shop/search"}))
+
+		Expect(get("/api/v1/experiments?labelSelector=a%3D%3D%3D", nil).StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should serve the runs and the report of an experiment", func() {
+		runs := List[chaosv1alpha1.IterationRecord]{}
+		get("/api/v1/namespaces/shop/experiments/checkout/runs", &runs)
+		Expect(runs.Items).To(HaveLen(1))
+
+		report := map[string]any{}
+		get("/api/v1/namespaces/shop/experiments/checkout/report", &report)
+		Expect(report).To(HaveKeyWithValue("experiment", "checkout"))
+		Expect(get("/api/v1/namespaces/shop/experiments/checkout/report?format=html", nil).Header.Get("Content-Type")).To(HavePrefix("text/html"))
+
+		Expect(get("/api/v1/namespaces/shop/experiments/search/report", nil).StatusCode).To(Equal(http.StatusNotFound))
+		Expect(get("/api/v1/namespaces/shop/experiments/missing", nil).StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
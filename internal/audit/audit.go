@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit keeps an append-only log of the changes the operator makes to
+// the cluster, with the experiment that made each of them and who created it.
+// Every record is chained to the one before it by its hash and, with a
+// signing key, signed, so that changes to the log after the fact show.
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// Record is an entry of the audit log.
+type Record struct {
+	// Time is when the change was made.
+	Time time.Time `json:"time"`
+	// Verb is the API verb: create, update, patch, delete or deletecollection.
+	Verb string `json:"verb"`
+	// APIVersion and Kind are the type of the changed object.
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Namespace and Name identify the changed object.
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// Subresource is the changed subresource, e.g. "eviction".
+	Subresource string `json:"subresource,omitempty"`
+	// Experiment is the experiment the change was made for, if any.
+	Experiment *Experiment `json:"experiment,omitempty"`
+	// Error is why the change failed; it is empty when the API server
+	// accepted it.
+	Error string `json:"error,omitempty"`
+	// PrevHash is the Hash of the record before this one in the log.
+	PrevHash string `json:"prevHash,omitempty"`
+	// Hash is the hex-encoded SHA-256 hash of the record, see Verify.
+	Hash string `json:"hash"`
+	// Signature is the base64-encoded Ed25519 signature of the hash, if the
+	// log is signed.
+	Signature string `json:"signature,omitempty"`
+}
+
+// Experiment identifies the experiment a change was made for.
+type Experiment struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	// CreatedBy is the user who created the experiment, as recorded by the
+	// defaulting webhook.
+	CreatedBy string `json:"createdBy,omitempty"`
+}
+
+type experimentKey struct{}
+
+// WithExperiment returns a context whose changes are recorded as made for the
+// experiment.
+func WithExperiment(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) context.Context {
+	return context.WithValue(ctx, experimentKey{}, &Experiment{
+		Namespace: experiment.Namespace,
+		Name:      experiment.Name,
+		UID:       experiment.UID,
+		CreatedBy: experiment.Annotations[chaosv1alpha1.CreatedByAnnotation],
+	})
+}
+
+// experimentFrom returns the experiment of the context, or nil.
+func experimentFrom(ctx context.Context) *Experiment {
+	experiment, _ := ctx.Value(experimentKey{}).(*Experiment)
+	return experiment
+}
+
+// Log writes records as JSON lines.
+type Log struct {
+	mu  sync.Mutex
+	out io.Writer
+	key ed25519.PrivateKey
+	// hash is the Hash of the last record written.
+	hash string
+}
+
+// NewLog returns a log writing to out. Its first record starts a new chain.
+func NewLog(out io.Writer) *Log {
+	return &Log{out: out}
+}
+
+// Open opens the log at path for appending, creating it if needed, and
+// continues the chain of the records it already holds. A truncated last
+// record, as left behind by a crash in the middle of a write, is ended and
+// followed by a new chain, which Verify reports from that line on. The path
+// "-" stands for standard output, where every run of the operator starts a
+// new chain.
+func Open(path string) (*Log, error) {
+	if path == "-" {
+		return NewLog(os.Stdout), nil
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := lastHash(file)
+	if errors.Is(err, errTornRecord) {
+		logf.Log.WithName("audit").Info("Starting a new chain after the truncated last record of the audit log", "Path", path)
+		// End the torn line, so that the next record starts on a line of its own.
+		_, err = file.Write([]byte("\n"))
+	}
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("reading the last record of %s: %w", path, err)
+	}
+	log := NewLog(file)
+	log.hash = hash
+	return log, nil
+}
+
+// SignWith makes the log sign the records written from now on with key.
+func (l *Log) SignWith(key ed25519.PrivateKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.key = key
+}
+
+// Write chains the record to the last one, signs it and appends it to the log.
+func (l *Log) Write(record Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := seal(&record, l.hash, l.key); err != nil {
+		return err
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := l.out.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	l.hash = record.Hash
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// auditedClient records every write made through a client, except to the
+// status of objects, in a log.
+type auditedClient struct {
+	client.Client
+	log *Log
+}
+
+// NewClient returns a client that records the writes made through c in log.
+// Status updates are not recorded.
+func NewClient(c client.Client, log *Log) client.Client {
+	return &auditedClient{Client: c, log: log}
+}
+
+func (c *auditedClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	err := c.Client.Create(ctx, obj, opts...)
+	c.record(ctx, "create", obj, "", err)
+	return err
+}
+
+func (c *auditedClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	err := c.Client.Update(ctx, obj, opts...)
+	c.record(ctx, "update", obj, "", err)
+	return err
+}
+
+func (c *auditedClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	err := c.Client.Patch(ctx, obj, patch, opts...)
+	c.record(ctx, "patch", obj, "", err)
+	return err
+}
+
+func (c *auditedClient) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	err := c.Client.Apply(ctx, obj, opts...)
+	// Apply configurations are not objects, but encode like them.
+	applied := &metav1.PartialObjectMetadata{}
+	if encoded, encodeErr := json.Marshal(obj); encodeErr == nil {
+		_ = json.Unmarshal(encoded, applied)
+	}
+	record := Record{
+		Time:       time.Now().UTC(),
+		Verb:       "apply",
+		Experiment: experimentFrom(ctx),
+		APIVersion: applied.APIVersion,
+		Kind:       applied.Kind,
+		Namespace:  applied.Namespace,
+		Name:       applied.Name,
+	}
+	c.write(ctx, record, err)
+	return err
+}
+
+func (c *auditedClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	err := c.Client.Delete(ctx, obj, opts...)
+	c.record(ctx, "delete", obj, "", err)
+	return err
+}
+
+func (c *auditedClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	err := c.Client.DeleteAllOf(ctx, obj, opts...)
+	c.record(ctx, "deletecollection", obj, "", err)
+	return err
+}
+
+func (c *auditedClient) SubResource(subResource string) client.SubResourceClient {
+	if subResource == "status" {
+		return c.Client.SubResource(subResource)
+	}
+	return &auditedSubResourceClient{SubResourceClient: c.Client.SubResource(subResource), client: c, subResource: subResource}
+}
+
+// record writes a record of the change to the log. Failures to write it are
+// logged, but do not fail the change, which has already been made.
+func (c *auditedClient) record(ctx context.Context, verb string, obj runtime.Object, subResource string, err error) {
+	record := Record{
+		Time:        time.Now().UTC(),
+		Verb:        verb,
+		Subresource: subResource,
+		Experiment:  experimentFrom(ctx),
+	}
+	if gvk, gvkErr := apiutil.GVKForObject(obj, c.Scheme()); gvkErr == nil {
+		record.APIVersion, record.Kind = gvk.GroupVersion().String(), gvk.Kind
+	}
+	if object, ok := obj.(client.Object); ok {
+		record.Namespace, record.Name = object.GetNamespace(), object.GetName()
+	}
+	c.write(ctx, record, err)
+}
+
+// write adds the error of the change to the record and writes it to the log.
+func (c *auditedClient) write(ctx context.Context, record Record, err error) {
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if err := c.log.Write(record); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to write audit record", "Verb", record.Verb, "Kind", record.Kind, "Name", record.Name)
+	}
+}
+
+// auditedSubResourceClient records the writes made to a subresource, such as
+// evictions.
+type auditedSubResourceClient struct {
+	client.SubResourceClient
+	client      *auditedClient
+	subResource string
+}
+
+func (c *auditedSubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	err := c.SubResourceClient.Create(ctx, obj, subResource, opts...)
+	c.client.record(ctx, "create", obj, c.subResource, err)
+	return err
+}
+
+func (c *auditedSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	err := c.SubResourceClient.Update(ctx, obj, opts...)
+	c.client.record(ctx, "update", obj, c.subResource, err)
+	return err
+}
+
+func (c *auditedSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	err := c.SubResourceClient.Patch(ctx, obj, patch, opts...)
+	c.client.record(ctx, "patch", obj, c.subResource, err)
+	return err
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// stubClient accepts deletions and evictions and rejects everything else.
+type stubClient struct {
+	client.Client
+}
+
+func (stubClient) Scheme() *runtime.Scheme { return clientgoscheme.Scheme }
+
+func (stubClient) Delete(context.Context, client.Object, ...client.DeleteOption) error { return nil }
+
+func (stubClient) Patch(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+	return errors.New("forbidden")
+}
+
+func (stubClient) Apply(context.Context, runtime.ApplyConfiguration, ...client.ApplyOption) error {
+	return nil
+}
+
+func (stubClient) SubResource(string) client.SubResourceClient { return stubSubResourceClient{} }
+
+type stubSubResourceClient struct {
+	client.SubResourceClient
+}
+
+func (stubSubResourceClient) Create(context.Context, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+	return nil
+}
+
+var _ = Describe("Client", func() {
+	var out *bytes.Buffer
+	var c client.Client
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		c = NewClient(stubClient{}, NewLog(out))
+	})
+
+	records := func() []Record {
+		var records []Record
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			var record Record
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		return records
+	}
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "nginx-1", Namespace: "demo"}}
+
+	It("should record changes with the experiment they were made for", func() {
+		ctx := WithExperiment(context.Background(), &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{
+			Name: "kill-nginx", Namespace: "demo", UID: "uid",
+			Annotations: map[string]string{chaosv1alpha1.CreatedByAnnotation: "alice"},
+		}})
+		Expect(c.Delete(ctx, pod)).To(Succeed())
+		Expect(c.SubResource("eviction").Create(ctx, pod, &policyv1.Eviction{})).To(Succeed())
+
+		Expect(records()).To(HaveExactElements(
+			SatisfyAll(
+				HaveField("Verb", "delete"),
+				HaveField("APIVersion", "v1"),
+				HaveField("Kind", "Pod"),
+				HaveField("Namespace", "demo"),
+				HaveField("Name", "nginx-1"),
+				HaveField("Experiment", Equal(&Experiment{Namespace: "demo", Name: "kill-nginx", UID: "uid", CreatedBy: "alice"})),
+				HaveField("Error", BeEmpty()),
+			),
+			SatisfyAll(
+				HaveField("Verb", "create"),
+				HaveField("Subresource", "eviction"),
+				HaveField("Name", "nginx-1"),
+			),
+		))
+	})
+
+	It("should record server-side applies", func() {
+		Expect(c.Apply(context.Background(), corev1ac.Node("node-1").WithSpec(corev1ac.NodeSpec().WithUnschedulable(true)))).To(Succeed())
+		Expect(records()).To(HaveExactElements(SatisfyAll(
+			HaveField("Verb", "apply"),
+			HaveField("APIVersion", "v1"),
+			HaveField("Kind", "Node"),
+			HaveField("Name", "node-1"),
+		)))
+	})
+
+	It("should record rejected changes with their error", func() {
+		Expect(c.Patch(context.Background(), pod, client.MergeFrom(pod))).NotTo(Succeed())
+		Expect(records()).To(HaveExactElements(SatisfyAll(
+			HaveField("Verb", "patch"),
+			HaveField("Experiment", BeNil()),
+			HaveField("Error", "forbidden"),
+		)))
+	})
+})
@@ -0,0 +1,181 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// SigningKeySecretKey is the key of the Secret holding the signing key of the
+// audit log: an Ed25519 private key in PEM-encoded PKCS #8 form, as written
+// by "openssl genpkey -algorithm ed25519".
+const SigningKeySecretKey = "signing.key"
+
+// maxRecordSize bounds the length of a line of the log that is read back.
+const maxRecordSize = 1 << 20
+
+// ParsePrivateKey parses a PEM-encoded PKCS #8 Ed25519 private key.
+func ParsePrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	private, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an Ed25519 private key but got %T", key)
+	}
+	return private, nil
+}
+
+// ParsePublicKey parses a PEM-encoded PKIX Ed25519 public key, as written by
+// "openssl pkey -pubout".
+func ParsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	public, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an Ed25519 public key but got %T", key)
+	}
+	return public, nil
+}
+
+// seal chains the record to the record before it, whose hash is prevHash,
+// and signs it with key if it is set. The hash covers the JSON encoding of
+// the record with its PrevHash, but without Hash and Signature.
+func seal(record *Record, prevHash string, key ed25519.PrivateKey) error {
+	record.PrevHash, record.Hash, record.Signature = prevHash, "", ""
+	sum, err := recordHash(record)
+	if err != nil {
+		return err
+	}
+	record.Hash = hex.EncodeToString(sum)
+	if key != nil {
+		record.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, sum))
+	}
+	return nil
+}
+
+// recordHash returns the SHA-256 hash of the record without its Hash and
+// Signature.
+func recordHash(record *Record) ([]byte, error) {
+	unsealed := *record
+	unsealed.Hash, unsealed.Signature = "", ""
+	data, err := json.Marshal(unsealed)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// errTornRecord is returned by lastHash when the last line of the log is not
+// a record, as left behind by a write that was cut short.
+var errTornRecord = errors.New("the last record of the log is truncated")
+
+// lastHash returns the hash of the last record in the log read from in, or
+// the empty string if it holds none. A last line that is not a record yields
+// errTornRecord; any other line that is not a record is an error of its own.
+func lastHash(in io.Reader) (string, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, maxRecordSize)
+	var hash string
+	var torn error
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if torn != nil {
+			return "", torn
+		}
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			torn = err
+			continue
+		}
+		hash = record.Hash
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if torn != nil {
+		return "", errTornRecord
+	}
+	return hash, nil
+}
+
+// Verify checks the log read from in and returns how many records it holds:
+// every record has to hash to its Hash and name the hash of the record before
+// it as its PrevHash. The first record may follow any record, so that the
+// tail of a rotated log can be verified on its own. With a key, every record
+// also has to carry a valid signature by the matching private key. The error
+// names the line of the first record that fails the checks.
+func Verify(in io.Reader, key ed25519.PublicKey) (int, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, maxRecordSize)
+	count := 0
+	line := 0
+	var prevHash string
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return count, fmt.Errorf("line %d: %w", line, err)
+		}
+		if count > 0 && record.PrevHash != prevHash {
+			return count, fmt.Errorf("line %d: the record does not follow the record before it", line)
+		}
+		sum, err := recordHash(&record)
+		if err != nil {
+			return count, fmt.Errorf("line %d: %w", line, err)
+		}
+		if record.Hash != hex.EncodeToString(sum) {
+			return count, fmt.Errorf("line %d: the record does not match its hash", line)
+		}
+		if key != nil {
+			signature, err := base64.StdEncoding.DecodeString(record.Signature)
+			if err != nil || !ed25519.Verify(key, sum, signature) {
+				return count, fmt.Errorf("line %d: the record is not signed by the key", line)
+			}
+		}
+		prevHash = record.Hash
+		count++
+	}
+	return count, scanner.Err()
+}
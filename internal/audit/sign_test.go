@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signed log", func() {
+	var public ed25519.PublicKey
+	var private ed25519.PrivateKey
+
+	BeforeEach(func() {
+		var err error
+		public, private, err = ed25519.GenerateKey(nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	write := func(log *Log, names ...string) {
+		for _, name := range names {
+			Expect(log.Write(Record{Time: time.Now().UTC(), Verb: "delete", APIVersion: "v1", Kind: "Pod", Namespace: "demo", Name: name})).To(Succeed())
+		}
+	}
+
+	It("should chain and sign the records", func() {
+		out := &bytes.Buffer{}
+		log := NewLog(out)
+		log.SignWith(private)
+		write(log, "nginx-1", "nginx-2", "nginx-3")
+
+		count, err := Verify(bytes.NewReader(out.Bytes()), public)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(3))
+
+		other, _, err := ed25519.GenerateKey(nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = Verify(bytes.NewReader(out.Bytes()), other)
+		Expect(err).To(MatchError("line 1: the record is not signed by the key"))
+	})
+
+	It("should detect records that were changed or removed", func() {
+		out := &bytes.Buffer{}
+		write(NewLog(out), "nginx-1", "nginx-2", "nginx-3")
+		lines := strings.SplitAfter(out.String(), "\n")
+
+		count, err := Verify(strings.NewReader(out.String()), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(3))
+
+		changed := lines[0] + strings.Replace(lines[1], "nginx-2", "nginx-9", 1) + lines[2]
+		_, err = Verify(strings.NewReader(changed), nil)
+		Expect(err).To(MatchError("line 2: the record does not match its hash"))
+
+		removed := lines[0] + lines[2]
+		_, err = Verify(strings.NewReader(removed), nil)
+		Expect(err).To(MatchError("line 2: the record does not follow the record before it"))
+
+		// The tail of a rotated log verifies on its own.
+		count, err = Verify(strings.NewReader(lines[1]+lines[2]), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(2))
+	})
+
+	It("should continue the chain of an existing log", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "audit.log")
+		log, err := Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		write(log, "nginx-1", "nginx-2")
+
+		log, err = Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		write(log, "nginx-3")
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		count, err := Verify(bytes.NewReader(data), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(3))
+	})
+
+	It("should start a new chain after a truncated last record", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "audit.log")
+		log, err := Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		write(log, "nginx-1", "nginx-2")
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(path, data[:len(data)-10], 0o600)).To(Succeed())
+
+		log, err = Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		write(log, "nginx-3")
+
+		data, err = os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+		Expect(lines).To(HaveLen(3))
+		count, err := Verify(bytes.NewReader(lines[2]), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+		_, err = Verify(bytes.NewReader(data), nil)
+		Expect(err).To(MatchError(ContainSubstring("line 2")))
+	})
+
+	It("should not open a log whose records were corrupted", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "audit.log")
+		log, err := Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		write(log, "nginx-1", "nginx-2")
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		data[0] = 'x'
+		Expect(os.WriteFile(path, data, 0o600)).To(Succeed())
+
+		_, err = Open(path)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should parse PEM-encoded keys", func() {
+		der, err := x509.MarshalPKCS8PrivateKey(private)
+		Expect(err).NotTo(HaveOccurred())
+		parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed.Equal(private)).To(BeTrue())
+
+		der, err = x509.MarshalPKIXPublicKey(public)
+		Expect(err).NotTo(HaveOccurred())
+		parsedPublic, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsedPublic.Equal(public)).To(BeTrue())
+
+		_, err = ParsePrivateKey([]byte("not a key"))
+		Expect(err).To(MatchError("no PEM data found"))
+	})
+})
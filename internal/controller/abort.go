@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// abortPollInterval is how often the abort conditions of an experiment that
+// has not finished yet are evaluated.
+const abortPollInterval = 15 * time.Second
+
+// prometheusClient is used for the queries against Prometheus.
+var prometheusClient = &http.Client{Timeout: 10 * time.Second}
+
+// reconcileAbortConditions evaluates spec.abortConditions and aborts the
+// experiment when one of them fires: helper pods still running are stopped,
+// every active fault is reverted and the phase becomes Aborted. It reports
+// whether the reconcile has to stop here, together with the result to hand
+// back to the controller; this is also the case while the conditions cannot
+// be evaluated, so that no attack runs unguarded.
+func (r *ChaosExperimentReconciler) reconcileAbortConditions(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if len(experiment.Spec.AbortConditions) == 0 || experimentFinished(experiment) {
+		return false, ctrl.Result{}, nil
+	}
+	if r.PrometheusURL == "" {
+		result, err := r.failExperiment(ctx, experiment, "PrometheusNotConfigured", "Abort conditions are set, but the operator is not configured with a Prometheus URL.")
+		return true, result, err
+	}
+
+	for _, condition := range experiment.Spec.AbortConditions {
+		firing, err := r.abortConditionFiring(ctx, condition)
+		if err != nil {
+			logger.Error(err, "Failed to evaluate abort condition", "Condition", describeAbortCondition(condition))
+			r.Recorder.Eventf(experiment, "Warning", "AbortConditionCheckFailed", "Failed to evaluate abort condition %s: %v", describeAbortCondition(condition), err)
+			return true, ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		if firing {
+			result, err := r.abortExperiment(ctx, experiment, "AbortConditionFired", describeAbortCondition(condition))
+			return true, result, err
+		}
+	}
+	return false, ctrl.Result{}, nil
+}
+
+// reconcileAbortRequest aborts an experiment that has the AbortAnnotation
+// and has not finished yet. It reports whether the reconcile has to stop
+// here, together with the result to hand back to the controller.
+func (r *ChaosExperimentReconciler) reconcileAbortRequest(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	reason, requested := experiment.Annotations[chaosv1alpha1.AbortAnnotation]
+	if !requested || experimentFinished(experiment) {
+		return false, ctrl.Result{}, nil
+	}
+	result, err := r.abortExperiment(ctx, experiment, "AbortRequested", describeAbortRequest(reason))
+	return true, result, err
+}
+
+// abortExperiment stops the experiment for the given reason and cause, a
+// description of what made it abort.
+func (r *ChaosExperimentReconciler) abortExperiment(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, reason, cause string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.Info("Aborting experiment", "Experiment", experiment.Name, "Cause", cause)
+	active, err := r.activeHelperPods(ctx, experiment)
+	if err != nil {
+		logger.Error(err, "Failed to list helper pods of ChaosExperiment")
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	for i := range active {
+		// Helper pods undo their changes when they are terminated.
+		if err := r.Delete(ctx, &active[i]); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to stop helper pod of aborted experiment", "HelperPod", active[i].Name)
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+	}
+	// Faults that fail to revert stay active and are retried when due.
+	if err := r.revertFaults(ctx, experiment, true); err != nil {
+		logger.Error(err, "Failed to revert injected faults of aborted experiment")
+	}
+
+	experiment.Status.Phase = chaosv1alpha1.ExperimentAborted
+	experiment.Status.Reason = reason
+	experiment.Status.Message = fmt.Sprintf("Experiment aborted: %s.", cause)
+	experiment.Status.NextScheduledTime = nil
+	r.recordIteration(ctx, experiment, chaosv1alpha1.IterationAborted, experiment.Status.Message, time.Now())
+	r.Recorder.Eventf(experiment, "Warning", "ExperimentAborted", "ChaosExperiment was aborted because %s.", cause)
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status to Aborted")
+		return ctrl.Result{}, err
+	}
+	return requeueForFaults(experiment, ctrl.Result{}), nil
+}
+
+// pollAbortConditions makes sure an experiment with abort conditions is
+// reconciled again in time to evaluate them, until it has finished.
+func pollAbortConditions(experiment *chaosv1alpha1.ChaosExperiment, result ctrl.Result) ctrl.Result {
+	if len(experiment.Spec.AbortConditions) == 0 || experimentFinished(experiment) {
+		return result
+	}
+	if result.RequeueAfter == 0 || abortPollInterval < result.RequeueAfter {
+		result.RequeueAfter = abortPollInterval
+	}
+	return result
+}
+
+// experimentFinished reports whether the experiment will not run any further
+// iterations: it completed, was aborted or failed.
+func experimentFinished(experiment *chaosv1alpha1.ChaosExperiment) bool {
+	switch experiment.Status.Phase {
+	case chaosv1alpha1.ExperimentCompleted, chaosv1alpha1.ExperimentAborted, chaosv1alpha1.ExperimentFailed:
+		return true
+	}
+	return false
+}
+
+// describeAbortCondition returns a human readable description of a firing
+// abort condition.
+func describeAbortCondition(condition chaosv1alpha1.AbortCondition) string {
+	if condition.Alert != "" {
+		return fmt.Sprintf("alert %s is firing", condition.Alert)
+	}
+	return fmt.Sprintf("query %q returned results", condition.Query)
+}
+
+// describeAbortRequest returns a human readable description of an abort
+// requested with the given reason.
+func describeAbortRequest(reason string) string {
+	if reason == "" {
+		return "an abort was requested"
+	}
+	return fmt.Sprintf("an abort was requested (%s)", reason)
+}
+
+// abortConditionFiring reports whether an abort condition currently fires.
+func (r *ChaosExperimentReconciler) abortConditionFiring(ctx context.Context, condition chaosv1alpha1.AbortCondition) (bool, error) {
+	query := condition.Query
+	if condition.Alert != "" {
+		query = fmt.Sprintf("ALERTS{alertname=%s,alertstate=\"firing\"}", strconv.Quote(condition.Alert))
+	}
+	return r.queryPrometheus(ctx, query)
+}
+
+// queryPrometheus evaluates an instant PromQL query through the Prometheus
+// HTTP API and reports whether it returned any series. Scalar results count
+// as returned when they are not zero.
+func (r *ChaosExperimentReconciler) queryPrometheus(ctx context.Context, query string) (bool, error) {
+	endpoint, err := url.Parse(r.PrometheusURL)
+	if err != nil {
+		return false, fmt.Errorf("invalid Prometheus URL: %w", err)
+	}
+	endpoint = endpoint.JoinPath("api", "v1", "query")
+	endpoint.RawQuery = url.Values{"query": {query}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := prometheusClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string          `json:"resultType"`
+			Result     json.RawMessage `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return false, fmt.Errorf("decoding Prometheus response with status %s: %w", resp.Status, err)
+	}
+	if body.Status != "success" {
+		return false, fmt.Errorf("prometheus query failed: %s", body.Error)
+	}
+
+	switch body.Data.ResultType {
+	case "vector", "matrix":
+		var series []json.RawMessage
+		if err := json.Unmarshal(body.Data.Result, &series); err != nil {
+			return false, err
+		}
+		return len(series) > 0, nil
+	case "scalar":
+		var sample [2]any
+		if err := json.Unmarshal(body.Data.Result, &sample); err != nil {
+			return false, err
+		}
+		value, _ := sample[1].(string)
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid scalar value %q: %w", value, err)
+		}
+		return parsed != 0, nil
+	default:
+		return false, fmt.Errorf("unsupported result type %q", body.Data.ResultType)
+	}
+}
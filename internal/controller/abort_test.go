@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Abort conditions", func() {
+	var server *httptest.Server
+	var queries []string
+
+	BeforeEach(func() {
+		queries = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			query := req.URL.Query().Get("query")
+			queries = append(queries, query)
+			switch query {
+			case `ALERTS{alertname="HighErrorRate",alertstate="firing"}`, "up == 0":
+				fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"1"]}]}}`)
+			case "scalar(vector(0))":
+				fmt.Fprint(w, `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"0"]}}`)
+			case "invalid(":
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+			default:
+				fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[]}}`)
+			}
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should evaluate alerts and queries against Prometheus", func() {
+		r := &ChaosExperimentReconciler{PrometheusURL: server.URL}
+		ctx := context.Background()
+
+		firing, err := r.abortConditionFiring(ctx, chaosv1alpha1.AbortCondition{Alert: "HighErrorRate"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(firing).To(BeTrue())
+
+		firing, err = r.abortConditionFiring(ctx, chaosv1alpha1.AbortCondition{Alert: "Watchdog"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(firing).To(BeFalse())
+
+		firing, err = r.abortConditionFiring(ctx, chaosv1alpha1.AbortCondition{Query: "up == 0"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(firing).To(BeTrue())
+
+		firing, err = r.abortConditionFiring(ctx, chaosv1alpha1.AbortCondition{Query: "scalar(vector(0))"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(firing).To(BeFalse())
+
+		_, err = r.abortConditionFiring(ctx, chaosv1alpha1.AbortCondition{Query: "invalid("})
+		Expect(err).To(MatchError(ContainSubstring("parse error")))
+		Expect(queries).To(HaveLen(5))
+	})
+
+	It("should keep polling until the experiment has finished", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode:            chaosv1alpha1.RecurringMode,
+			AbortConditions: []chaosv1alpha1.AbortCondition{{Alert: "HighErrorRate"}},
+		}}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		Expect(pollAbortConditions(experiment, ctrl.Result{RequeueAfter: time.Hour}).RequeueAfter).To(Equal(abortPollInterval))
+		Expect(pollAbortConditions(experiment, ctrl.Result{RequeueAfter: time.Second}).RequeueAfter).To(Equal(time.Second))
+
+		experiment.Status.Phase = chaosv1alpha1.ExperimentAborted
+		Expect(pollAbortConditions(experiment, ctrl.Result{}).RequeueAfter).To(BeZero())
+	})
+
+	It("should only abort unfinished experiments on request", func() {
+		r := &ChaosExperimentReconciler{}
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		aborted, _, err := r.reconcileAbortRequest(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(aborted).To(BeFalse())
+
+		experiment.Annotations = map[string]string{chaosv1alpha1.AbortAnnotation: "campaign stopped"}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+		aborted, _, err = r.reconcileAbortRequest(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(aborted).To(BeFalse())
+
+		Expect(describeAbortRequest("")).To(Equal("an abort was requested"))
+		Expect(describeAbortRequest("campaign stopped")).To(Equal("an abort was requested (campaign stopped)"))
+	})
+})
@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// podTarget returns the affected target for a pod, including its node.
+func podTarget(pod *corev1.Pod) chaosv1alpha1.AffectedTarget {
+	return chaosv1alpha1.AffectedTarget{Kind: "Pod", Name: pod.Name, Namespace: pod.Namespace, NodeName: pod.Spec.NodeName}
+}
+
+// podTargets returns the affected targets for the pods.
+func podTargets(pods []corev1.Pod) []chaosv1alpha1.AffectedTarget {
+	targets := make([]chaosv1alpha1.AffectedTarget, 0, len(pods))
+	for i := range pods {
+		targets = append(targets, podTarget(&pods[i]))
+	}
+	return targets
+}
+
+// nodeTarget returns the affected target for a node.
+func nodeTarget(name string) chaosv1alpha1.AffectedTarget {
+	return chaosv1alpha1.AffectedTarget{Kind: "Node", Name: name}
+}
+
+// objectTarget returns the affected target for a namespaced object.
+func objectTarget(kind, namespace, name string) chaosv1alpha1.AffectedTarget {
+	return chaosv1alpha1.AffectedTarget{Kind: kind, Name: name, Namespace: namespace}
+}
+
+// targetName formats an affected target like the targets of
+// status.lastIteration: pods as namespace/name, nodes as "Node name" and other
+// objects as kind namespace/name.
+func targetName(target chaosv1alpha1.AffectedTarget) string {
+	switch {
+	case target.Kind == "Pod":
+		return target.Namespace + "/" + target.Name
+	case target.Namespace == "":
+		return target.Kind + " " + target.Name
+	default:
+		return fmt.Sprintf("%s %s/%s", target.Kind, target.Namespace, target.Name)
+	}
+}
+
+// targetNames formats the affected targets with targetName.
+func targetNames(targets []chaosv1alpha1.AffectedTarget) []string {
+	names := make([]string, 0, len(targets))
+	for _, target := range targets {
+		names = append(names, targetName(target))
+	}
+	return names
+}
+
+// describeTargets formats the affected targets for an event, naming the node
+// of every pod so that they can be found on dashboards.
+func describeTargets(targets []chaosv1alpha1.AffectedTarget) string {
+	descriptions := make([]string, 0, len(targets))
+	for _, target := range targets {
+		description := targetName(target)
+		if target.Kind == "Pod" {
+			description = "pod " + description
+			if target.NodeName != "" {
+				description += " on node " + target.NodeName
+			}
+		}
+		descriptions = append(descriptions, description)
+	}
+	return strings.Join(descriptions, ", ")
+}
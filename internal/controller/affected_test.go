@@ -0,0 +1,47 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Affected targets", func() {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-a", Namespace: "demo"},
+		Spec:       corev1.PodSpec{NodeName: "worker-1"},
+	}
+
+	It("should record pods with their node", func() {
+		Expect(podTarget(pod)).To(Equal(chaosv1alpha1.AffectedTarget{Kind: "Pod", Name: "nginx-a", Namespace: "demo", NodeName: "worker-1"}))
+	})
+
+	It("should name targets like status.lastIteration", func() {
+		targets := []chaosv1alpha1.AffectedTarget{podTarget(pod), nodeTarget("worker-2"), objectTarget("Deployment", "demo", "web")}
+		Expect(targetNames(targets)).To(Equal([]string{"demo/nginx-a", "Node worker-2", "Deployment demo/web"}))
+	})
+
+	It("should name the node of every pod in events", func() {
+		targets := []chaosv1alpha1.AffectedTarget{podTarget(pod), objectTarget("Service", "demo", "web")}
+		Expect(describeTargets(targets)).To(Equal("pod demo/nginx-a on node worker-1, Service demo/web"))
+	})
+})
@@ -0,0 +1,43 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FieldManager is the field manager of the changes the operator makes to
+// objects, so that their managedFields tell them apart from the changes of
+// other controllers.
+const FieldManager = "kubechaos-operator"
+
+// applyFault injects a fault into an object it shares with other controllers
+// by server-side applying the fields the fault sets. The operator takes over
+// those fields and leaves all others to their managers.
+func (r *ChaosExperimentReconciler) applyFault(ctx context.Context, fault runtime.ApplyConfiguration) error {
+	return r.targets(ctx).Apply(ctx, fault, client.FieldOwner(FieldManager), client.ForceOwnership)
+}
+
+// releaseFault reverts a fault injected with applyFault by applying the
+// object without any fields. The fields only the operator manages are
+// removed; fields other managers set as well keep their values.
+func (r *ChaosExperimentReconciler) releaseFault(ctx context.Context, empty runtime.ApplyConfiguration) error {
+	return r.Apply(ctx, empty, client.FieldOwner(FieldManager))
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// podKillBudgetWindow is the window MaxPodKillsPerHour applies to.
+const podKillBudgetWindow = time.Hour
+
+// coveringBudgets returns the ChaosBudgets whose namespace selector matches
+// the namespace. Budgets with an invalid selector cover every namespace, so
+// that a typo does not lift the limits.
+func (r *ChaosExperimentReconciler) coveringBudgets(ctx context.Context, namespace string) ([]chaosv1alpha1.ChaosBudget, error) {
+	var budgets chaosv1alpha1.ChaosBudgetList
+	if err := r.List(ctx, &budgets); err != nil {
+		return nil, err
+	}
+	if len(budgets.Items) == 0 {
+		return nil, nil
+	}
+
+	namespaceLabels, err := r.namespaceLabels(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	var covering []chaosv1alpha1.ChaosBudget
+	for _, budget := range budgets.Items {
+		if budget.Spec.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(budget.Spec.NamespaceSelector)
+			if err == nil && !selector.Matches(labels.Set(namespaceLabels)) {
+				continue
+			}
+		}
+		covering = append(covering, budget)
+	}
+	return covering, nil
+}
+
+// namespaceLabels returns the labels of the namespace, or none if it does not
+// exist.
+func (r *ChaosExperimentReconciler) namespaceLabels(ctx context.Context, namespace string) (map[string]string, error) {
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return ns.Labels, nil
+}
+
+// enforceConcurrencyBudgets defers the first iteration of an experiment while
+// as many experiments as a ChaosBudget covering its target namespace allows
+// are already running against that namespace. It reports whether the
+// iteration may go ahead; if it may not, the iteration has been skipped and
+// the result to hand back to the controller is returned.
+func (r *ChaosExperimentReconciler) enforceConcurrencyBudgets(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Running experiments already count against the budgets.
+	if experiment.Status.Phase == chaosv1alpha1.ExperimentRunning {
+		return true, ctrl.Result{}, nil
+	}
+	namespace := experiment.Spec.Target.Namespace
+	budgets, err := r.coveringBudgets(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to look up chaos budgets", "Namespace", namespace)
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	var limit *int32
+	for _, budget := range budgets {
+		if allowed := budget.Spec.MaxConcurrentExperimentsPerNamespace; allowed != nil && (limit == nil || *allowed < *limit) {
+			limit = allowed
+		}
+	}
+	if limit == nil {
+		return true, ctrl.Result{}, nil
+	}
+
+	var experiments chaosv1alpha1.ChaosExperimentList
+	if err := r.List(ctx, &experiments); err != nil {
+		logger.Error(err, "Failed to list ChaosExperiments")
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	running := 0
+	for _, other := range experiments.Items {
+		if other.UID != experiment.UID && other.Spec.Target.Namespace == namespace && !other.Spec.DryRun && other.Status.Phase == chaosv1alpha1.ExperimentRunning {
+			running++
+		}
+	}
+	if running < int(*limit) {
+		return true, ctrl.Result{}, nil
+	}
+
+	logger.Info("Chaos budget allows no further experiments, deferring iteration", "Namespace", namespace, "Running", running)
+	r.Recorder.Eventf(experiment, "Warning", "BudgetExhausted", "Iteration deferred because %d experiment(s) are already running against namespace %s, the most a chaos budget allows.", running, namespace)
+	holdBackIteration(experiment, "BudgetExhausted", fmt.Sprintf("A chaos budget allows no more than %d concurrent experiment(s) against namespace %s.", *limit, namespace))
+	result, err := r.skipIteration(ctx, experiment, "Iteration deferred: the chaos budget for concurrent experiments is exhausted.")
+	return false, result, err
+}
+
+// reservePodKills charges up to n pod kills to the ChaosBudgets covering the
+// target namespace and returns how many pods may be killed. Kills are charged
+// before they happen, so a kill that fails still counts.
+func (r *ChaosExperimentReconciler) reservePodKills(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, n int) (int, error) {
+	budgets, err := r.coveringBudgets(ctx, experiment.Spec.Target.Namespace)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	var limited []chaosv1alpha1.ChaosBudget
+	for _, budget := range budgets {
+		if budget.Spec.MaxPodKillsPerHour != nil {
+			n = min(n, podKillsRemaining(&budget, now))
+			limited = append(limited, budget)
+		}
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	for i := range limited {
+		// Experiments charge the same budget concurrently, so the patch is
+		// rejected if the budget changed since it was read.
+		patch := client.MergeFromWithOptions(limited[i].DeepCopy(), client.MergeFromWithOptimisticLock{})
+		chargePodKills(&limited[i], experiment.Namespace+"/"+experiment.Name, n, now)
+		if err := r.Status().Patch(ctx, &limited[i], patch); err != nil {
+			return 0, fmt.Errorf("charging pod kills to chaos budget %s: %w", limited[i].Name, err)
+		}
+	}
+	return n, nil
+}
+
+// podKillsRemaining returns how many more pods the budget allows to be killed
+// at now.
+func podKillsRemaining(budget *chaosv1alpha1.ChaosBudget, now time.Time) int {
+	since := now.Add(-podKillBudgetWindow)
+	used := 0
+	for _, record := range budget.Status.PodKills {
+		if record.Time.Time.After(since) {
+			used += int(record.Count)
+		}
+	}
+	return max(int(*budget.Spec.MaxPodKillsPerHour)-used, 0)
+}
+
+// chargePodKills records count pod kills by the experiment in the budget
+// status and drops the records that fell out of the window.
+func chargePodKills(budget *chaosv1alpha1.ChaosBudget, experiment string, count int, now time.Time) {
+	since := now.Add(-podKillBudgetWindow)
+	kept := budget.Status.PodKills[:0]
+	for _, record := range budget.Status.PodKills {
+		if record.Time.Time.After(since) {
+			kept = append(kept, record)
+		}
+	}
+	budget.Status.PodKills = append(kept, chaosv1alpha1.PodKillRecord{Experiment: experiment, Count: int32(count), Time: metav1.NewTime(now)})
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Chaos budgets", func() {
+	It("should track pod kills within the last hour", func() {
+		budget := &chaosv1alpha1.ChaosBudget{Spec: chaosv1alpha1.ChaosBudgetSpec{MaxPodKillsPerHour: ptr.To[int32](5)}}
+		start := time.Now()
+		Expect(podKillsRemaining(budget, start)).To(Equal(5))
+
+		chargePodKills(budget, "demo/pod-kill", 3, start)
+		chargePodKills(budget, "demo/other", 2, start.Add(30*time.Minute))
+		Expect(podKillsRemaining(budget, start.Add(30*time.Minute))).To(Equal(0))
+
+		// The first kills fall out of the window after an hour.
+		Expect(podKillsRemaining(budget, start.Add(time.Hour+time.Second))).To(Equal(3))
+		chargePodKills(budget, "demo/pod-kill", 1, start.Add(time.Hour+time.Second))
+		Expect(budget.Status.PodKills).To(HaveLen(2))
+		Expect(podKillsRemaining(budget, start.Add(time.Hour+time.Second))).To(Equal(2))
+	})
+})
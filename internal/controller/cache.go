@@ -0,0 +1,74 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UncachedObjects are read straight from the API server. The operator reads
+// them rarely and one at a time, and caching them would keep every Secret,
+// ConfigMap and Event of the cluster in memory.
+var UncachedObjects = []client.Object{
+	&corev1.Secret{},
+	&corev1.ConfigMap{},
+	&corev1.Event{},
+	&corev1.Service{},
+	&coordinationv1.Lease{},
+}
+
+// CacheOptions returns the options of the manager cache. Managed fields are
+// stripped from every cached object, and pod templates from ReplicaSets,
+// which are only cached to resolve the owners of pods. With podLabels set,
+// only the pods carrying these labels are cached, which makes them the only
+// pods experiments can target. With namespaces set, only namespaced objects
+// in these namespaces are watched and cached.
+func CacheOptions(podLabels map[string]string, namespaces []string) cache.Options {
+	options := cache.Options{
+		DefaultTransform: cache.TransformStripManagedFields(),
+		ByObject: map[client.Object]cache.ByObject{
+			&appsv1.ReplicaSet{}: {Transform: stripReplicaSet},
+		},
+	}
+	if len(podLabels) > 0 {
+		options.ByObject[&corev1.Pod{}] = cache.ByObject{Label: labels.SelectorFromSet(podLabels)}
+	}
+	if len(namespaces) > 0 {
+		options.DefaultNamespaces = map[string]cache.Config{}
+		for _, namespace := range namespaces {
+			options.DefaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+	return options
+}
+
+// stripReplicaSet drops everything but the metadata and replica counts of a
+// ReplicaSet before it is cached.
+func stripReplicaSet(in any) (any, error) {
+	replicaSet, ok := in.(*appsv1.ReplicaSet)
+	if !ok {
+		return in, nil
+	}
+	replicaSet.ManagedFields = nil
+	replicaSet.Spec.Template = corev1.PodTemplateSpec{}
+	return replicaSet, nil
+}
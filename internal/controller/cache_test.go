@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Cache options", func() {
+	It("should only restrict pods when labels are given", func() {
+		options := CacheOptions(nil, nil)
+		Expect(options.DefaultTransform).NotTo(BeNil())
+		for obj := range options.ByObject {
+			Expect(obj).NotTo(BeAssignableToTypeOf(&corev1.Pod{}))
+		}
+
+		options = CacheOptions(map[string]string{"chaos": "allowed"}, nil)
+		var selector labels.Selector
+		for obj, config := range options.ByObject {
+			if _, ok := obj.(*corev1.Pod); ok {
+				selector = config.Label
+			}
+		}
+		Expect(selector).NotTo(BeNil())
+		Expect(selector.Matches(labels.Set{"chaos": "allowed", "app": "web"})).To(BeTrue())
+		Expect(selector.Matches(labels.Set{"app": "web"})).To(BeFalse())
+	})
+
+	It("should only watch the given namespaces", func() {
+		Expect(CacheOptions(nil, nil).DefaultNamespaces).To(BeEmpty())
+
+		options := CacheOptions(nil, []string{"team-a", "team-b"})
+		Expect(options.DefaultNamespaces).To(HaveLen(2))
+		Expect(options.DefaultNamespaces).To(HaveKey("team-a"))
+		Expect(options.DefaultNamespaces).To(HaveKey("team-b"))
+	})
+
+	It("should strip pod templates from ReplicaSets", func() {
+		replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name:          "web-abc",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kube-controller-manager"}},
+		}}
+		replicaSet.Spec.Template.Spec.Containers = []corev1.Container{{Name: "web", Image: "web:1"}}
+
+		stripped, err := stripReplicaSet(replicaSet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stripped.(*appsv1.ReplicaSet).Name).To(Equal("web-abc"))
+		Expect(stripped.(*appsv1.ReplicaSet).ManagedFields).To(BeNil())
+		Expect(stripped.(*appsv1.ReplicaSet).Spec.Template.Spec.Containers).To(BeEmpty())
+	})
+
+	It("should give helper pods the cached pod labels", func() {
+		scheme := runtime.NewScheme()
+		Expect(chaosv1alpha1.AddToScheme(scheme)).To(Succeed())
+		r := &ChaosExperimentReconciler{Scheme: scheme, PodCacheLabels: map[string]string{"chaos": "allowed"}}
+		experiment := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "chaos", UID: "uid-1"}}
+		experiment.Spec.Attack.Type = chaosv1alpha1.ContainerKillAttack
+		target := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}}
+
+		pod, err := r.helperPodFor(experiment, target, "true")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pod.Labels).To(HaveKeyWithValue("chaos", "allowed"))
+		Expect(pod.Labels).To(HaveKeyWithValue(ExperimentLabel, "kill-web"))
+	})
+})
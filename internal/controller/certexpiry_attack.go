@@ -0,0 +1,230 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// certExpiryOriginal is stored in InjectedFault.Original for the cert-expiry
+// attack.
+type certExpiryOriginal struct {
+	Certificate []byte `json:"certificate"`
+	Key         []byte `json:"key"`
+	// InjectedSHA256 identifies the replacement certificate, so that a
+	// certificate rotated by someone else in the meantime is not overwritten.
+	InjectedSHA256 string `json:"injectedSHA256"`
+}
+
+// reconcileCertExpiryAttack replaces the certificate and key of a TLS Secret
+// with a self-signed certificate for the same subject and names that has
+// already expired, or expires shortly. The original pair is recorded as an
+// active fault and written back when the window ends.
+func (r *ChaosExperimentReconciler) reconcileCertExpiryAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "CertExpiry")
+
+	spec := experiment.Spec.Attack.CertExpiry
+	if spec == nil || spec.SecretName == "" {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "cert-expiry requires attack.certExpiry.secretName.")
+	}
+
+	namespace := experiment.Spec.Target.Namespace
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, []chaosv1alpha1.AffectedTarget{objectTarget("Secret", namespace, spec.SecretName)}); !allowed {
+		return result, err
+	}
+	now := metav1.Now()
+	revertAt := metav1.NewTime(now.Add(attackDuration(experiment, spec.Duration)))
+
+	// The replacement certificate is still in place from an earlier iteration.
+	if findFault(experiment, chaosv1alpha1.CertExpiryAttack, "Secret", namespace, spec.SecretName) != nil {
+		if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+			Attack:    chaosv1alpha1.CertExpiryAttack,
+			Kind:      "Secret",
+			Namespace: namespace,
+			Name:      spec.SecretName,
+			RevertAt:  revertAt,
+		}); err != nil {
+			logger.Error(err, "Failed to extend certificate swap in ChaosExperiment status", "Name", spec.SecretName)
+			return ctrl.Result{}, err
+		}
+		return r.completeAttackIteration(ctx, experiment, "Cert-expiry attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget("Secret", namespace, spec.SecretName)})
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: spec.SecretName}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return r.failExperiment(ctx, experiment, "SecretNotFound", fmt.Sprintf("Secret %s/%s not found.", namespace, spec.SecretName))
+		}
+		logger.Error(err, "Failed to get TLS secret", "Namespace", namespace, "Name", spec.SecretName)
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	notAfter := now.Add(-24 * time.Hour)
+	if spec.ValidFor != nil && spec.ValidFor.Duration > 0 {
+		notAfter = now.Add(spec.ValidFor.Duration)
+	}
+	certPEM, keyPEM, err := replacementCertificate(secret.Data[corev1.TLSCertKey], notAfter)
+	if err != nil {
+		return r.failExperiment(ctx, experiment, "InvalidCertificate", fmt.Sprintf("Secret %s/%s: %v", namespace, spec.SecretName, err))
+	}
+
+	injected := sha256.Sum256(certPEM)
+	encoded, err := json.Marshal(certExpiryOriginal{
+		Certificate:    secret.Data[corev1.TLSCertKey],
+		Key:            secret.Data[corev1.TLSPrivateKeyKey],
+		InjectedSHA256: hex.EncodeToString(injected[:]),
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+		Attack:     chaosv1alpha1.CertExpiryAttack,
+		Kind:       "Secret",
+		Namespace:  namespace,
+		Name:       spec.SecretName,
+		Original:   string(encoded),
+		InjectedAt: now,
+		RevertAt:   revertAt,
+	}); err != nil {
+		logger.Error(err, "Failed to record original certificate in ChaosExperiment status", "Name", spec.SecretName)
+		return ctrl.Result{}, err
+	}
+
+	patch := client.MergeFromWithOptions(secret.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	secret.Data[corev1.TLSCertKey] = certPEM
+	secret.Data[corev1.TLSPrivateKeyKey] = keyPEM
+	if err := r.targets(ctx).Patch(ctx, secret, patch); err != nil {
+		logger.Error(err, "Failed to replace certificate", "Namespace", namespace, "Name", secret.Name)
+		failOnError(experiment, "CertificateSwapFailed", err)
+		experiment.Status.Message = "Failed to replace target certificate."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "CertificateSwapFailed", "Failed to replace certificate in secret %s/%s", namespace, secret.Name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after secret patch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Replaced certificate", "Namespace", namespace, "Name", secret.Name, "NotAfter", notAfter, "RevertAt", revertAt)
+	r.Recorder.Eventf(experiment, "Normal", "CertificateSwapped", "Secret %s/%s now holds a certificate expiring at %s until %s.",
+		namespace, secret.Name, notAfter.Format(time.RFC3339), revertAt.Format(time.RFC3339))
+
+	return r.completeAttackIteration(ctx, experiment, "Cert-expiry attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget("Secret", namespace, spec.SecretName)})
+}
+
+// revertCertExpiry writes the original certificate and key back, as long as
+// the Secret still holds the replacement certificate.
+func (r *ChaosExperimentReconciler) revertCertExpiry(ctx context.Context, fault chaosv1alpha1.InjectedFault) error {
+	original := certExpiryOriginal{}
+	if err := json.Unmarshal([]byte(fault.Original), &original); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: fault.Namespace, Name: fault.Name}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	current := sha256.Sum256(secret.Data[corev1.TLSCertKey])
+	if hex.EncodeToString(current[:]) != original.InjectedSHA256 {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(secret.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	secret.Data[corev1.TLSCertKey] = original.Certificate
+	secret.Data[corev1.TLSPrivateKeyKey] = original.Key
+	return r.Patch(ctx, secret, patch)
+}
+
+// replacementCertificate returns a PEM encoded self-signed certificate and
+// key that copy the subject and names of the first certificate in certPEM,
+// but expire at notAfter.
+func replacementCertificate(certPEM []byte, notAfter time.Time) ([]byte, []byte, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, nil, fmt.Errorf("no PEM encoded certificate found in %s", corev1.TLSCertKey)
+	}
+	original, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:   serial,
+		Subject:        original.Subject,
+		DNSNames:       original.DNSNames,
+		IPAddresses:    original.IPAddresses,
+		URIs:           original.URIs,
+		EmailAddresses: original.EmailAddresses,
+		NotBefore:      notAfter.Add(-min(original.NotAfter.Sub(original.NotBefore), 90*24*time.Hour)),
+		NotAfter:       notAfter,
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    original.ExtKeyUsage,
+	}
+	if template.Subject.CommonName == "" && len(template.DNSNames) == 0 {
+		template.Subject = pkix.Name{CommonName: "chaos-cert-expiry"}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var certOut, keyOut bytes.Buffer
+	if err := pem.Encode(&certOut, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return nil, nil, err
+	}
+	if err := pem.Encode(&keyOut, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}); err != nil {
+		return nil, nil, err
+	}
+	return certOut.Bytes(), keyOut.Bytes(), nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Replacement certificates", func() {
+	It("should refuse secrets without a certificate", func() {
+		_, _, err := replacementCertificate(nil, time.Now())
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep the names of the original certificate and expire as requested", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "web"},
+			DNSNames:     []string{"web.demo.svc"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(24 * time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		notAfter := time.Now().Add(-time.Hour).Truncate(time.Second)
+		certPEM, keyPEM, err := replacementCertificate(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), notAfter)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyPEM).NotTo(BeEmpty())
+
+		block, _ := pem.Decode(certPEM)
+		Expect(block).NotTo(BeNil())
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Subject.CommonName).To(Equal("web"))
+		Expect(cert.DNSNames).To(Equal([]string{"web.demo.svc"}))
+		Expect(cert.NotAfter.Equal(notAfter)).To(BeTrue())
+		Expect(cert.NotBefore.Before(cert.NotAfter)).To(BeTrue())
+	})
+})
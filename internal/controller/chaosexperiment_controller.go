@@ -18,33 +18,128 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"slices"
+	"sort"
+	"strconv"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/audit"
 )
 
+// ProtectAnnotation opts a pod out of every experiment when set to "true".
+const ProtectAnnotation = "chaos.shanto.dev/protect"
+
+// OptInLabel opts a namespace in to chaos when set to "true" and the operator
+// requires namespaces to opt in.
+const OptInLabel = "chaos.shanto.dev/enabled"
+
 // ChaosExperimentReconciler reconciles a ChaosExperiment object
 type ChaosExperimentReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+
+	// HelperImage is the image used for the privileged helper pods that carry
+	// out attacks inside target containers. Defaults to DefaultHelperImage.
+	HelperImage string
+
+	// ProtectedNamespaces lists the namespaces experiments must never target.
+	ProtectedNamespaces []string
+
+	// WatchNamespaces restricts the operator to these namespaces, see
+	// CacheOptions. Experiments that target other namespaces are failed. All
+	// namespaces are watched when it is empty.
+	WatchNamespaces []string
+
+	// RequireNamespaceOptIn holds experiments until their target namespace
+	// carries OptInLabel.
+	RequireNamespaceOptIn bool
+
+	// PrometheusURL is the base URL of the Prometheus API that abort
+	// conditions are evaluated against, e.g. "http://prometheus:9090".
+	PrometheusURL string
+
+	// PolicyURL is the endpoint of an OPA-style policy that every attack is
+	// authorized against with the experiment and its resolved targets, e.g.
+	// "http://opa:8181/v1/data/chaos/allow". Attacks are not checked when
+	// it is empty.
+	PolicyURL string
+
+	// APIReader reads the events of experiments for their reports, and the
+	// target pods when PodListPageSize is set, without caching them. The
+	// client is used when it is not set.
+	APIReader client.Reader
+
+	// PodListPageSize, when set, lists target pods from the API server in
+	// pages of that many pods instead of from the cache, and picks the pods
+	// to kill at random while the pages arrive, so that large namespaces are
+	// never held in memory at once.
+	PodListPageSize int64
+
+	// PodCacheLabels are the labels the manager cache is restricted to for
+	// pods, see CacheOptions. Only pods carrying them are targeted, also
+	// when listed from the API server, and helper pods get them too.
+	PodCacheLabels map[string]string
+
+	// MaxConcurrentReconciles is how many experiments are reconciled at
+	// once. Defaults to 1.
+	MaxConcurrentReconciles int
+
+	// PodKillWorkers is how many pods a pod-kill iteration deletes or evicts
+	// at once. Defaults to DefaultPodKillWorkers.
+	PodKillWorkers int
+
+	// ImpersonatingClient returns a client that acts as the given user. The
+	// targets of experiments that set spec.serviceAccountName are listed and
+	// acted on with it; such experiments fail when it is not set.
+	ImpersonatingClient func(username string) (client.Client, error)
 }
 
+// DefaultProtectedNamespaces are the namespaces protected when the operator is
+// not configured otherwise.
+var DefaultProtectedNamespaces = []string{"kube-system", "kube-public", "kube-node-lease"}
+
 // +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosexperiments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosexperiments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosexperiments/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
-// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosbudgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosbudgets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaospolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosresults,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosresults/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete;patch
+// +kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list;watch;create;delete;patch;update
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;create;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=impersonate
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=apps,resources=replicasets;daemonsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main Kubernetes reconciliation loop that aims to
 // move the current state of the cluster closer to the desired state by
@@ -62,18 +157,44 @@ func (r *ChaosExperimentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			// Owned objects are automatically garbage collected. For additional cleanup logic,
 			// use finalizers. Return and don't requeue
 			logger.Info("ChaosExperiment resource not found. Ignoring since object must be deleted")
+			forgetResilienceScore(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
 		logger.Error(err, "Failed to get ChaosExperiment")
 		return ctrl.Result{}, err
 	}
+	ctx = audit.WithExperiment(ctx, experiment)
+	ctx = withStatusBase(ctx, experiment)
+
+	result, err := r.reconcileExperiment(ctx, experiment)
+	return r.retryFailures(ctx, experiment, requeueForDeadline(experiment, result), err)
+}
+
+// reconcileExperiment moves the experiment towards its next step: reverting
+// its faults, waiting, attacking or finishing.
+func (r *ChaosExperimentReconciler) reconcileExperiment(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Revert everything the experiment injected before letting it go.
+	if !experiment.DeletionTimestamp.IsZero() {
+		done, err := r.finalizeFaults(ctx, experiment)
+		if err != nil {
+			logger.Error(err, "Failed to revert injected faults of deleted ChaosExperiment")
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		if !done {
+			logger.Info("Waiting for helper pods of deleted ChaosExperiment to terminate", "Experiment", experiment.Name)
+			return ctrl.Result{RequeueAfter: time.Second * 5}, nil
+		}
+		return ctrl.Result{}, nil
+	}
 
 	// Initialize experiment phase if it's empty
 	if experiment.Status.Phase == "" {
 		experiment.Status.Phase = chaosv1alpha1.ExperimentPending
 		experiment.Status.Message = "Experiment initialized and pending."
-		if err := r.Status().Update(ctx, experiment); err != nil {
+		if err := r.patchStatus(ctx, experiment); err != nil {
 			logger.Error(err, "Failed to update ChaosExperiment status to Pending")
 			return ctrl.Result{}, err
 		}
@@ -81,59 +202,224 @@ func (r *ChaosExperimentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{RequeueAfter: time.Second * 5}, nil // Requeue to start processing
 	}
 
-	// Handle "Completed" or "Failed" experiments
-	if experiment.Status.Phase == chaosv1alpha1.ExperimentCompleted || experiment.Status.Phase == chaosv1alpha1.ExperimentFailed {
-		if experiment.Spec.Mode == chaosv1alpha1.OneShotMode {
-			logger.Info("One-shot experiment is completed or failed, not re-queueing", "Experiment", experiment.Name, "Phase", experiment.Status.Phase)
-			return ctrl.Result{}, nil
+	// Restart experiments whose spec changed while they were running.
+	if restarted, err := r.reconcileSpecChange(ctx, experiment); err != nil || restarted {
+		if err != nil {
+			logger.Error(err, "Failed to handle spec change of ChaosExperiment")
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
 		}
-		// For recurring, we will requeue based on duration
-		if experiment.Spec.Duration != nil {
-			requeueAfter := experiment.Spec.Duration.Duration
-			if experiment.Status.LastRunTime != nil {
-				sinceLastRun := time.Since(experiment.Status.LastRunTime.Time)
-				if sinceLastRun < requeueAfter {
-					logger.Info("Recurring experiment completed, re-queueing for next run", "Experiment", experiment.Name, "RequeueAfter", requeueAfter-sinceLastRun)
-					return ctrl.Result{RequeueAfter: requeueAfter - sinceLastRun}, nil
-				}
-			}
-			logger.Info("Recurring experiment completed, immediately re-queueing for next run", "Experiment", experiment.Name)
-			// Reset status for next run if it's recurring and duration has passed
-			experiment.Status.Phase = chaosv1alpha1.ExperimentRunning // Or Pending, depending on desired behavior
-			experiment.Status.Message = "Recurring experiment re-triggered."
-			if err := r.Status().Update(ctx, experiment); err != nil {
-				logger.Error(err, "Failed to update ChaosExperiment status for recurring run")
+		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
+	}
+
+	// Revert faults injected by earlier iterations whose window has ended.
+	if err := r.revertDueFaults(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to revert injected faults")
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	// Refuse to attack namespaces the operator protects or does not watch.
+	if slices.Contains(r.ProtectedNamespaces, experiment.Spec.Target.Namespace) {
+		return r.rejectProtectedTarget(ctx, experiment, "ProtectedNamespace",
+			fmt.Sprintf("Namespace %s is protected by the operator and cannot be targeted.", experiment.Spec.Target.Namespace))
+	}
+	if len(r.WatchNamespaces) > 0 && !slices.Contains(r.WatchNamespaces, experiment.Spec.Target.Namespace) {
+		return r.rejectProtectedTarget(ctx, experiment, "UnwatchedNamespace",
+			fmt.Sprintf("Namespace %s is not watched by the operator and cannot be targeted.", experiment.Spec.Target.Namespace))
+	}
+
+	// Experiments past their active deadline end, whatever their mode and state.
+	if completed, result, err := r.reconcileDeadline(ctx, experiment); completed {
+		return result, err
+	}
+
+	// Experiments wait for their target namespace to opt in, if required.
+	if blocked, err := r.reconcileNamespaceOptIn(ctx, experiment); err != nil || blocked {
+		if err != nil {
+			logger.Error(err, "Failed to check namespace opt-in of ChaosExperiment")
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		return requeueForFaults(experiment, ctrl.Result{RequeueAfter: time.Minute}), nil
+	}
+
+	// Experiments are aborted on request, even while suspended.
+	if aborted, result, err := r.reconcileAbortRequest(ctx, experiment); aborted {
+		return result, err
+	}
+
+	// Suspended experiments run no iterations until they are resumed.
+	if suspended, err := r.reconcileSuspension(ctx, experiment); err != nil || suspended {
+		if err != nil {
+			logger.Error(err, "Failed to update paused condition of ChaosExperiment")
+		}
+		return requeueForFaults(experiment, ctrl.Result{}), err
+	}
+
+	// Firing abort conditions stop the experiment and revert its faults.
+	if aborted, result, err := r.reconcileAbortConditions(ctx, experiment); aborted {
+		return result, err
+	}
+
+	// The steady-state hypothesis is verified again once the last iteration
+	// has taken effect, before anything else happens.
+	if waiting, result, err := r.verifyHypothesis(ctx, experiment); waiting {
+		return pollAbortConditions(experiment, requeueForFaults(experiment, result)), err
+	}
+
+	// Completed, aborted and failed experiments run no further iterations.
+	if experimentFinished(experiment) {
+		return r.reconcileFinished(ctx, experiment)
+	}
+
+	// Experiments with a ServiceAccount list and act on their targets as it.
+	ctx, err := r.withTargetClient(ctx, experiment)
+	if err != nil {
+		logger.Error(err, "Failed to impersonate ServiceAccount of ChaosExperiment", "ServiceAccount", experiment.Spec.ServiceAccountName)
+		return r.failExperiment(ctx, experiment, "ImpersonationFailed", fmt.Sprintf("Cannot act as ServiceAccount %s: %v.", experiment.Spec.ServiceAccountName, err))
+	}
+
+	result, err := r.reconcileIteration(ctx, experiment)
+	if err == nil {
+		result = requeueForNextIteration(experiment, result)
+	}
+	return pollAbortConditions(experiment, requeueForHypothesis(experiment, result)), err
+}
+
+// reconcileIteration runs the next attack iteration of the experiment, once
+// its lifetime, start point, schedule, allowed windows and concurrency policy
+// allow it.
+func (r *ChaosExperimentReconciler) reconcileIteration(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Check if the experiment should be completed based on its lifetime
+	if lifetime, ok := experimentLifetime(experiment); ok && experiment.Status.StartTime != nil {
+		if time.Since(experiment.Status.StartTime.Time) >= lifetime {
+			experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+			experiment.Status.Reason = "DurationElapsed"
+			experiment.Status.Message = "Experiment completed successfully."
+			setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionTrue, "DurationElapsed", experiment.Status.Message)
+			experiment.Status.NextScheduledTime = nil
+			if err := r.patchStatus(ctx, experiment); err != nil {
+				logger.Error(err, "Failed to update ChaosExperiment status to Completed")
 				return ctrl.Result{}, err
 			}
-			r.Recorder.Event(experiment, "Normal", "ExperimentReTriggered", "Recurring ChaosExperiment re-triggered.")
-		}
-	}
-
-	// Check if the experiment should be completed based on duration
-	if experiment.Spec.Duration != nil && experiment.Status.LastRunTime != nil {
-		durationElapsed := time.Since(experiment.Status.LastRunTime.Time)
-		if durationElapsed >= experiment.Spec.Duration.Duration {
-			if experiment.Spec.Mode == chaosv1alpha1.OneShotMode {
-				experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
-				experiment.Status.Message = "Experiment completed successfully."
-				if err := r.Status().Update(ctx, experiment); err != nil {
-					logger.Error(err, "Failed to update ChaosExperiment status to Completed")
-					return ctrl.Result{}, err
-				}
-				r.Recorder.Event(experiment, "Normal", "ExperimentCompleted", "ChaosExperiment has completed its one-shot execution.")
-				return ctrl.Result{}, nil
-			}
+			r.Recorder.Event(experiment, "Normal", "ExperimentCompleted", "ChaosExperiment has run for its full duration.")
+			r.notify(ctx, experiment, chaosv1alpha1.NotifyCompleted, experiment.Status.Message)
+			return requeueForFaults(experiment, ctrl.Result{}), nil
 		}
 	}
 
+	// Iterations interrupted after starting their helper pods are not run twice.
+	if resumed, result, err := r.resumeIteration(ctx, experiment); resumed {
+		return result, err
+	}
+
+	// Delayed experiments wait for their start point before the first iteration.
+	if started, result, err := r.waitForStart(ctx, experiment); !started {
+		return result, err
+	}
+
+	// Scheduled experiments only run an iteration when a scheduled time has
+	// come, other recurring experiments when their interval has passed.
+	if experiment.Spec.Schedule != "" {
+		if due, result, err := r.waitForSchedule(ctx, experiment); !due {
+			return result, err
+		}
+	} else if experiment.Spec.Mode == chaosv1alpha1.RecurringMode {
+		if due, result, err := r.waitForInterval(ctx, experiment); !due {
+			return result, err
+		}
+	}
+
+	// Iterations that come due outside of the allowed windows wait for the next one.
+	if len(experiment.Spec.AllowedWindows) > 0 {
+		if open, result, err := r.waitForWindow(ctx, experiment); !open {
+			return result, err
+		}
+	}
+
+	// Iterations of recurring experiments may not overlap, depending on the policy.
+	if experiment.Spec.Mode == chaosv1alpha1.RecurringMode && experiment.Spec.ConcurrencyPolicy != "" && experiment.Spec.ConcurrencyPolicy != chaosv1alpha1.AllowConcurrent {
+		if proceed, result, err := r.enforceConcurrencyPolicy(ctx, experiment); !proceed {
+			return result, err
+		}
+	}
+
+	// Dry runs only report what an iteration would attack.
+	if experiment.Spec.DryRun {
+		return r.reconcileDryRun(ctx, experiment)
+	}
+
+	// Chaos policies may forbid the attack or defer it to the windows they allow.
+	if allowed, result, err := r.enforceChaosPolicies(ctx, experiment); !allowed {
+		return result, err
+	}
+
+	// Chaos budgets may defer experiments that are not running yet.
+	if allowed, result, err := r.enforceConcurrencyBudgets(ctx, experiment); !allowed {
+		return result, err
+	}
+
+	// Chaos budget quotas may defer experiments that are not active yet.
+	if allowed, result, err := r.enforceActiveExperimentQuotas(ctx, experiment); !allowed {
+		return result, err
+	}
+
+	// The steady-state hypothesis has to hold before chaos is injected.
+	if steady, result, err := r.checkSteadyState(ctx, experiment); !steady {
+		return result, err
+	}
+
+	// Chaos budget quotas may limit how often the namespace or team is attacked.
+	if allowed, result, err := r.chargeAttackQuotas(ctx, experiment); !allowed {
+		return result, err
+	}
+
+	// Every iteration that gets to attack is identified by a run ID.
+	runID(experiment)
+
 	// Perform the attack based on attack type
 	switch experiment.Spec.Attack.Type {
 	case chaosv1alpha1.PodKillAttack:
 		return r.reconcilePodKillAttack(ctx, experiment)
+	case chaosv1alpha1.ContainerKillAttack:
+		return r.reconcileContainerKillAttack(ctx, experiment)
+	case chaosv1alpha1.CPUStressAttack:
+		return r.reconcileCPUStressAttack(ctx, experiment)
+	case chaosv1alpha1.MemoryStressAttack:
+		return r.reconcileMemoryStressAttack(ctx, experiment)
+	case chaosv1alpha1.NetworkPartitionAttack:
+		return r.reconcileNetworkPartitionAttack(ctx, experiment)
+	case chaosv1alpha1.IOStressAttack:
+		return r.reconcileIOStressAttack(ctx, experiment)
+	case chaosv1alpha1.NodeTaintAttack:
+		return r.reconcileNodeTaintAttack(ctx, experiment)
+	case chaosv1alpha1.KubeletChaosAttack:
+		return r.reconcileKubeletChaosAttack(ctx, experiment)
+	case chaosv1alpha1.TimeSkewAttack:
+		return r.reconcileTimeSkewAttack(ctx, experiment)
+	case chaosv1alpha1.GRPCFaultAttack:
+		return r.reconcileGRPCFaultAttack(ctx, experiment)
+	case chaosv1alpha1.ProcessKillAttack:
+		return r.reconcileProcessKillAttack(ctx, experiment)
+	case chaosv1alpha1.PodPauseAttack:
+		return r.reconcilePodPauseAttack(ctx, experiment)
+	case chaosv1alpha1.ScaleChaosAttack:
+		return r.reconcileScaleChaosAttack(ctx, experiment)
+	case chaosv1alpha1.ImagePullFailureAttack:
+		return r.reconcileImagePullFailureAttack(ctx, experiment)
+	case chaosv1alpha1.ConfigChaosAttack:
+		return r.reconcileConfigChaosAttack(ctx, experiment)
+	case chaosv1alpha1.ServiceBlackholeAttack:
+		return r.reconcileServiceBlackholeAttack(ctx, experiment)
+	case chaosv1alpha1.CertExpiryAttack:
+		return r.reconcileCertExpiryAttack(ctx, experiment)
+	case chaosv1alpha1.NetworkChaosAttack:
+		return r.reconcileNetworkChaosAttack(ctx, experiment)
 	default:
-		experiment.Status.Phase = chaosv1alpha1.ExperimentFailed
+		applyFailurePolicy(experiment, "UnsupportedAttackType")
 		experiment.Status.Message = "Unsupported attack type."
-		if err := r.Status().Update(ctx, experiment); err != nil {
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		if err := r.patchStatus(ctx, experiment); err != nil {
 			logger.Error(err, "Failed to update ChaosExperiment status for unsupported attack type")
 		}
 		r.Recorder.Event(experiment, "Warning", "UnsupportedAttackType", "ChaosExperiment specified an unsupported attack type.")
@@ -144,95 +430,967 @@ func (r *ChaosExperimentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 func (r *ChaosExperimentReconciler) reconcilePodKillAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
 	logger := log.FromContext(ctx).WithValues("AttackType", "PodKill")
 
-	// 1. List pods in spec.target.namespace using the given labelSelector.
-	podList := &corev1.PodList{}
-	listOpts := []client.ListOption{
-		client.InNamespace(experiment.Spec.Target.Namespace),
-		client.MatchingLabels(experiment.Spec.Target.LabelSelector),
+	// 1. Pick the pods to kill at random.
+	var count *int32
+	if experiment.Spec.Attack.PodKill != nil {
+		count = experiment.Spec.Attack.PodKill.Count
+	}
+	podsToKill, result, err := r.pickTargetPods(ctx, experiment, count)
+	if len(podsToKill) == 0 {
+		return result, err
+	}
+
+	// 2. Charge the kills to the chaos budgets covering the namespace.
+	allowed, err := r.reservePodKills(ctx, experiment, len(podsToKill))
+	if err != nil {
+		logger.Error(err, "Failed to charge pod kills to chaos budgets")
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	if allowed == 0 {
+		r.Recorder.Event(experiment, "Warning", "BudgetExhausted", "Iteration deferred because a chaos budget allows no further pod kills this hour.")
+		holdBackIteration(experiment, "BudgetExhausted", "A chaos budget allows no further pod kills this hour.")
+		return r.skipIteration(ctx, experiment, "Iteration deferred: the chaos budget for pod kills is exhausted.")
 	}
-	if err := r.List(ctx, podList, listOpts...); err != nil {
-		logger.Error(err, "Failed to list pods for chaos experiment", "Namespace", experiment.Spec.Target.Namespace, "LabelSelector", experiment.Spec.Target.LabelSelector)
-		experiment.Status.Phase = chaosv1alpha1.ExperimentFailed
-		experiment.Status.Message = "Failed to list target pods."
-		r.Recorder.Event(experiment, "Warning", "PodListFailed", "Failed to list target pods.")
-		if err := r.Status().Update(ctx, experiment); err != nil {
-			logger.Error(err, "Failed to update ChaosExperiment status to Failed after pod listing error")
+	podsToKill = podsToKill[:allowed]
+
+	// 3. Delete or evict them.
+	action, failureReason := "delete", "PodDeletionFailed"
+	evict := experiment.Spec.Attack.PodKill != nil && experiment.Spec.Attack.PodKill.DeletionMethod == chaosv1alpha1.EvictPod
+	if evict {
+		action, failureReason = "evict", "PodEvictionFailed"
+	}
+
+	// Deletions bypass PodDisruptionBudgets, so check them up front when asked to.
+	var budgets []policyv1.PodDisruptionBudget
+	respectBudgets := experiment.Spec.RespectPDB && !evict
+	if respectBudgets {
+		if budgets, err = r.disruptionBudgets(ctx, experiment.Spec.Target.Namespace); err != nil {
+			logger.Error(err, "Failed to list PodDisruptionBudgets")
+			return ctrl.Result{}, err
 		}
-		return ctrl.Result{RequeueAfter: time.Second * 30}, err // Requeue to retry listing pods
 	}
 
-	if len(podList.Items) == 0 {
-		// No pods found, update status and requeue after some time.
-		logger.Info("No target pods found for chaos experiment", "Namespace", experiment.Spec.Target.Namespace, "LabelSelector", experiment.Spec.Target.LabelSelector)
-		experiment.Status.Phase = chaosv1alpha1.ExperimentFailed
-		experiment.Status.Message = "No target pods found matching the label selector."
-		r.Recorder.Event(experiment, "Warning", "NoTargetPods", "No target pods found for the experiment.")
-		if err := r.Status().Update(ctx, experiment); err != nil {
-			logger.Error(err, "Failed to update ChaosExperiment status to Failed after no pods found")
+	blocked := 0
+	candidates := make([]*corev1.Pod, 0, len(podsToKill))
+	for i := range podsToKill {
+		if respectBudgets && !allowDisruption(budgets, &podsToKill[i]) {
+			logger.Info("Deletion skipped, PodDisruptionBudget allows no disruptions", "PodName", podsToKill[i].Name)
+			blocked++
+			continue
 		}
-		return ctrl.Result{RequeueAfter: time.Second * 60}, nil // Requeue to check again later
+		candidates = append(candidates, &podsToKill[i])
 	}
 
-	// 2. Pick one at random and delete it.
-	r.seedRand() // Seed the random number generator
-	podToKill := podList.Items[rand.Intn(len(podList.Items))]
-	logger.Info("Attempting to delete pod", "PodName", podToKill.Name, "Namespace", podToKill.Namespace)
+	// The pods are written to the status before they are killed, so that an
+	// iteration that fails to record its outcome never kills others.
+	if len(candidates) > 0 {
+		targets := make([]chaosv1alpha1.AffectedTarget, 0, len(candidates))
+		for _, candidate := range candidates {
+			targets = append(targets, podTarget(candidate))
+		}
+		if err := r.startRun(ctx, experiment, targets); err != nil {
+			logger.Error(err, "Failed to record the pods to "+action+" before the attack")
+			return ctrl.Result{}, err
+		}
+	}
+
+	var killed []chaosv1alpha1.AffectedTarget
+	var failures []chaosv1alpha1.TargetFailure
+	var killErr error
+	for i, err := range r.killPods(ctx, experiment, candidates, evict) {
+		podToKill := candidates[i]
+		switch {
+		case err == nil:
+			killed = append(killed, podTarget(podToKill))
+		case errors.IsTooManyRequests(err):
+			logger.Info("Eviction refused by PodDisruptionBudget", "PodName", podToKill.Name, "Reason", err.Error())
+			blocked++
+		default:
+			logger.Error(err, "Failed to "+action+" pod", "PodName", podToKill.Name)
+			r.Recorder.Eventf(experiment, "Warning", failureReason, "Failed to %s pod %s/%s", action, podToKill.Namespace, podToKill.Name)
+			failures = append(failures, chaosv1alpha1.TargetFailure{Target: targetName(podTarget(podToKill)), Error: err.Error()})
+			if killErr == nil {
+				killErr = err
+			}
+		}
+	}
+	if len(killed) == 0 && killErr != nil {
+		failOnError(experiment, failureReason, killErr)
+		experiment.Status.Message = fmt.Sprintf("Failed to %s target pod.", action)
+		experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{Time: metav1.Now(), Skipped: int32(blocked), Failures: failures}
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after pod "+action+" error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, killErr // Requeue to retry
+	}
+
+	// Evictions that would violate a PodDisruptionBudget are never forced.
+	experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{
+		Time:     metav1.Now(),
+		Targets:  targetNames(killed),
+		Skipped:  int32(blocked),
+		Failures: failures,
+	}
+	if len(killed) == 0 && respectBudgets {
+		r.Recorder.Event(experiment, "Warning", "DisruptionBlocked", "Iteration skipped because the PodDisruptionBudgets of the selected pods allow no disruptions.")
+		holdBackIteration(experiment, "DisruptionBlocked", "The PodDisruptionBudgets of the selected pods allow no disruptions.")
+		return r.skipIteration(ctx, experiment, "Iteration skipped: the PodDisruptionBudgets of the selected pods allow no disruptions.")
+	}
+	if len(killed) == 0 {
+		return r.failExperiment(ctx, experiment, "EvictionBlocked", "Evicting the selected pods would violate a PodDisruptionBudget.")
+	}
+	if blocked > 0 && respectBudgets {
+		r.Recorder.Eventf(experiment, "Warning", "DisruptionBlocked", "%d pod(s) were left alone because their PodDisruptionBudgets allow no disruptions.", blocked)
+	} else if blocked > 0 {
+		r.Recorder.Eventf(experiment, "Warning", "EvictionBlocked", "%d pod(s) were left alone because evicting them would violate a PodDisruptionBudget.", blocked)
+	}
+
+	// 4. Record the iteration and work out when to come back.
+	message := "Pod-kill attack executed."
+	if len(failures) > 0 {
+		message = fmt.Sprintf("Pod-kill attack executed, %d of %d pod(s) could not be %sd.", len(failures), len(candidates), action)
+	}
+	return r.completeAttackIteration(ctx, experiment, message, killed)
+}
+
+// deletePod deletes a target pod. A pod that is already gone counts as killed.
+func (r *ChaosExperimentReconciler) deletePod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, pod *corev1.Pod) error {
+	logger := log.FromContext(ctx).WithValues("AttackType", "PodKill")
+
+	logger.Info("Attempting to delete pod", "PodName", pod.Name, "Namespace", pod.Namespace)
+	var opts []client.DeleteOption
+	if gracePeriod := podKillGracePeriod(experiment); gracePeriod != nil {
+		opts = append(opts, client.GracePeriodSeconds(*gracePeriod))
+	}
+	if err := r.targets(ctx).Delete(ctx, pod, opts...); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		logger.Info("Pod to kill not found, it might have been deleted already", "PodName", pod.Name)
+		return nil
+	}
+	logger.Info("Successfully deleted pod", "PodName", pod.Name)
+	r.Recorder.AnnotatedEventf(experiment, runAnnotations(experiment), "Normal", "PodKilled", "Pod %s/%s was successfully killed.", pod.Namespace, pod.Name)
+	r.Recorder.AnnotatedEventf(pod, runAnnotations(experiment), "Warning", "PodKilled", "Pod was killed by ChaosExperiment %s/%s.", experiment.Namespace, experiment.Name)
+	return nil
+}
+
+// podKillGracePeriod returns the grace period pod-kill gives its targets, or
+// nil to use the grace period of the pod. Forced kills give them none.
+func podKillGracePeriod(experiment *chaosv1alpha1.ChaosExperiment) *int64 {
+	if experiment.Spec.Attack.PodKill == nil {
+		return nil
+	}
+	if experiment.Spec.Attack.PodKill.Force {
+		return ptr.To[int64](0)
+	}
+	return experiment.Spec.Attack.PodKill.GracePeriodSeconds
+}
+
+// evictPod removes a target pod through the Eviction API, so that
+// PodDisruptionBudgets are respected. A pod that is already gone counts as
+// killed; an eviction refused by a budget returns a TooManyRequests error.
+func (r *ChaosExperimentReconciler) evictPod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, pod *corev1.Pod) error {
+	logger := log.FromContext(ctx).WithValues("AttackType", "PodKill")
+
+	logger.Info("Attempting to evict pod", "PodName", pod.Name, "Namespace", pod.Namespace)
+	eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}
+	if gracePeriod := podKillGracePeriod(experiment); gracePeriod != nil {
+		eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: gracePeriod}
+	}
+	if err := r.targets(ctx).SubResource("eviction").Create(ctx, pod, eviction); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		logger.Info("Pod to evict not found, it might have been deleted already", "PodName", pod.Name)
+		return nil
+	}
+	logger.Info("Successfully evicted pod", "PodName", pod.Name)
+	r.Recorder.AnnotatedEventf(experiment, runAnnotations(experiment), "Normal", "PodEvicted", "Pod %s/%s was successfully evicted.", pod.Namespace, pod.Name)
+	r.Recorder.AnnotatedEventf(pod, runAnnotations(experiment), "Warning", "PodEvicted", "Pod was evicted by ChaosExperiment %s/%s.", experiment.Namespace, experiment.Name)
+	return nil
+}
+
+// withoutProtectedPods drops the pods that carry ProtectAnnotation or match
+// the exclude selector, which may be nil.
+func withoutProtectedPods(pods []corev1.Pod, exclude labels.Selector) []corev1.Pod {
+	kept := pods[:0]
+	for _, pod := range pods {
+		if pod.Annotations[ProtectAnnotation] == "true" {
+			continue
+		}
+		if exclude != nil && exclude.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		kept = append(kept, pod)
+	}
+	return kept
+}
+
+// podFields returns the fields a target field selector can match on. The
+// cache the controller reads pods from cannot filter by fields that are not
+// indexed, so field selectors are evaluated here instead of in the List call.
+func podFields(pod *corev1.Pod) fields.Set {
+	return fields.Set{
+		"metadata.name":            pod.Name,
+		"spec.nodeName":            pod.Spec.NodeName,
+		"spec.restartPolicy":       string(pod.Spec.RestartPolicy),
+		"spec.schedulerName":       pod.Spec.SchedulerName,
+		"spec.serviceAccountName":  pod.Spec.ServiceAccountName,
+		"spec.hostNetwork":         strconv.FormatBool(pod.Spec.HostNetwork),
+		"status.phase":             string(pod.Status.Phase),
+		"status.podIP":             pod.Status.PodIP,
+		"status.nominatedNodeName": pod.Status.NominatedNodeName,
+	}
+}
+
+// parsePodFieldSelector parses a target field selector, rejecting fields that
+// podFields does not provide. It returns nil if the selector is empty.
+func parsePodFieldSelector(selector string) (fields.Selector, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	parsed, err := fields.ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	supported := podFields(&corev1.Pod{})
+	for _, requirement := range parsed.Requirements() {
+		if !supported.Has(requirement.Field) {
+			return nil, fmt.Errorf("field %q is not supported", requirement.Field)
+		}
+	}
+	return parsed, nil
+}
+
+// podMeetsConditions reports whether the pod meets all of the conditions.
+func podMeetsConditions(pod *corev1.Pod, conditions []chaosv1alpha1.TargetPodCondition) bool {
+	ready := false
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			ready = condition.Status == corev1.ConditionTrue
+		}
+	}
+	for _, condition := range conditions {
+		switch condition {
+		case chaosv1alpha1.PodRunning:
+			if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+				return false
+			}
+		case chaosv1alpha1.PodReady:
+			if !ready {
+				return false
+			}
+		case chaosv1alpha1.PodNotReady:
+			if ready {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// filterPodsByNode keeps the pods scheduled to a node matching the selector.
+func (r *ChaosExperimentReconciler) filterPodsByNode(ctx context.Context, selector labels.Selector, pods []corev1.Pod) ([]corev1.Pod, error) {
+	nodeList := &corev1.NodeList{}
+	if err := r.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	nodes := make(map[string]bool, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		nodes[node.Name] = true
+	}
+
+	kept := pods[:0]
+	for _, pod := range pods {
+		if nodes[pod.Spec.NodeName] {
+			kept = append(kept, pod)
+		}
+	}
+	return kept, nil
+}
+
+// pickTargetPods lists the pods matching the experiment target and returns a
+// subset of them chosen by the selection strategy: count pods when count is
+// set, else as many as targetPodCount says, but no more than spec.safeguards
+// still allows. The picked pods are authorized by the policy and recorded
+// for the safeguards unless the experiment is a dry run. If no pod can be
+// picked, the experiment status is updated accordingly and no pods are
+// returned together with the result the caller should hand back to the
+// controller.
+func (r *ChaosExperimentReconciler) pickTargetPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, count *int32) ([]corev1.Pod, ctrl.Result, error) {
+	var pods []corev1.Pod
+	var matching int
+	var result ctrl.Result
+	var err error
+	var rng *rand.Rand
+	if strategy := experiment.Spec.Target.SelectionStrategy; strategy == "" || strategy == chaosv1alpha1.SelectRandom {
+		rng = selectionRand(experiment)
+	}
+	if r.samplesTargetPods(experiment) {
+		pods, matching, result, err = r.sampleTargetPods(ctx, experiment, count, rng)
+	} else {
+		pods, result, err = r.listTargetPods(ctx, experiment)
+		matching = len(pods)
+	}
+	if len(pods) == 0 {
+		return nil, result, err
+	}
+
+	n := targetPodCount(experiment, matching)
+	if count != nil {
+		n = int(*count)
+	}
+	// A sample holds fewer pods than match.
+	n = min(n, len(pods))
+	now := time.Now()
+	if remaining, limited := affectedBudget(experiment, matching, now); limited {
+		if remaining == 0 {
+			r.Recorder.Event(experiment, "Warning", "BlastRadiusLimited", "Iteration skipped because safeguards.maxAffectedPercentage has been reached.")
+			holdBackIteration(experiment, "BlastRadiusLimited", "safeguards.maxAffectedPercentage has been reached.")
+			result, err := r.skipIteration(ctx, experiment, "Iteration skipped: safeguards.maxAffectedPercentage has been reached.")
+			return nil, result, err
+		}
+		n = min(n, remaining)
+	}
+	selected := r.selectPods(experiment, pods, n, rng)
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, podTargets(selected)); !allowed {
+		return nil, result, err
+	}
+	if !experiment.Spec.DryRun {
+		recordAffected(experiment, selected, now)
+	}
+	return selected, ctrl.Result{}, nil
+}
+
+// targetPodCount returns how many of the matching pods an iteration affects:
+// target.percentage of them rounded up, or a single pod when it is not set.
+func targetPodCount(experiment *chaosv1alpha1.ChaosExperiment, matching int) int {
+	if percentage := experiment.Spec.Target.Percentage; percentage != nil {
+		return min(max((matching*int(*percentage)+99)/100, 1), matching)
+	}
+	return 1
+}
+
+// pickTargetPod lists the pods matching the experiment target and returns one
+// of them, chosen by the selection strategy. If no pod can be picked, the
+// experiment status is updated accordingly and a nil pod is returned together
+// with the result the caller should hand back to the controller.
+func (r *ChaosExperimentReconciler) pickTargetPod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (*corev1.Pod, ctrl.Result, error) {
+	pods, result, err := r.pickTargetPods(ctx, experiment, ptr.To[int32](1))
+	if len(pods) == 0 {
+		return nil, result, err
+	}
+	return &pods[0], ctrl.Result{}, nil
+}
+
+// selectPods returns the first n pods in the order given by the selection
+// strategy of the experiment, shuffling them with rng for random selection.
+// The picked pods, and for round-robin the last of them, are recorded in the
+// status, which is persisted when the iteration completes.
+func (r *ChaosExperimentReconciler) selectPods(experiment *chaosv1alpha1.ChaosExperiment, pods []corev1.Pod, n int, rng *rand.Rand) []corev1.Pod {
+	strategy := experiment.Spec.Target.SelectionStrategy
+	if strategy == "" || strategy == chaosv1alpha1.SelectRandom {
+		// The cache lists pods in no particular order.
+		sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+		rng.Shuffle(len(pods), func(i, j int) { pods[i], pods[j] = pods[j], pods[i] })
+	} else {
+		orderPods(strategy, pods, experiment.Status.LastSelectedPod)
+		if strategy == chaosv1alpha1.SelectRoundRobin {
+			experiment.Status.LastSelectedPod = pods[n-1].Name
+		}
+	}
+
+	experiment.Status.SelectionOrder = make([]string, n)
+	for i := range n {
+		experiment.Status.SelectionOrder[i] = pods[i].Name
+	}
+	return pods[:n]
+}
 
-	if err := r.Delete(ctx, &podToKill); err != nil {
+// selectionRand returns the source of randomness for selecting the target
+// pods of the upcoming iteration. It is seeded with the experiment seed,
+// spec.seed or else one picked now and recorded in status.seed, combined with
+// the iteration number, so that iterations differ from each other but each
+// of them can be replayed.
+func selectionRand(experiment *chaosv1alpha1.ChaosExperiment) *rand.Rand {
+	var seed int64
+	switch {
+	case experiment.Spec.Seed != nil:
+		seed = *experiment.Spec.Seed
+	case experiment.Status.Seed != nil:
+		seed = *experiment.Status.Seed
+	default:
+		seed = rand.Int63()
+	}
+	experiment.Status.Seed = &seed
+	return rand.New(rand.NewSource(seed + int64(experiment.Status.IterationsCompleted) + 1))
+}
+
+// orderPods sorts the pods for the oldest, newest and round-robin selection
+// strategies. Round-robin starts with the first pod whose name sorts after
+// last, wrapping around, so that the rotation survives pods coming and going.
+func orderPods(strategy chaosv1alpha1.SelectionStrategy, pods []corev1.Pod, last string) {
+	switch strategy {
+	case chaosv1alpha1.SelectOldest, chaosv1alpha1.SelectNewest:
+		sort.SliceStable(pods, func(i, j int) bool {
+			a, b := pods[i].CreationTimestamp, pods[j].CreationTimestamp
+			if a.Equal(&b) {
+				return pods[i].Name < pods[j].Name
+			}
+			if strategy == chaosv1alpha1.SelectNewest {
+				return b.Before(&a)
+			}
+			return a.Before(&b)
+		})
+	case chaosv1alpha1.SelectRoundRobin:
+		sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+		next := sort.Search(len(pods), func(i int) bool { return pods[i].Name > last })
+		// Rotate the pods left by next.
+		slices.Reverse(pods[:next])
+		slices.Reverse(pods[next:])
+		slices.Reverse(pods)
+	}
+}
+
+// listTargetPods lists the pods matching the experiment target. If listing
+// fails or nothing matches, the experiment status is updated accordingly and
+// no pods are returned together with the result the caller should hand back
+// to the controller.
+func (r *ChaosExperimentReconciler) listTargetPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) ([]corev1.Pod, ctrl.Result, error) {
+	var pods []corev1.Pod
+	matching, result, err := r.visitTargetPods(ctx, experiment, func(page []corev1.Pod) {
+		pods = append(pods, page...)
+	})
+	if matching == 0 {
+		return nil, result, err
+	}
+	return pods, ctrl.Result{}, nil
+}
+
+// targetPodFilter holds what the pods listed by the selector of an experiment
+// target are filtered by.
+type targetPodFilter struct {
+	workload      client.Object
+	exclude       labels.Selector
+	nodeSelector  labels.Selector
+	fieldSelector fields.Selector
+}
+
+// visitTargetPods hands the pods matching the experiment target to visit, a
+// page at a time, and returns how many matched. If listing fails or nothing
+// matches, the experiment status is updated accordingly and zero is returned
+// together with the result the caller should hand back to the controller.
+func (r *ChaosExperimentReconciler) visitTargetPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, visit func([]corev1.Pod)) (int, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Resolve the selector of workload targets on every iteration, so the
+	// experiment follows the workload when its labels change.
+	var workload client.Object
+	var selector labels.Selector
+	var err error
+	if ref := experiment.Spec.Target.Workload; ref != nil {
+		workload, err = r.getTargetWorkload(ctx, experiment.Spec.Target.Namespace, ref)
 		if errors.IsNotFound(err) {
-			logger.Info("Pod to kill not found, it might have been deleted already", "PodName", podToKill.Name)
-			// Continue as if deleted, update status.
-		} else {
-			logger.Error(err, "Failed to delete pod", "PodName", podToKill.Name)
-			experiment.Status.Phase = chaosv1alpha1.ExperimentFailed
-			experiment.Status.Message = "Failed to delete target pod."
-			r.Recorder.Eventf(experiment, "Warning", "PodDeletionFailed", "Failed to delete pod %s/%s", podToKill.Namespace, podToKill.Name)
-			if err := r.Status().Update(ctx, experiment); err != nil {
-				logger.Error(err, "Failed to update ChaosExperiment status to Failed after pod deletion error")
+			logger.Info("Target workload not found", "Kind", ref.Kind, "Namespace", experiment.Spec.Target.Namespace, "Name", ref.Name)
+			applyFailurePolicy(experiment, "WorkloadNotFound")
+			experiment.Status.Message = "Target workload not found."
+			setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionFalse, "WorkloadNotFound", experiment.Status.Message)
+			r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+			r.Recorder.Eventf(experiment, "Warning", "WorkloadNotFound", "Target %s %s/%s not found.", ref.Kind, experiment.Spec.Target.Namespace, ref.Name)
+			retryAfter := countFailure(experiment)
+			if err := r.patchStatus(ctx, experiment); err != nil {
+				logger.Error(err, "Failed to update ChaosExperiment status to Failed after workload lookup")
 			}
-			return ctrl.Result{RequeueAfter: time.Second * 30}, err // Requeue to retry
+			return 0, ctrl.Result{RequeueAfter: retryAfter}, nil // Requeue to check again later
+		}
+		if err != nil {
+			logger.Error(err, "Failed to get target workload", "Kind", ref.Kind, "Namespace", experiment.Spec.Target.Namespace, "Name", ref.Name)
+			return 0, ctrl.Result{RequeueAfter: time.Second * 30}, err
 		}
+		selector, err = workloadPodSelector(workload)
 	} else {
-		logger.Info("Successfully deleted pod", "PodName", podToKill.Name)
-		r.Recorder.Eventf(experiment, "Normal", "PodKilled", "Pod %s/%s was successfully killed.", podToKill.Namespace, podToKill.Name)
+		selector, err = experiment.Spec.Target.PodSelector()
+	}
+	if err != nil {
+		message := fmt.Sprintf("Invalid target selector: %v", err)
+		setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionFalse, "InvalidTarget", message)
+		result, err := r.failExperiment(ctx, experiment, "InvalidTarget", message)
+		return 0, result, err
+	}
+	if len(r.PodCacheLabels) > 0 {
+		requirements, _ := labels.SelectorFromSet(r.PodCacheLabels).Requirements()
+		selector = selector.Add(requirements...)
+	}
+	exclude, err := experiment.Spec.Target.ExcludeSelector()
+	if err != nil {
+		message := fmt.Sprintf("Invalid target exclude selector: %v", err)
+		setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionFalse, "InvalidTarget", message)
+		result, err := r.failExperiment(ctx, experiment, "InvalidTarget", message)
+		return 0, result, err
+	}
+	nodeSelector, err := experiment.Spec.Target.TargetNodeSelector()
+	if err != nil {
+		message := fmt.Sprintf("Invalid target node selector: %v", err)
+		setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionFalse, "InvalidTarget", message)
+		result, err := r.failExperiment(ctx, experiment, "InvalidTarget", message)
+		return 0, result, err
+	}
+	fieldSelector, err := parsePodFieldSelector(experiment.Spec.Target.FieldSelector)
+	if err != nil {
+		message := fmt.Sprintf("Invalid target field selector: %v", err)
+		setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionFalse, "InvalidTarget", message)
+		result, err := r.failExperiment(ctx, experiment, "InvalidTarget", message)
+		return 0, result, err
+	}
+
+	filter := targetPodFilter{workload: workload, exclude: exclude, nodeSelector: nodeSelector, fieldSelector: fieldSelector}
+
+	// List pods in spec.target.namespace using the target selector.
+	listOpts := []client.ListOption{
+		client.InNamespace(experiment.Spec.Target.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	}
+	matching := 0
+	for continueToken := ""; ; {
+		podList := &corev1.PodList{}
+		if err := r.listPodPage(ctx, podList, continueToken, listOpts...); err != nil {
+			logger.Error(err, "Failed to list pods for chaos experiment", "Namespace", experiment.Spec.Target.Namespace, "Selector", selector.String())
+			failOnError(experiment, "PodListFailed", err)
+			experiment.Status.Message = "Failed to list target pods."
+			r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+			r.Recorder.Event(experiment, "Warning", "PodListFailed", "Failed to list target pods.")
+			if err := r.patchStatus(ctx, experiment); err != nil {
+				logger.Error(err, "Failed to update ChaosExperiment status to Failed after pod listing error")
+			}
+			return 0, ctrl.Result{RequeueAfter: time.Second * 30}, err // Requeue to retry listing pods
+		}
+		pods, err := r.filterTargetPods(ctx, experiment, filter, podList.Items)
+		if err != nil {
+			logger.Error(err, "Failed to filter target pods")
+			return 0, ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		if len(pods) > 0 {
+			matching += len(pods)
+			visit(pods)
+		}
+		if continueToken = podList.Continue; continueToken == "" {
+			break
+		}
+	}
+
+	if matching == 0 {
+		logger.Info("No target pods found for chaos experiment", "Namespace", experiment.Spec.Target.Namespace, "Selector", selector.String())
+		result, err := r.reconcileNoTargets(ctx, experiment)
+		return 0, result, err
 	}
 
-	// 3. Set status.phase = "Running" and status.lastRunTime = now.
+	setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionTrue, "TargetsFound", fmt.Sprintf("%d target pod(s) found.", matching))
+	if meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionWaitingForTargets) {
+		setCondition(experiment, chaosv1alpha1.ConditionWaitingForTargets, metav1.ConditionFalse, "TargetsFound", fmt.Sprintf("%d target pod(s) found.", matching))
+		r.Recorder.Eventf(experiment, "Normal", "TargetsAppeared", "%d target pod(s) appeared.", matching)
+	}
+	return matching, ctrl.Result{}, nil
+}
+
+// filterTargetPods keeps the pods of a page that pass the filters of the
+// experiment target.
+func (r *ChaosExperimentReconciler) filterTargetPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, filter targetPodFilter, pods []corev1.Pod) ([]corev1.Pod, error) {
+	var err error
+	if filter.workload != nil {
+		if pods, err = r.filterWorkloadPods(ctx, filter.workload, pods); err != nil {
+			return nil, fmt.Errorf("filtering pods of target workload %s: %w", filter.workload.GetName(), err)
+		}
+	}
+	pods = withoutProtectedPods(pods, filter.exclude)
+	if filter.fieldSelector != nil {
+		pods = slices.DeleteFunc(pods, func(pod corev1.Pod) bool {
+			return !filter.fieldSelector.Matches(podFields(&pod))
+		})
+	}
+	if conditions := experiment.Spec.Target.PodConditions; len(conditions) > 0 {
+		pods = slices.DeleteFunc(pods, func(pod corev1.Pod) bool {
+			return !podMeetsConditions(&pod, conditions)
+		})
+	}
+	if filter.nodeSelector != nil {
+		if pods, err = r.filterPodsByNode(ctx, filter.nodeSelector, pods); err != nil {
+			return nil, fmt.Errorf("listing nodes matching the target node selector %s: %w", filter.nodeSelector, err)
+		}
+	}
+	if kind := experiment.Spec.Target.OwnerKind; kind != "" {
+		if pods, err = r.filterPodsByOwnerKind(ctx, kind, pods); err != nil {
+			return nil, fmt.Errorf("resolving owners of target pods: %w", err)
+		}
+	}
+	if experiment.Spec.Target.Role != "" {
+		if pods, err = r.filterPodsByRole(ctx, experiment, pods); err != nil {
+			return nil, fmt.Errorf("identifying the leader of the target pods: %w", err)
+		}
+	}
+	return pods, nil
+}
+
+// completeAttackIteration marks the experiment as Running after a successful
+// attack iteration, records the run time and the targets the iteration acted
+// on, and decides when the experiment should be reconciled again.
+func (r *ChaosExperimentReconciler) completeAttackIteration(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, message string, targets []chaosv1alpha1.AffectedTarget) (ctrl.Result, error) {
+	return r.completeAttackIterationAt(ctx, experiment, message, targets, time.Now())
+}
+
+// completeAttackIterationAt completes an iteration like
+// completeAttackIteration, as run at the given time.
+func (r *ChaosExperimentReconciler) completeAttackIterationAt(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, message string, targets []chaosv1alpha1.AffectedTarget, at time.Time) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Set status.phase = "Running" and status.lastRunTime = now.
 	experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
-	now := metav1.Now()
+	experiment.Status.Reason = ""
+	now := metav1.NewTime(at)
+	// Dry runs affect nothing and have recorded what they would have.
+	if len(targets) > 0 {
+		experiment.Status.LastAffectedTargets = targets
+		setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionTrue, "TargetsFound", fmt.Sprintf("%d target(s) found.", len(targets)))
+		if last := experiment.Status.LastIteration; last == nil || !currentIteration(experiment, last) {
+			experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{Time: now, Targets: targetNames(targets)}
+		}
+		r.Recorder.AnnotatedEventf(experiment, runAnnotations(experiment), "Normal", "TargetsAffected", "Iteration %d (run %s) affected %s.", experiment.Status.IterationsCompleted+1, runID(experiment), describeTargets(targets))
+	}
+	r.recordIteration(ctx, experiment, chaosv1alpha1.IterationSucceeded, message, now.Time)
 	experiment.Status.LastRunTime = &now
-	experiment.Status.Message = "Pod-kill attack executed."
+	started := experiment.Status.StartTime == nil
+	if started {
+		experiment.Status.StartTime = &now
+	}
+	experiment.Status.IterationsCompleted++
+	experiment.Status.ConsecutiveFailures = 0
+	experiment.Status.Message = message
+	scheduleHypothesisCheck(experiment, now.Time)
+	nextRun, scheduled := r.nextIteration(experiment, now.Time)
 
-	if err := r.Status().Update(ctx, experiment); err != nil {
-		logger.Error(err, "Failed to update ChaosExperiment status after pod kill")
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status after attack")
 		return ctrl.Result{}, err
 	}
+	if started {
+		r.notify(ctx, experiment, chaosv1alpha1.NotifyStarted, "Experiment started.")
+	}
+	r.notify(ctx, experiment, chaosv1alpha1.NotifyAttackExecuted, message)
 
-	// Determine next requeue for recurring experiments or for duration check
-	if experiment.Spec.Mode == chaosv1alpha1.RecurringMode && experiment.Spec.Duration != nil {
-		logger.Info("Requeuing recurring experiment", "Experiment", experiment.Name, "RequeueAfter", experiment.Spec.Duration.Duration)
-		return ctrl.Result{RequeueAfter: experiment.Spec.Duration.Duration}, nil
-	} else if experiment.Spec.Duration != nil {
-		// For one-shot, requeue to check for completion if duration is set
-		timeToCompletion := experiment.Spec.Duration.Duration - time.Since(experiment.Status.LastRunTime.Time)
-		if timeToCompletion > 0 {
-			logger.Info("Requeuing one-shot experiment to check for completion", "Experiment", experiment.Name, "RequeueAfter", timeToCompletion)
-			return ctrl.Result{RequeueAfter: timeToCompletion}, nil
+	// Determine next requeue for recurring experiments or for the lifetime check
+	lifetime, bounded := experimentLifetime(experiment)
+	if limit := experiment.Spec.MaxIterations; limit != nil && experiment.Status.IterationsCompleted >= *limit {
+		experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+		experiment.Status.Reason = "MaxIterationsReached"
+		experiment.Status.Message = fmt.Sprintf("Experiment completed after %d iterations.", experiment.Status.IterationsCompleted)
+		setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionTrue, "MaxIterationsReached", experiment.Status.Message)
+		experiment.Status.NextScheduledTime = nil
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Completed after the last iteration")
+			return ctrl.Result{}, err
 		}
+		r.Recorder.Eventf(experiment, "Normal", "ExperimentCompleted", "ChaosExperiment completed after %d iterations.", experiment.Status.IterationsCompleted)
+		r.notify(ctx, experiment, chaosv1alpha1.NotifyCompleted, experiment.Status.Message)
+		return requeueForFaults(experiment, ctrl.Result{}), nil
 	}
-
-	// If one-shot and no duration, it's considered complete after one successful run
-	if experiment.Spec.Mode == chaosv1alpha1.OneShotMode && experiment.Spec.Duration == nil {
+	if experiment.Spec.Mode != chaosv1alpha1.RecurringMode && !bounded {
+		// If one-shot and no duration, it's considered complete after one successful run
 		experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+		experiment.Status.Reason = "OneShotCompleted"
 		experiment.Status.Message = "One-shot experiment completed successfully (no duration specified)."
-		if err := r.Status().Update(ctx, experiment); err != nil {
+		setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionTrue, "OneShotCompleted", experiment.Status.Message)
+		if err := r.patchStatus(ctx, experiment); err != nil {
 			logger.Error(err, "Failed to update ChaosExperiment status to Completed for one-shot without duration")
 		}
 		r.Recorder.Event(experiment, "Normal", "ExperimentCompleted", "One-shot ChaosExperiment completed successfully.")
-		return ctrl.Result{}, nil
+		r.notify(ctx, experiment, chaosv1alpha1.NotifyCompleted, experiment.Status.Message)
+		return requeueForFaults(experiment, ctrl.Result{}), nil
+	}
+
+	var requeueAfter time.Duration
+	if scheduled {
+		logger.Info("Requeuing recurring experiment", "Experiment", experiment.Name, "NextScheduledTime", experiment.Status.NextScheduledTime)
+		// Iterations completed once their helper pods finished ran earlier.
+		requeueAfter = max(nextRun-time.Since(at), time.Second)
+	}
+	if bounded {
+		// Requeue to check for completion no later than the end of the lifetime.
+		timeToCompletion := max(lifetime-time.Since(experiment.Status.StartTime.Time), time.Second)
+		if requeueAfter == 0 || timeToCompletion < requeueAfter {
+			logger.Info("Requeuing experiment to check for completion", "Experiment", experiment.Name, "RequeueAfter", timeToCompletion)
+			requeueAfter = timeToCompletion
+		}
+	}
+	return requeueForFaults(experiment, ctrl.Result{RequeueAfter: requeueAfter}), nil
+}
+
+// jitter moves the interval by a random amount of up to spec.jitter in either
+// direction, keeping it at least a second.
+func (r *ChaosExperimentReconciler) jitter(experiment *chaosv1alpha1.ChaosExperiment, interval time.Duration) time.Duration {
+	if experiment.Spec.Jitter == nil || experiment.Spec.Jitter.Duration <= 0 {
+		return interval
+	}
+	r.seedRand() // Seed the random number generator
+	jitter := experiment.Spec.Jitter.Duration
+	return max(interval-jitter+time.Duration(rand.Int63n(int64(2*jitter)+1)), time.Second)
+}
+
+// skipIteration records an iteration of a recurring experiment that did not
+// attack anything and returns when the next one is due.
+func (r *ChaosExperimentReconciler) skipIteration(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, message string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	now := metav1.Now()
+	r.recordIteration(ctx, experiment, chaosv1alpha1.IterationSkipped, message, now.Time)
+	experiment.Status.LastRunTime = &now
+	experiment.Status.Message = message
+	requeueAfter := time.Second * 30
+	if nextRun, ok := r.nextIteration(experiment, now.Time); ok {
+		requeueAfter = nextRun
+	}
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status after skipping an iteration")
+		return ctrl.Result{}, err
+	}
+	return requeueForFaults(experiment, ctrl.Result{RequeueAfter: requeueAfter}), nil
+}
+
+// recurrenceInterval returns how long a recurring experiment waits between
+// iterations: spec.interval or, for experiments written before it existed,
+// spec.duration. It returns false for one-shot experiments and when neither
+// is set.
+func recurrenceInterval(experiment *chaosv1alpha1.ChaosExperiment) (time.Duration, bool) {
+	if experiment.Spec.Mode != chaosv1alpha1.RecurringMode {
+		return 0, false
+	}
+	if experiment.Spec.Interval != nil && experiment.Spec.Interval.Duration > 0 {
+		return experiment.Spec.Interval.Duration, true
+	}
+	if durationAsInterval(experiment) {
+		return experiment.Spec.Duration.Duration, true
+	}
+	return 0, false
+}
+
+// durationAsInterval reports whether the recurring experiment uses the
+// deprecated spec.duration as its interval, because it sets neither
+// spec.interval nor spec.schedule. Such experiments run until deleted.
+func durationAsInterval(experiment *chaosv1alpha1.ChaosExperiment) bool {
+	return experiment.Spec.Mode == chaosv1alpha1.RecurringMode && experiment.Spec.Interval == nil && experiment.Spec.Schedule == "" &&
+		experiment.Spec.Duration != nil && experiment.Spec.Duration.Duration > 0
+}
+
+// experimentLifetime returns how long the experiment runs, counted from its
+// first iteration, spec.duration. It returns false for experiments without a
+// duration and for recurring experiments that use it as their interval.
+func experimentLifetime(experiment *chaosv1alpha1.ChaosExperiment) (time.Duration, bool) {
+	if experiment.Spec.Duration == nil || experiment.Spec.Duration.Duration <= 0 || durationAsInterval(experiment) {
+		return 0, false
+	}
+	return experiment.Spec.Duration.Duration, true
+}
+
+// nextIteration records when the next iteration of a recurring experiment is
+// due in status.nextScheduledTime, after an iteration that ended at now, and
+// returns how long it is until then. It returns false for one-shot
+// experiments.
+func (r *ChaosExperimentReconciler) nextIteration(experiment *chaosv1alpha1.ChaosExperiment, now time.Time) (time.Duration, bool) {
+	if experiment.Spec.Schedule != "" {
+		return nextScheduledRun(experiment, now)
+	}
+	interval, ok := recurrenceInterval(experiment)
+	if !ok {
+		return 0, false
+	}
+	next := r.jitter(experiment, interval)
+	experiment.Status.NextScheduledTime = &metav1.Time{Time: now.Add(next)}
+	return next, true
+}
+
+// requeueForNextIteration makes sure a running recurring experiment comes
+// back for its next iteration when the iteration just run, failed or not,
+// did not ask for anything else: when the next iteration is due or, after a
+// failed one, after the retry backoff.
+func requeueForNextIteration(experiment *chaosv1alpha1.ChaosExperiment, result ctrl.Result) ctrl.Result {
+	if experiment.Spec.Mode != chaosv1alpha1.RecurringMode || experiment.Status.Phase != chaosv1alpha1.ExperimentRunning || result.RequeueAfter > 0 {
+		return result
+	}
+	if next := experiment.Status.NextScheduledTime; next != nil && next.After(time.Now()) {
+		return requeueForFaults(experiment, ctrl.Result{RequeueAfter: capToLifetime(experiment, time.Until(next.Time))})
+	}
+	return requeueForFaults(experiment, ctrl.Result{RequeueAfter: capToLifetime(experiment, retryBackoff(experiment, max(experiment.Status.ConsecutiveFailures, 1)))})
+}
+
+// reconcileFinished records when an experiment finished and deletes it once
+// spec.ttlSecondsAfterFinished has passed. Until then it is requeued to revert
+// its remaining faults and to honor the TTL.
+func (r *ChaosExperimentReconciler) reconcileFinished(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if experiment.Status.CompletionTime == nil {
+		now := metav1.Now()
+		experiment.Status.CompletionTime = &now
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to record completion time of ChaosExperiment")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if experiment.Status.ReportRef == nil {
+		if err := r.writeReport(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to write report of ChaosExperiment")
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		r.Recorder.Eventf(experiment, "Normal", "ReportWritten", "Report written to ConfigMap %s.", experiment.Status.ReportRef.Name)
+	}
+
+	var result ctrl.Result
+	if ttl := experiment.Spec.TTLSecondsAfterFinished; ttl != nil {
+		expiresIn := time.Until(experiment.Status.CompletionTime.Add(time.Duration(*ttl) * time.Second))
+		if expiresIn <= 0 {
+			// Deleting the experiment reverts its remaining faults first.
+			logger.Info("Deleting finished experiment after its TTL", "Experiment", experiment.Name, "Phase", experiment.Status.Phase)
+			if err := r.Delete(ctx, experiment); err != nil && !errors.IsNotFound(err) {
+				logger.Error(err, "Failed to delete finished ChaosExperiment")
+				return ctrl.Result{RequeueAfter: time.Second * 30}, err
+			}
+			return ctrl.Result{}, nil
+		}
+		result.RequeueAfter = expiresIn
 	}
 
-	return ctrl.Result{}, nil
+	if next, ok := nextFaultRevert(experiment); ok {
+		logger.Info("Experiment is completed or failed, re-queueing to revert faults", "Experiment", experiment.Name, "Phase", experiment.Status.Phase, "RequeueAfter", next)
+		return requeueForFaults(experiment, result), nil
+	}
+	logger.Info("Experiment is completed or failed, not re-queueing for another run", "Experiment", experiment.Name, "Phase", experiment.Status.Phase, "RequeueAfter", result.RequeueAfter)
+	return result, nil
+}
+
+// rejectProtectedTarget fails an experiment that targets a namespace it may
+// not target and sets its TargetProtected condition with the given reason.
+func (r *ChaosExperimentReconciler) rejectProtectedTarget(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, reason, message string) (ctrl.Result, error) {
+	if experiment.Status.Phase == chaosv1alpha1.ExperimentFailed && meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionTargetProtected) {
+		return requeueForFaults(experiment, ctrl.Result{}), nil
+	}
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               chaosv1alpha1.ConditionTargetProtected,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: experiment.Generation,
+	})
+	result, err := r.failExperiment(ctx, experiment, "TargetProtected", message)
+	return requeueForFaults(experiment, result), err
+}
+
+// reconcileNamespaceOptIn keeps the Blocked condition and the Paused phase in
+// line with the OptInLabel of the target namespace and reports whether the
+// experiment is blocked. Nothing is blocked unless RequireNamespaceOptIn is set.
+func (r *ChaosExperimentReconciler) reconcileNamespaceOptIn(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, error) {
+	condition := metav1.Condition{
+		Type:               chaosv1alpha1.ConditionBlocked,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NamespaceOptedIn",
+		Message:            fmt.Sprintf("Namespace %s is opted in to chaos.", experiment.Spec.Target.Namespace),
+		ObservedGeneration: experiment.Generation,
+	}
+	if r.RequireNamespaceOptIn {
+		namespace := &corev1.Namespace{}
+		if err := r.Get(ctx, client.ObjectKey{Name: experiment.Spec.Target.Namespace}, namespace); err != nil && !errors.IsNotFound(err) {
+			return true, err
+		}
+		if namespace.Labels[OptInLabel] != "true" {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "NamespaceNotOptedIn"
+			condition.Message = fmt.Sprintf("Namespace %s is not labeled %s=true.", experiment.Spec.Target.Namespace, OptInLabel)
+		}
+	} else if meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionBlocked) == nil {
+		return false, nil
+	} else {
+		condition.Reason = "OptInNotRequired"
+		condition.Message = "The operator does not require namespaces to opt in to chaos."
+	}
+
+	blocked := condition.Status == metav1.ConditionTrue
+	changed := meta.SetStatusCondition(&experiment.Status.Conditions, condition)
+	if blocked {
+		changed = pauseExperiment(experiment, condition.Reason) || changed
+	} else {
+		changed = resumeExperiment(experiment, "NamespaceNotOptedIn") || changed
+	}
+	if changed {
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			return blocked, err
+		}
+		if blocked {
+			r.Recorder.Event(experiment, "Warning", "ExperimentBlocked", condition.Message)
+		} else {
+			r.Recorder.Event(experiment, "Normal", "ExperimentUnblocked", condition.Message)
+		}
+	}
+	return blocked, nil
+}
+
+// reconcileSuspension keeps the Paused condition and phase in line with
+// spec.suspend and reports whether the experiment is suspended.
+func (r *ChaosExperimentReconciler) reconcileSuspension(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, error) {
+	condition := metav1.Condition{
+		Type:               chaosv1alpha1.ConditionPaused,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Resumed",
+		Message:            "Experiment is not suspended.",
+		ObservedGeneration: experiment.Generation,
+	}
+	if experiment.Spec.Suspend {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Suspended"
+		condition.Message = "Attack iterations are halted by spec.suspend."
+	} else if !meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionPaused) {
+		// Only record the resumption of experiments that were paused.
+		return false, nil
+	}
+
+	changed := meta.SetStatusCondition(&experiment.Status.Conditions, condition)
+	if experiment.Spec.Suspend {
+		changed = pauseExperiment(experiment, "Suspended") || changed
+	} else {
+		changed = resumeExperiment(experiment, "Suspended") || changed
+	}
+	if changed {
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			return experiment.Spec.Suspend, err
+		}
+		if experiment.Spec.Suspend {
+			r.Recorder.Event(experiment, "Normal", "ExperimentSuspended", "ChaosExperiment is suspended.")
+		} else {
+			r.Recorder.Event(experiment, "Normal", "ExperimentResumed", "ChaosExperiment is resumed.")
+		}
+	}
+	return experiment.Spec.Suspend, nil
+}
+
+// failExperiment fails the iteration for a problem that retrying it right
+// away cannot fix, such as an invalid attack specification or a missing
+// target. What happens to the experiment is up to spec.failurePolicy; an
+// experiment that is not failed for good is retried after the backoff.
+func (r *ChaosExperimentReconciler) failExperiment(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, reason, message string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	experiment.Status.Message = message
+	applyFailurePolicy(experiment, reason)
+	r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+	var result ctrl.Result
+	if experiment.Status.Phase != chaosv1alpha1.ExperimentFailed {
+		result.RequeueAfter = countFailure(experiment)
+	}
+	r.Recorder.Event(experiment, "Warning", reason, message)
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status after a failed iteration", "Reason", reason)
+		return ctrl.Result{}, err
+	}
+	return result, nil
 }
 
 // seedRand seeds the random number generator if it hasn't been seeded yet.
@@ -244,8 +1402,13 @@ func (r *ChaosExperimentReconciler) seedRand() {
 // SetupWithManager sets up the controller with the Manager.
 func (r *ChaosExperimentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Recorder = mgr.GetEventRecorderFor("chaos-operator")
+	if err := indexExperiments(context.Background(), mgr); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&chaosv1alpha1.ChaosExperiment{}).
-		Owns(&corev1.Pod{}). // Watch for changes in Pods (e.g., deletions)
+		For(&chaosv1alpha1.ChaosExperiment{}, builder.WithPredicates(experimentChanged)).
+		Owns(&corev1.Pod{}, builder.WithPredicates(helperPodFinished)).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.experimentsForPod), builder.WithPredicates(targetPodAppeared)).
+		WithOptions(crcontroller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Complete(r)
 }
@@ -0,0 +1,124 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// coveringPolicies returns the ChaosPolicies covering the namespace.
+func (r *ChaosExperimentReconciler) coveringPolicies(ctx context.Context, namespace string) ([]chaosv1alpha1.ChaosPolicy, error) {
+	var policies chaosv1alpha1.ChaosPolicyList
+	if err := r.List(ctx, &policies); err != nil {
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+
+	namespaceLabels, err := r.namespaceLabels(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	var covering []chaosv1alpha1.ChaosPolicy
+	for _, policy := range policies.Items {
+		if policy.Covers(namespaceLabels) {
+			covering = append(covering, policy)
+		}
+	}
+	return covering, nil
+}
+
+// enforceChaosPolicies checks the experiment against the ChaosPolicies
+// covering its target namespace, which may have changed since it was
+// admitted. An iteration that violates one of them is skipped: PolicyDenied
+// turns true and the experiment is paused until an iteration runs again. An
+// iteration that comes due outside of the windows a policy allows is
+// deferred until the next one opens. It reports whether the iteration may go
+// ahead, otherwise the result to hand back to the controller.
+func (r *ChaosExperimentReconciler) enforceChaosPolicies(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	namespace := experiment.Spec.Target.Namespace
+	policies, err := r.coveringPolicies(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to look up chaos policies", "Namespace", namespace)
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	if len(policies) == 0 {
+		return true, ctrl.Result{}, nil
+	}
+
+	var violations []string
+	var deferredBy string
+	var deferredUntil time.Time
+	for _, policy := range policies {
+		for _, violation := range policy.Violations(&experiment.Spec) {
+			violations = append(violations, fmt.Sprintf("ChaosPolicy %s: %s", policy.Name, violation))
+		}
+		if len(policy.Spec.AllowedWindows) == 0 {
+			continue
+		}
+		location, err := loadLocation(policy.Spec.TimeZone)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("ChaosPolicy %s: %v", policy.Name, err))
+			continue
+		}
+		next, open := nextWindowOpen(policy.Spec.AllowedWindows, time.Now().In(location))
+		switch {
+		case open:
+		case next.IsZero():
+			violations = append(violations, fmt.Sprintf("ChaosPolicy %s: none of its allowed windows ever opens", policy.Name))
+		case next.After(deferredUntil):
+			deferredBy, deferredUntil = policy.Name, next
+		}
+	}
+
+	if len(violations) > 0 {
+		cause := strings.Join(violations, "; ")
+		logger.Info("Chaos policies forbid the attack", "Violations", cause)
+		r.Recorder.Eventf(experiment, "Warning", "ChaosPolicyViolated", "Iteration skipped because %s.", cause)
+		setCondition(experiment, chaosv1alpha1.ConditionPolicyDenied, metav1.ConditionTrue, "ChaosPolicyViolated", fmt.Sprintf("Attack skipped because %s.", cause))
+		pauseExperiment(experiment, "ChaosPolicyViolated")
+		result, err := r.skipIteration(ctx, experiment, fmt.Sprintf("Iteration skipped: %s.", cause))
+		return false, result, err
+	}
+	if !deferredUntil.IsZero() {
+		message := fmt.Sprintf("Outside of the windows ChaosPolicy %s allows, deferred until %s.", deferredBy, deferredUntil.Format(time.RFC3339))
+		if experiment.Status.Message != message {
+			experiment.Status.Message = message
+			if err := r.patchStatus(ctx, experiment); err != nil {
+				logger.Error(err, "Failed to record deferral of ChaosExperiment")
+				return false, ctrl.Result{}, err
+			}
+			r.Recorder.Event(experiment, "Normal", "IterationDeferred", message)
+		}
+		logger.Info("Experiment is outside of the windows a chaos policy allows", "Experiment", experiment.Name, "ChaosPolicy", deferredBy, "NextWindow", deferredUntil)
+		return false, requeueForFaults(experiment, ctrl.Result{RequeueAfter: time.Until(deferredUntil)}), nil
+	}
+	setCondition(experiment, chaosv1alpha1.ConditionPolicyDenied, metav1.ConditionFalse, "PolicyAllowed", fmt.Sprintf("The chaos policies covering namespace %s allow the attack.", namespace))
+	return true, ctrl.Result{}, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// policyLister serves ChaosPolicies and the labels of namespaces.
+type policyLister struct {
+	*statusRecorder
+	policies   []chaosv1alpha1.ChaosPolicy
+	namespaces map[string]map[string]string
+}
+
+func (c *policyLister) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	if policies, ok := list.(*chaosv1alpha1.ChaosPolicyList); ok {
+		policies.Items = c.policies
+	}
+	return nil
+}
+
+func (c *policyLister) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if ns, ok := obj.(*corev1.Namespace); ok {
+		ns.Labels = c.namespaces[key.Name]
+	}
+	return nil
+}
+
+var _ = Describe("Chaos policies", func() {
+	var c *policyLister
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		c = &policyLister{statusRecorder: &statusRecorder{}, namespaces: map[string]map[string]string{
+			"shop": {"team": "payments"},
+		}}
+		r = &ChaosExperimentReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "chaos"}}
+		experiment.Spec.Mode = chaosv1alpha1.RecurringMode
+		experiment.Spec.Interval = &metav1.Duration{Duration: 5 * time.Minute}
+		experiment.Spec.Target.Namespace = "shop"
+		experiment.Spec.Target.Percentage = ptr.To[int32](50)
+		experiment.Spec.Attack.Type = chaosv1alpha1.PodKillAttack
+		experiment.Spec.ResultsLimit = ptr.To[int32](0)
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+	})
+
+	It("should report the attack types and blast radius a policy does not permit", func() {
+		policy := &chaosv1alpha1.ChaosPolicy{Spec: chaosv1alpha1.ChaosPolicySpec{
+			AllowedAttacks:  []chaosv1alpha1.AttackType{chaosv1alpha1.CPUStressAttack},
+			MaxPercentage:   ptr.To[int32](25),
+			MaxPodKillCount: ptr.To[int32](1),
+		}}
+		experiment.Spec.Attack.PodKill = &chaosv1alpha1.PodKillAttackSpec{Count: ptr.To[int32](3)}
+		Expect(policy.Violations(&experiment.Spec)).To(Equal([]string{
+			"attack type pod-kill is not allowed",
+			"target.percentage 50 exceeds 25",
+			"attack.podKill.count 3 exceeds 1",
+		}))
+
+		policy.Spec.AllowedAttacks = append(policy.Spec.AllowedAttacks, chaosv1alpha1.PodKillAttack)
+		policy.Spec.MaxPercentage = ptr.To[int32](50)
+		policy.Spec.MaxPodKillCount = nil
+		Expect(policy.Violations(&experiment.Spec)).To(BeEmpty())
+	})
+
+	It("should only cover the namespaces matching the selector", func() {
+		policy := &chaosv1alpha1.ChaosPolicy{}
+		Expect(policy.Covers(nil)).To(BeTrue())
+
+		policy.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}
+		Expect(policy.Covers(map[string]string{"team": "payments"})).To(BeTrue())
+		Expect(policy.Covers(map[string]string{"team": "search"})).To(BeFalse())
+
+		// An invalid selector does not lift the restrictions.
+		policy.Spec.NamespaceSelector.MatchLabels["team"] = "not a valid value"
+		Expect(policy.Covers(nil)).To(BeTrue())
+	})
+
+	It("should skip iterations a covering policy forbids", func() {
+		c.policies = []chaosv1alpha1.ChaosPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "payments"},
+				Spec: chaosv1alpha1.ChaosPolicySpec{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+					AllowedAttacks:    []chaosv1alpha1.AttackType{chaosv1alpha1.CPUStressAttack},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "search"},
+				Spec: chaosv1alpha1.ChaosPolicySpec{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "search"}},
+					MaxPercentage:     ptr.To[int32](10),
+				},
+			},
+		}
+
+		allowed, result, err := r.enforceChaosPolicies(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 5*time.Minute, time.Second))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPaused))
+		Expect(experiment.Status.Reason).To(Equal("ChaosPolicyViolated"))
+		Expect(experiment.Status.Message).To(Equal("Iteration skipped: ChaosPolicy payments: attack type pod-kill is not allowed."))
+		condition := meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionPolicyDenied)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("ChaosPolicyViolated"))
+		Expect(c.updates).To(Equal(1))
+	})
+
+	It("should defer iterations outside of the windows a policy allows", func() {
+		open := time.Now().UTC().Add(2 * time.Hour)
+		c.policies = []chaosv1alpha1.ChaosPolicy{{
+			ObjectMeta: metav1.ObjectMeta{Name: "payments"},
+			Spec: chaosv1alpha1.ChaosPolicySpec{AllowedWindows: []chaosv1alpha1.TimeWindow{{
+				Start: open.Format("15:04"),
+				End:   open.Add(time.Hour).Format("15:04"),
+			}}},
+		}}
+
+		allowed, result, err := r.enforceChaosPolicies(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 2*time.Hour, time.Minute))
+		Expect(experiment.Status.Message).To(HavePrefix("Outside of the windows ChaosPolicy payments allows, deferred until "))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+		Expect(c.updates).To(Equal(1))
+	})
+
+	It("should let iterations go ahead that the covering policies permit", func() {
+		allowed, _, err := r.enforceChaosPolicies(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(experiment.Status.Conditions).To(BeEmpty())
+
+		c.policies = []chaosv1alpha1.ChaosPolicy{{
+			ObjectMeta: metav1.ObjectMeta{Name: "payments"},
+			Spec:       chaosv1alpha1.ChaosPolicySpec{AllowedAttacks: []chaosv1alpha1.AttackType{chaosv1alpha1.PodKillAttack}},
+		}}
+		allowed, _, err = r.enforceChaosPolicies(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(experiment.Status.Conditions, chaosv1alpha1.ConditionPolicyDenied)).To(BeTrue())
+		Expect(c.updates).To(BeZero())
+	})
+})
@@ -0,0 +1,301 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const (
+	// defaultSuccessfulRunsHistoryLimit is how many completed runs of a
+	// schedule are kept when spec.successfulRunsHistoryLimit is not set.
+	defaultSuccessfulRunsHistoryLimit = 3
+
+	// defaultFailedRunsHistoryLimit is how many failed runs of a schedule are
+	// kept when spec.failedRunsHistoryLimit is not set.
+	defaultFailedRunsHistoryLimit = 1
+)
+
+// ChaosScheduleReconciler reconciles a ChaosSchedule object
+type ChaosScheduleReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// MaxConcurrentReconciles is how many schedules are reconciled at once.
+	// Defaults to 1.
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosschedules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosschedules/finalizers,verbs=update
+
+// Reconcile starts the runs of a ChaosSchedule as they come due and prunes
+// its finished runs.
+func (r *ChaosScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	schedule := &chaosv1alpha1.ChaosSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, schedule); err != nil {
+		if errors.IsNotFound(err) {
+			// The runs are garbage collected with the schedule.
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get ChaosSchedule")
+		return ctrl.Result{}, err
+	}
+	original := schedule.DeepCopy()
+	if !schedule.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	runs := &chaosv1alpha1.ChaosExperimentList{}
+	if err := r.List(ctx, runs, client.InNamespace(schedule.Namespace), client.MatchingLabels{chaosv1alpha1.ScheduleLabel: schedule.Name}); err != nil {
+		logger.Error(err, "Failed to list runs of ChaosSchedule")
+		return ctrl.Result{}, err
+	}
+	active, successful, failed := classifyRuns(schedule, runs.Items)
+	schedule.Status.Active = nil
+	for i := range active {
+		schedule.Status.Active = append(schedule.Status.Active, runReference(&active[i]))
+	}
+	for _, run := range successful {
+		if completed := run.Status.CompletionTime; completed != nil && (schedule.Status.LastSuccessfulTime == nil || completed.After(schedule.Status.LastSuccessfulTime.Time)) {
+			schedule.Status.LastSuccessfulTime = completed.DeepCopy()
+		}
+	}
+	for _, prune := range []struct {
+		runs  []chaosv1alpha1.ChaosExperiment
+		limit int32
+	}{
+		{successful, historyLimitOf(schedule.Spec.SuccessfulRunsHistoryLimit, defaultSuccessfulRunsHistoryLimit)},
+		{failed, historyLimitOf(schedule.Spec.FailedRunsHistoryLimit, defaultFailedRunsHistoryLimit)},
+	} {
+		for _, run := range excessRuns(prune.runs, prune.limit) {
+			if err := r.Delete(ctx, &run, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+				logger.Error(err, "Failed to delete finished run of ChaosSchedule", "Run", run.Name)
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	now := time.Now()
+	since := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		since = schedule.Status.LastScheduleTime.Time
+	}
+	due, next, err := scheduledRuns(schedule, since, now)
+	if err != nil {
+		if schedule.Status.Message != err.Error() {
+			schedule.Status.Message = err.Error()
+			r.Recorder.Event(schedule, "Warning", "InvalidSchedule", err.Error())
+		}
+		schedule.Status.NextScheduleTime = nil
+		return ctrl.Result{}, r.Status().Patch(ctx, schedule, client.MergeFrom(original))
+	}
+
+	switch {
+	case due.IsZero():
+	case schedule.Spec.Suspend:
+		// Runs missed while suspended do not start on resume.
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: due}
+	case schedule.Spec.ConcurrencyPolicy == chaosv1alpha1.ForbidConcurrent && len(active) > 0:
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: due}
+		r.Recorder.Eventf(schedule, "Normal", "RunSkipped", "Skipped the run due at %s because %d run(s) are still active.", due.UTC().Format(time.RFC3339), len(active))
+	default:
+		if schedule.Spec.ConcurrencyPolicy == chaosv1alpha1.ReplaceConcurrent {
+			for i := range active {
+				if err := r.Delete(ctx, &active[i], client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+					logger.Error(err, "Failed to delete active run of ChaosSchedule", "Run", active[i].Name)
+					return ctrl.Result{}, err
+				}
+				r.Recorder.Eventf(schedule, "Normal", "RunReplaced", "Deleted active run %s to start the next one.", active[i].Name)
+			}
+			schedule.Status.Active = nil
+		}
+		run := runFor(schedule, due)
+		if err := controllerutil.SetControllerReference(schedule, run, r.Scheme); err != nil {
+			logger.Error(err, "Failed to set owner reference on run of ChaosSchedule")
+			return ctrl.Result{}, err
+		}
+		if err := r.Create(ctx, run); err != nil && !errors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create run of ChaosSchedule", "Run", run.Name)
+			r.Recorder.Eventf(schedule, "Warning", "RunFailed", "Failed to create run %s: %v", run.Name, err)
+			return ctrl.Result{}, err
+		}
+		logger.Info("Started run of ChaosSchedule", "Run", run.Name, "ScheduledTime", due)
+		r.Recorder.Eventf(schedule, "Normal", "RunStarted", "Started run %s.", run.Name)
+		schedule.Status.Active = append(schedule.Status.Active, runReference(run))
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: due}
+	}
+
+	schedule.Status.NextScheduleTime = nil
+	schedule.Status.Message = ""
+	switch {
+	case schedule.Spec.Suspend:
+		schedule.Status.Message = "Suspended."
+	case next.IsZero():
+		schedule.Status.Message = "The schedule never fires again."
+	default:
+		schedule.Status.NextScheduleTime = &metav1.Time{Time: next}
+	}
+	if err := r.Status().Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
+		logger.Error(err, "Failed to update ChaosSchedule status")
+		return ctrl.Result{}, err
+	}
+	if next.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+}
+
+// scheduledRuns returns the most recent time a run of the schedule was due
+// after since and no later than now, or the zero time if none was, and the
+// time the next run is due after now, or the zero time if there is none.
+func scheduledRuns(schedule *chaosv1alpha1.ChaosSchedule, since, now time.Time) (time.Time, time.Time, error) {
+	if interval := schedule.Spec.Interval; interval != nil {
+		if interval.Duration <= 0 {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid interval %s", interval.Duration)
+		}
+		var due time.Time
+		if elapsed := now.Sub(since); elapsed >= interval.Duration {
+			due = since.Add(elapsed / interval.Duration * interval.Duration)
+		}
+		next := since.Add((now.Sub(since)/interval.Duration + 1) * interval.Duration)
+		return due, next, nil
+	}
+
+	cron, err := parseCronSchedule(schedule.Spec.Schedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid schedule %q: %w", schedule.Spec.Schedule, err)
+	}
+	location, err := loadLocation(schedule.Spec.TimeZone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	var due time.Time
+	next := cron.next(since.In(location))
+	for !next.IsZero() && !next.After(now) {
+		due = next
+		next = cron.next(next)
+	}
+	return due, next, nil
+}
+
+// runFor returns the run of the schedule that is due at the given time. Runs
+// are named after the minute they are due, so that a run is never created
+// twice.
+func runFor(schedule *chaosv1alpha1.ChaosSchedule, due time.Time) *chaosv1alpha1.ChaosExperiment {
+	template := schedule.Spec.ExperimentTemplate
+	run := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%d", schedule.Name, due.Unix()/60),
+			Namespace:   schedule.Namespace,
+			Labels:      maps.Clone(template.Metadata.Labels),
+			Annotations: maps.Clone(template.Metadata.Annotations),
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	if run.Labels == nil {
+		run.Labels = map[string]string{}
+	}
+	run.Labels[chaosv1alpha1.ScheduleLabel] = schedule.Name
+	if run.Annotations == nil {
+		run.Annotations = map[string]string{}
+	}
+	run.Annotations[chaosv1alpha1.ScheduledTimeAnnotation] = due.UTC().Format(time.RFC3339)
+	return run
+}
+
+// classifyRuns splits the runs controlled by the schedule into the active,
+// successful and failed ones.
+func classifyRuns(schedule *chaosv1alpha1.ChaosSchedule, runs []chaosv1alpha1.ChaosExperiment) (active, successful, failed []chaosv1alpha1.ChaosExperiment) {
+	for _, run := range runs {
+		if !metav1.IsControlledBy(&run, schedule) {
+			continue
+		}
+		switch {
+		case run.Status.Phase == chaosv1alpha1.ExperimentCompleted:
+			successful = append(successful, run)
+		case run.Status.Phase == chaosv1alpha1.ExperimentAborted, run.Status.Phase == chaosv1alpha1.ExperimentFailed:
+			failed = append(failed, run)
+		default:
+			active = append(active, run)
+		}
+	}
+	return active, successful, failed
+}
+
+// excessRuns returns the oldest of the finished runs beyond the limit.
+func excessRuns(runs []chaosv1alpha1.ChaosExperiment, limit int32) []chaosv1alpha1.ChaosExperiment {
+	if len(runs) <= int(limit) {
+		return nil
+	}
+	runs = slices.Clone(runs)
+	slices.SortFunc(runs, func(a, b chaosv1alpha1.ChaosExperiment) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	})
+	return runs[:len(runs)-int(limit)]
+}
+
+// historyLimitOf returns the configured history limit, or the default.
+func historyLimitOf(limit *int32, defaultLimit int32) int32 {
+	if limit == nil {
+		return defaultLimit
+	}
+	return *limit
+}
+
+// runReference refers to a run in the status of its schedule.
+func runReference(run *chaosv1alpha1.ChaosExperiment) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: chaosv1alpha1.GroupVersion.String(),
+		Kind:       "ChaosExperiment",
+		Namespace:  run.Namespace,
+		Name:       run.Name,
+		UID:        run.UID,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ChaosScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("chaos-operator")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&chaosv1alpha1.ChaosSchedule{}).
+		Owns(&chaosv1alpha1.ChaosExperiment{}).
+		Named("chaosschedule").
+		WithOptions(crcontroller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(r)
+}
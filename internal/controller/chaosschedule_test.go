@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Chaos schedules", func() {
+	// Wednesday.
+	since := time.Date(2025, time.March, 12, 10, 30, 0, 0, time.UTC)
+
+	It("should find the most recent missed cron run and the next one", func() {
+		schedule := &chaosv1alpha1.ChaosSchedule{Spec: chaosv1alpha1.ChaosScheduleSpec{Schedule: "0 * * * *"}}
+		due, next, err := scheduledRuns(schedule, since, since.Add(3*time.Hour+10*time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(Equal(time.Date(2025, time.March, 12, 13, 0, 0, 0, time.UTC)))
+		Expect(next).To(Equal(time.Date(2025, time.March, 12, 14, 0, 0, 0, time.UTC)))
+
+		due, next, err = scheduledRuns(schedule, since, since.Add(10*time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(BeZero())
+		Expect(next).To(Equal(time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)))
+	})
+
+	It("should interpret cron schedules in their time zone", func() {
+		schedule := &chaosv1alpha1.ChaosSchedule{Spec: chaosv1alpha1.ChaosScheduleSpec{Schedule: "0 12 * * *", TimeZone: ptr.To("Europe/Berlin")}}
+		_, next, err := scheduledRuns(schedule, since, since)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next.UTC()).To(Equal(time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)))
+
+		schedule.Spec.TimeZone = ptr.To("Nowhere/Nothing")
+		_, _, err = scheduledRuns(schedule, since, since)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep interval runs on their grid", func() {
+		schedule := &chaosv1alpha1.ChaosSchedule{Spec: chaosv1alpha1.ChaosScheduleSpec{Interval: &metav1.Duration{Duration: time.Hour}}}
+		due, next, err := scheduledRuns(schedule, since, since.Add(150*time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(Equal(since.Add(2 * time.Hour)))
+		Expect(next).To(Equal(since.Add(3 * time.Hour)))
+
+		due, next, err = scheduledRuns(schedule, since, since.Add(time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(BeZero())
+		Expect(next).To(Equal(since.Add(time.Hour)))
+	})
+
+	It("should create runs from the template named after their minute", func() {
+		schedule := &chaosv1alpha1.ChaosSchedule{
+			ObjectMeta: metav1.ObjectMeta{Name: "daily-kill", Namespace: "demo"},
+			Spec: chaosv1alpha1.ChaosScheduleSpec{ExperimentTemplate: chaosv1alpha1.ExperimentTemplate{
+				Metadata: chaosv1alpha1.ExperimentTemplateMetadata{Labels: map[string]string{"team": "payments"}},
+				Spec:     chaosv1alpha1.ChaosExperimentSpec{Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack}},
+			}},
+		}
+		run := runFor(schedule, since)
+		Expect(run.Name).To(Equal("daily-kill-29029590"))
+		Expect(run.Namespace).To(Equal("demo"))
+		Expect(run.Labels).To(Equal(map[string]string{"team": "payments", chaosv1alpha1.ScheduleLabel: "daily-kill"}))
+		Expect(run.Annotations).To(HaveKeyWithValue(chaosv1alpha1.ScheduledTimeAnnotation, "2025-03-12T10:30:00Z"))
+		Expect(run.Spec.Attack.Type).To(Equal(chaosv1alpha1.PodKillAttack))
+		Expect(schedule.Spec.ExperimentTemplate.Metadata.Labels).NotTo(HaveKey(chaosv1alpha1.ScheduleLabel))
+	})
+
+	It("should classify and prune the runs it controls", func() {
+		schedule := &chaosv1alpha1.ChaosSchedule{ObjectMeta: metav1.ObjectMeta{Name: "daily-kill", UID: "schedule"}}
+		run := func(name string, created int, phase chaosv1alpha1.ExperimentPhase, mode chaosv1alpha1.ExperimentMode) chaosv1alpha1.ChaosExperiment {
+			return chaosv1alpha1.ChaosExperiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					CreationTimestamp: metav1.NewTime(since.Add(time.Duration(created) * time.Hour)),
+					OwnerReferences:   []metav1.OwnerReference{{UID: "schedule", Controller: ptr.To(true)}},
+				},
+				Spec:   chaosv1alpha1.ChaosExperimentSpec{Mode: mode},
+				Status: chaosv1alpha1.ChaosExperimentStatus{Phase: phase},
+			}
+		}
+		foreign := run("foreign", 0, chaosv1alpha1.ExperimentCompleted, chaosv1alpha1.OneShotMode)
+		foreign.OwnerReferences = nil
+		active, successful, failed := classifyRuns(schedule, []chaosv1alpha1.ChaosExperiment{
+			run("done-2", 2, chaosv1alpha1.ExperimentCompleted, chaosv1alpha1.OneShotMode),
+			run("done-1", 1, chaosv1alpha1.ExperimentCompleted, chaosv1alpha1.OneShotMode),
+			run("done-3", 3, chaosv1alpha1.ExperimentCompleted, chaosv1alpha1.OneShotMode),
+			run("failed", 4, chaosv1alpha1.ExperimentFailed, chaosv1alpha1.OneShotMode),
+			run("recurring", 5, chaosv1alpha1.ExperimentFailed, chaosv1alpha1.RecurringMode),
+			run("running", 6, chaosv1alpha1.ExperimentRunning, chaosv1alpha1.OneShotMode),
+			foreign,
+		})
+		names := func(runs []chaosv1alpha1.ChaosExperiment) []string {
+			var names []string
+			for _, run := range runs {
+				names = append(names, run.Name)
+			}
+			return names
+		}
+		Expect(names(active)).To(ConsistOf("running"))
+		Expect(names(successful)).To(ConsistOf("done-1", "done-2", "done-3"))
+		Expect(names(failed)).To(ConsistOf("failed", "recurring"))
+
+		Expect(names(excessRuns(successful, 1))).To(Equal([]string{"done-1", "done-2"}))
+		Expect(excessRuns(failed, 2)).To(BeEmpty())
+		Expect(excessRuns(failed, 0)).To(HaveLen(2))
+	})
+})
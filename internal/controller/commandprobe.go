@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// ProbeLabel is set on the pods of command probes and names the
+// ChaosExperiment whose probe they run. Probe pods do not carry
+// ExperimentLabel, so that they are not taken for helper pods.
+const ProbeLabel = "chaos.shanto.dev/probe-of"
+
+// defaultCommandProbeTimeout bounds command probes that do not set a timeout.
+const defaultCommandProbeTimeout = time.Minute
+
+// probePodName names the pod that runs a command probe in a phase of the
+// current iteration, so that a check that is repeated while the pod runs
+// picks up the same pod.
+func probePodName(experiment *chaosv1alpha1.ChaosExperiment, probe chaosv1alpha1.Probe, phase chaosv1alpha1.ProbePhase) string {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s/%s/%d", probe.Name, phase, experiment.Status.IterationsCompleted)
+	return fmt.Sprintf("%s-probe-%08x", experiment.Name, h.Sum32())
+}
+
+// commandProbeTimeout returns the timeout of a command probe.
+func commandProbeTimeout(probe *chaosv1alpha1.CommandProbe) time.Duration {
+	if probe.Timeout != nil && probe.Timeout.Duration > 0 {
+		return probe.Timeout.Duration
+	}
+	return defaultCommandProbeTimeout
+}
+
+// probePod builds the pod that runs a command probe.
+func probePod(experiment *chaosv1alpha1.ChaosExperiment, probe chaosv1alpha1.Probe, phase chaosv1alpha1.ProbePhase) *corev1.Pod {
+	timeout := commandProbeTimeout(probe.Command)
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      probePodName(experiment, probe, phase),
+			Namespace: experiment.Namespace,
+			Labels:    map[string]string{ProbeLabel: experiment.Name},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: ptr.To(int64(max(timeout/time.Second, 1))),
+			Containers: []corev1.Container{{
+				Name:    "probe",
+				Image:   probe.Command.Image,
+				Command: probe.Command.Command,
+			}},
+		},
+	}
+}
+
+// runCommandProbe runs a command probe in a pod. The first call creates the
+// pod and returns errProbePending; later calls return the outcome once the
+// pod has finished.
+func (r *ChaosExperimentReconciler) runCommandProbe(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, probe chaosv1alpha1.Probe, phase chaosv1alpha1.ProbePhase) error {
+	desired := probePod(experiment, probe, phase)
+	// Probe pods have to be cached like the targets to be found again.
+	maps.Copy(desired.Labels, r.PodCacheLabels)
+	pod := &corev1.Pod{}
+	err := r.Get(ctx, client.ObjectKeyFromObject(desired), pod)
+	if errors.IsNotFound(err) {
+		if err := controllerutil.SetControllerReference(experiment, desired, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, desired); err != nil && !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating probe pod: %w", err)
+		}
+		return errProbePending
+	}
+	if err != nil {
+		return err
+	}
+	if pod.DeletionTimestamp != nil {
+		return errProbePending
+	}
+	return commandProbeOutcome(pod, commandProbeTimeout(probe.Command), time.Now())
+}
+
+// commandProbeOutcome turns the state of a probe pod into the outcome of its
+// probe. Pods that never start, e.g. because the image cannot be pulled, fail
+// the probe once they are pending for twice the timeout.
+func commandProbeOutcome(pod *corev1.Pod, timeout time.Duration, now time.Time) error {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return nil
+	case corev1.PodFailed:
+		if pod.Status.Reason == "DeadlineExceeded" {
+			return fmt.Errorf("command did not finish within %s", timeout)
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+				return fmt.Errorf("command exited with status %d", terminated.ExitCode)
+			}
+		}
+		return fmt.Errorf("probe pod %s failed: %s", pod.Name, pod.Status.Message)
+	}
+	if now.Sub(pod.CreationTimestamp.Time) > 2*timeout {
+		return fmt.Errorf("probe pod %s did not finish within %s", pod.Name, 2*timeout)
+	}
+	return errProbePending
+}
+
+// deleteProbePods deletes the pods of command probes of the experiment.
+func (r *ChaosExperimentReconciler) deleteProbePods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) error {
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(experiment.Namespace), client.MatchingLabels{ProbeLabel: experiment.Name}); err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
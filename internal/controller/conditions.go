@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// setCondition sets a condition of the experiment for its current
+// generation. The change is persisted by the next status update.
+func setCondition(experiment *chaosv1alpha1.ChaosExperiment, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: experiment.Generation,
+	})
+}
+
+// setIterationConditions updates the conditions that describe the outcome of
+// an iteration. Skipped iterations set SafeguardsSatisfied where they are
+// skipped, as only they know the reason.
+func setIterationConditions(experiment *chaosv1alpha1.ChaosExperiment, result chaosv1alpha1.IterationOutcome, message string) {
+	switch result {
+	case chaosv1alpha1.IterationSucceeded:
+		reason := "AttackExecuted"
+		if experiment.Spec.DryRun {
+			reason = "DryRun"
+		}
+		setCondition(experiment, chaosv1alpha1.ConditionAttackSucceeded, metav1.ConditionTrue, reason, message)
+		setCondition(experiment, chaosv1alpha1.ConditionSafeguardsSatisfied, metav1.ConditionTrue, "WithinLimits", "The last iteration stayed within the safeguards and budgets of the experiment.")
+		setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionFalse, "InProgress", "The experiment has not completed yet.")
+	case chaosv1alpha1.IterationFailed:
+		setCondition(experiment, chaosv1alpha1.ConditionAttackSucceeded, metav1.ConditionFalse, "AttackFailed", message)
+		setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionFalse, "Failed", message)
+	case chaosv1alpha1.IterationAborted:
+		setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionFalse, "Aborted", message)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Conditions", func() {
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+	})
+
+	It("should record the generation they were observed at", func() {
+		setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionTrue, "TargetsFound", "2 target pod(s) found.")
+		condition := meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionTargetsFound)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.ObservedGeneration).To(Equal(int64(3)))
+	})
+
+	It("should report a successful iteration", func() {
+		setIterationConditions(experiment, chaosv1alpha1.IterationSucceeded, "Pod-kill attack executed.")
+		Expect(meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionAttackSucceeded)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionSafeguardsSatisfied)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(experiment.Status.Conditions, chaosv1alpha1.ConditionCompleted)).To(BeTrue())
+	})
+
+	It("should report a failed iteration with its message", func() {
+		setIterationConditions(experiment, chaosv1alpha1.IterationSucceeded, "Pod-kill attack executed.")
+		setIterationConditions(experiment, chaosv1alpha1.IterationFailed, "Failed to delete target pod.")
+		condition := meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionAttackSucceeded)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("AttackFailed"))
+		Expect(condition.Message).To(Equal("Failed to delete target pod."))
+	})
+
+	It("should leave the attack condition alone for skipped iterations", func() {
+		setIterationConditions(experiment, chaosv1alpha1.IterationSkipped, "Iteration skipped.")
+		Expect(meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionAttackSucceeded)).To(BeNil())
+	})
+})
@@ -0,0 +1,195 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// renamedConfigSuffix is appended to the name of objects moved aside by the
+// rename action of the config-chaos attack.
+const renamedConfigSuffix = "-chaos-renamed"
+
+// reconcileConfigChaosAttack removes a ConfigMap or Secret the target pods
+// depend on. A snapshot of the object is stored in the active fault and the
+// object is recreated from it when the window ends. Note that this puts the
+// contents of a targeted Secret into the experiment status, so read access to
+// experiments should be restricted accordingly.
+func (r *ChaosExperimentReconciler) reconcileConfigChaosAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "ConfigChaos")
+
+	spec := experiment.Spec.Attack.ConfigChaos
+	if spec == nil || spec.Name == "" {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "config-chaos requires attack.configChaos.kind and name.")
+	}
+	object, err := newConfigObject(spec.Kind)
+	if err != nil {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", err.Error())
+	}
+	action := spec.Action
+	if action == "" {
+		action = chaosv1alpha1.ConfigDelete
+	}
+
+	namespace := experiment.Spec.Target.Namespace
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, []chaosv1alpha1.AffectedTarget{objectTarget(spec.Kind, namespace, spec.Name)}); !allowed {
+		return result, err
+	}
+	now := metav1.Now()
+	revertAt := metav1.NewTime(now.Add(attackDuration(experiment, spec.Duration)))
+
+	// The object is still missing from an earlier iteration, just keep it so.
+	if findFault(experiment, chaosv1alpha1.ConfigChaosAttack, spec.Kind, namespace, spec.Name) != nil {
+		if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+			Attack:    chaosv1alpha1.ConfigChaosAttack,
+			Kind:      spec.Kind,
+			Namespace: namespace,
+			Name:      spec.Name,
+			RevertAt:  revertAt,
+		}); err != nil {
+			logger.Error(err, "Failed to extend config removal in ChaosExperiment status", "Kind", spec.Kind, "Name", spec.Name)
+			return ctrl.Result{}, err
+		}
+		return r.completeAttackIteration(ctx, experiment, "Config-chaos attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget(spec.Kind, namespace, spec.Name)})
+	}
+
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: spec.Name}, object); err != nil {
+		if errors.IsNotFound(err) {
+			return r.failExperiment(ctx, experiment, "ConfigNotFound", fmt.Sprintf("%s %s/%s not found.", spec.Kind, namespace, spec.Name))
+		}
+		logger.Error(err, "Failed to get config object", "Kind", spec.Kind, "Namespace", namespace, "Name", spec.Name)
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	snapshot, err := configSnapshot(object)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+		Attack:     chaosv1alpha1.ConfigChaosAttack,
+		Kind:       spec.Kind,
+		Namespace:  namespace,
+		Name:       spec.Name,
+		Original:   snapshot,
+		InjectedAt: now,
+		RevertAt:   revertAt,
+	}); err != nil {
+		logger.Error(err, "Failed to record config snapshot in ChaosExperiment status", "Kind", spec.Kind, "Name", spec.Name)
+		return ctrl.Result{}, err
+	}
+
+	if action == chaosv1alpha1.ConfigRename {
+		renamed, _ := newConfigObject(spec.Kind)
+		if err := json.Unmarshal([]byte(snapshot), renamed); err != nil {
+			return ctrl.Result{}, err
+		}
+		renamed.SetName(spec.Name + renamedConfigSuffix)
+		if err := r.targets(ctx).Create(ctx, renamed); err != nil && !errors.IsAlreadyExists(err) {
+			return r.configChaosFailed(ctx, experiment, err, "Failed to rename", spec.Kind, namespace, spec.Name)
+		}
+	}
+	if err := r.targets(ctx).Delete(ctx, object, client.Preconditions{UID: ptr.To(object.GetUID())}); err != nil && !errors.IsNotFound(err) {
+		return r.configChaosFailed(ctx, experiment, err, "Failed to delete", spec.Kind, namespace, spec.Name)
+	}
+
+	logger.Info("Removed config object", "Kind", spec.Kind, "Namespace", namespace, "Name", spec.Name, "Action", action, "RevertAt", revertAt)
+	r.Recorder.Eventf(experiment, "Normal", "ConfigRemoved", "%s %s/%s was removed (%s) until %s.", spec.Kind, namespace, spec.Name, action, revertAt.Format(time.RFC3339))
+
+	return r.completeAttackIteration(ctx, experiment, "Config-chaos attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget(spec.Kind, namespace, spec.Name)})
+}
+
+// configChaosFailed moves the experiment to the Failed phase after the object
+// could not be removed. The fault stays recorded, so whatever was changed
+// before the failure is still reverted.
+func (r *ChaosExperimentReconciler) configChaosFailed(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, err error, action, kind, namespace, name string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "ConfigChaos")
+
+	logger.Error(err, action+" config object", "Kind", kind, "Namespace", namespace, "Name", name)
+	failOnError(experiment, "ConfigChaosFailed", err)
+	experiment.Status.Message = fmt.Sprintf("%s %s %s/%s.", action, kind, namespace, name)
+	r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+	r.Recorder.Eventf(experiment, "Warning", "ConfigChaosFailed", "%s %s %s/%s: %v", action, kind, namespace, name, err)
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status to Failed after config-chaos error")
+	}
+	return ctrl.Result{RequeueAfter: time.Second * 30}, err
+}
+
+// revertConfigChaos recreates the object from the snapshot recorded in the
+// fault, unless it has been recreated in the meantime, and removes the
+// renamed copy if there is one.
+func (r *ChaosExperimentReconciler) revertConfigChaos(ctx context.Context, fault chaosv1alpha1.InjectedFault) error {
+	object, err := newConfigObject(fault.Kind)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(fault.Original), object); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, object); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	renamed, _ := newConfigObject(fault.Kind)
+	renamed.SetNamespace(fault.Namespace)
+	renamed.SetName(fault.Name + renamedConfigSuffix)
+	if err := r.Delete(ctx, renamed); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// newConfigObject returns an empty object of the given config kind.
+func newConfigObject(kind string) (client.Object, error) {
+	switch kind {
+	case "ConfigMap":
+		return &corev1.ConfigMap{}, nil
+	case "Secret":
+		return &corev1.Secret{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported config kind %q", kind)
+	}
+}
+
+// configSnapshot encodes the object without the server-populated metadata, so
+// that it can be created again as is.
+func configSnapshot(object client.Object) (string, error) {
+	object = object.DeepCopyObject().(client.Object)
+	object.SetUID("")
+	object.SetResourceVersion("")
+	object.SetCreationTimestamp(metav1.Time{})
+	object.SetManagedFields(nil)
+	object.SetGeneration(0)
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileContainerKillAttack SIGKILLs every process of a single container in
+// a randomly picked target pod. The kubelet then restarts the container
+// according to the pod's restart policy, while the pod itself, its IP and its
+// volumes are left untouched.
+func (r *ChaosExperimentReconciler) reconcileContainerKillAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	containerName := ""
+	if experiment.Spec.Attack.ContainerKill != nil {
+		containerName = experiment.Spec.Attack.ContainerKill.ContainerName
+	}
+	return r.reconcileContainerAttack(ctx, experiment, containerName, func(containerID string) string {
+		return containerPIDsScript(containerID) + "kill -KILL $pids\n"
+	}, "ContainerKilled", "was killed")
+}
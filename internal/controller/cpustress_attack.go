@@ -0,0 +1,50 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileCPUStressAttack starts stress-ng inside the cgroup of a target
+// container, so the generated load counts against the container's CPU limit
+// and shows up in its metrics exactly like load produced by the workload.
+func (r *ChaosExperimentReconciler) reconcileCPUStressAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	spec := experiment.Spec.Attack.CPUStress
+	if spec == nil {
+		spec = &chaosv1alpha1.CPUStressAttackSpec{}
+	}
+	workers := spec.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	load := spec.Load
+	if load < 1 || load > 100 {
+		load = 100
+	}
+	timeout := attackDuration(experiment, spec.Duration)
+
+	return r.reconcileContainerAttack(ctx, experiment, spec.ContainerName, func(containerID string) string {
+		return containerPIDsScript(containerID) + joinContainerCgroupScript() +
+			fmt.Sprintf("exec stress-ng --cpu %d --cpu-load %d --timeout %ds\n", workers, load, attackSeconds(timeout))
+	}, "CPUStressStarted", fmt.Sprintf("is under %d%% CPU load from %d worker(s) for %s", load, workers, timeout))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// experimentDeadline returns when spec.activeDeadlineSeconds runs out, and
+// false if it is not set or the experiment has not run an iteration yet.
+func experimentDeadline(experiment *chaosv1alpha1.ChaosExperiment) (time.Time, bool) {
+	if experiment.Spec.ActiveDeadlineSeconds == nil || experiment.Status.StartTime == nil {
+		return time.Time{}, false
+	}
+	return experiment.Status.StartTime.Add(time.Duration(*experiment.Spec.ActiveDeadlineSeconds) * time.Second), true
+}
+
+// reconcileDeadline enforces spec.activeDeadlineSeconds. Once the deadline has
+// passed, helper pods that are still running are stopped and every active
+// fault is reverted; an experiment that has not finished yet is completed. It
+// reports whether the experiment was completed, in which case the reconcile
+// stops here.
+func (r *ChaosExperimentReconciler) reconcileDeadline(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	deadline, ok := experimentDeadline(experiment)
+	if !ok || time.Now().Before(deadline) {
+		return false, ctrl.Result{}, nil
+	}
+	active, err := r.activeHelperPods(ctx, experiment)
+	if err != nil {
+		logger.Error(err, "Failed to list helper pods of ChaosExperiment")
+		return true, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	for i := range active {
+		// Helper pods undo their changes when they are terminated.
+		if err := r.Delete(ctx, &active[i]); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to stop helper pod after the active deadline", "HelperPod", active[i].Name)
+			return true, ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+	}
+	if len(experiment.Status.ActiveFaults) > 0 {
+		// Faults that fail to revert stay active and are retried when due.
+		if err := r.revertFaults(ctx, experiment, true); err != nil {
+			logger.Error(err, "Failed to revert injected faults after the active deadline")
+		}
+	}
+	if experimentFinished(experiment) {
+		return false, ctrl.Result{}, nil
+	}
+
+	logger.Info("Completing experiment after its active deadline", "Experiment", experiment.Name, "Deadline", deadline)
+	experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+	experiment.Status.Reason = "DeadlineExceeded"
+	experiment.Status.Message = fmt.Sprintf("Experiment completed: its active deadline of %ds was reached.", *experiment.Spec.ActiveDeadlineSeconds)
+	setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionTrue, "DeadlineExceeded", experiment.Status.Message)
+	experiment.Status.NextScheduledTime = nil
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status to Completed")
+		return true, ctrl.Result{}, err
+	}
+	r.Recorder.Event(experiment, "Normal", "DeadlineExceeded", "ChaosExperiment was completed because its active deadline was reached.")
+	r.notify(ctx, experiment, chaosv1alpha1.NotifyCompleted, experiment.Status.Message)
+	return true, requeueForFaults(experiment, ctrl.Result{}), nil
+}
+
+// requeueForDeadline makes sure an experiment with an active deadline is
+// reconciled again in time to enforce it.
+func requeueForDeadline(experiment *chaosv1alpha1.ChaosExperiment, result ctrl.Result) ctrl.Result {
+	deadline, ok := experimentDeadline(experiment)
+	if !ok || !time.Now().Before(deadline) {
+		return result
+	}
+	next := max(time.Until(deadline), time.Second)
+	if result.RequeueAfter == 0 || next < result.RequeueAfter {
+		result.RequeueAfter = next
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Active deadline", func() {
+	var c *helperPodLister
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		c = &helperPodLister{statusRecorder: &statusRecorder{}}
+		r = &ChaosExperimentReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+		startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+		nextTime := metav1.NewTime(time.Now().Add(time.Minute))
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "stress-web", Namespace: "chaos"}}
+		experiment.Spec.Mode = chaosv1alpha1.RecurringMode
+		experiment.Spec.Interval = &metav1.Duration{Duration: 5 * time.Minute}
+		experiment.Spec.ActiveDeadlineSeconds = ptr.To[int64](1800)
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		experiment.Status.StartTime = &startTime
+		experiment.Status.NextScheduledTime = &nextTime
+	})
+
+	It("should complete a recurring experiment once its deadline has passed", func() {
+		completed, _, err := r.reconcileDeadline(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(BeTrue())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentCompleted))
+		Expect(experiment.Status.Reason).To(Equal("DeadlineExceeded"))
+		Expect(experiment.Status.NextScheduledTime).To(BeNil())
+		Expect(meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionCompleted)).To(BeTrue())
+		Expect(c.updates).To(Equal(1))
+	})
+
+	It("should complete a paused experiment once its deadline has passed", func() {
+		Expect(pauseExperiment(experiment, "Suspended")).To(BeTrue())
+
+		completed, _, err := r.reconcileDeadline(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(BeTrue())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentCompleted))
+	})
+
+	It("should leave finished experiments in their phase", func() {
+		experiment.Status.Phase = chaosv1alpha1.ExperimentFailed
+
+		completed, _, err := r.reconcileDeadline(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(BeFalse())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentFailed))
+		Expect(c.updates).To(BeZero())
+	})
+
+	It("should requeue an experiment in time for its deadline", func() {
+		experiment.Spec.ActiveDeadlineSeconds = ptr.To[int64](3660)
+
+		completed, _, err := r.reconcileDeadline(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(BeFalse())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+
+		result := requeueForDeadline(experiment, ctrl.Result{RequeueAfter: 5 * time.Minute})
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Minute, time.Second))
+		result = requeueForDeadline(experiment, ctrl.Result{RequeueAfter: 10 * time.Second})
+		Expect(result.RequeueAfter).To(Equal(10 * time.Second))
+	})
+
+	It("should not count down before the first iteration", func() {
+		experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+		experiment.Status.StartTime = nil
+
+		completed, _, err := r.reconcileDeadline(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(BeFalse())
+		Expect(requeueForDeadline(experiment, ctrl.Result{})).To(Equal(ctrl.Result{}))
+	})
+})
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// disruptionBudgets lists the PodDisruptionBudgets of a namespace.
+func (r *ChaosExperimentReconciler) disruptionBudgets(ctx context.Context, namespace string) ([]policyv1.PodDisruptionBudget, error) {
+	var budgets policyv1.PodDisruptionBudgetList
+	if err := r.List(ctx, &budgets, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	return budgets.Items, nil
+}
+
+// allowDisruption reports whether every PodDisruptionBudget covering the pod
+// still allows a disruption and, if so, charges the disruption to them, so
+// that killing several pods in one iteration cannot exhaust a budget twice.
+// Budgets with a nil selector cover no pods, like in the Eviction API.
+func allowDisruption(budgets []policyv1.PodDisruptionBudget, pod *corev1.Pod) bool {
+	var covering []*policyv1.PodDisruptionBudget
+	for i := range budgets {
+		budget := &budgets[i]
+		if budget.Spec.Selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		if budget.Status.DisruptionsAllowed <= 0 {
+			return false
+		}
+		covering = append(covering, budget)
+	}
+	for _, budget := range covering {
+		budget.Status.DisruptionsAllowed--
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("PodDisruptionBudget awareness", func() {
+	budget := func(app string, allowed int32) policyv1.PodDisruptionBudget {
+		return policyv1.PodDisruptionBudget{
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed},
+		}
+	}
+	pod := func(name, app string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"app": app}}}
+	}
+
+	It("should charge disruptions to the covering budgets", func() {
+		budgets := []policyv1.PodDisruptionBudget{budget("nginx", 1), budget("redis", 0)}
+		Expect(allowDisruption(budgets, pod("nginx-0", "nginx"))).To(BeTrue())
+		Expect(allowDisruption(budgets, pod("nginx-1", "nginx"))).To(BeFalse())
+		Expect(allowDisruption(budgets, pod("redis-0", "redis"))).To(BeFalse())
+	})
+
+	It("should allow pods no budget covers", func() {
+		budgets := []policyv1.PodDisruptionBudget{budget("redis", 0), {}}
+		Expect(allowDisruption(budgets, pod("nginx-0", "nginx"))).To(BeTrue())
+		Expect(allowDisruption(nil, pod("nginx-0", "nginx"))).To(BeTrue())
+	})
+})
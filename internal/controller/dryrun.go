@@ -0,0 +1,114 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileDryRun selects the targets of an iteration like the attack would,
+// and records them in the status and an event instead of attacking them.
+func (r *ChaosExperimentReconciler) reconcileDryRun(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", experiment.Spec.Attack.Type)
+
+	targets, result, err := r.dryRunTargets(ctx, experiment)
+	if targets == nil {
+		return result, err
+	}
+
+	experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{
+		Time:    metav1.Now(),
+		Targets: targets,
+		DryRun:  true,
+	}
+	logger.Info("Dry run, not attacking", "Targets", targets)
+	r.Recorder.Eventf(experiment, "Normal", "DryRun", "Dry run: %s would have attacked %s.", experiment.Spec.Attack.Type, strings.Join(targets, ", "))
+
+	return r.completeAttackIteration(ctx, experiment, "Dry run executed, nothing was attacked.", nil)
+}
+
+// dryRunTargets returns what an iteration of the attack would affect: the
+// pods it would pick, or the objects named in the attack specification for
+// attacks that do not act on the target pods. If nothing can be selected, the
+// experiment status is updated accordingly and nil is returned together with
+// the result the caller should hand back to the controller.
+func (r *ChaosExperimentReconciler) dryRunTargets(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) ([]string, ctrl.Result, error) {
+	namespace := experiment.Spec.Target.Namespace
+	attack := experiment.Spec.Attack
+	object := func(kind, namespace, name string) ([]string, ctrl.Result, error) {
+		return []string{fmt.Sprintf("%s %s/%s", kind, namespace, name)}, ctrl.Result{}, nil
+	}
+
+	var pods []corev1.Pod
+	var result ctrl.Result
+	var err error
+	switch {
+	case attack.Type == chaosv1alpha1.PodKillAttack:
+		var count *int32
+		if attack.PodKill != nil {
+			count = attack.PodKill.Count
+		}
+		pods, result, err = r.pickTargetPods(ctx, experiment, count)
+	case attack.Type == chaosv1alpha1.NetworkPartitionAttack, attack.Type == chaosv1alpha1.NetworkChaosAttack:
+		pods, result, err = r.listTargetPods(ctx, experiment)
+	case attack.Type == chaosv1alpha1.ScaleChaosAttack, attack.Type == chaosv1alpha1.ImagePullFailureAttack:
+		kind, name := "", ""
+		if attack.ScaleChaos != nil {
+			kind, name = attack.ScaleChaos.Kind, attack.ScaleChaos.Name
+		} else if attack.ImagePullFailure != nil {
+			kind, name = attack.ImagePullFailure.Kind, attack.ImagePullFailure.Name
+		}
+		workload, kind, result, err := r.targetWorkload(ctx, experiment, kind, name)
+		if workload == nil {
+			return nil, result, err
+		}
+		return object(kind, workload.GetNamespace(), workload.GetName())
+	case attack.Type == chaosv1alpha1.GRPCFaultAttack && attack.GRPCFault != nil:
+		return []string{"calls to " + attack.GRPCFault.Host}, ctrl.Result{}, nil
+	case attack.Type == chaosv1alpha1.ConfigChaosAttack && attack.ConfigChaos != nil:
+		return object(attack.ConfigChaos.Kind, namespace, attack.ConfigChaos.Name)
+	case attack.Type == chaosv1alpha1.ServiceBlackholeAttack && attack.ServiceBlackhole != nil:
+		return object("Service", namespace, attack.ServiceBlackhole.ServiceName)
+	case attack.Type == chaosv1alpha1.CertExpiryAttack && attack.CertExpiry != nil:
+		return object("Secret", namespace, attack.CertExpiry.SecretName)
+	case attack.Type == chaosv1alpha1.GRPCFaultAttack, attack.Type == chaosv1alpha1.ConfigChaosAttack,
+		attack.Type == chaosv1alpha1.ServiceBlackholeAttack, attack.Type == chaosv1alpha1.CertExpiryAttack:
+		result, err := r.failExperiment(ctx, experiment, "InvalidAttackSpec", fmt.Sprintf("%s is missing its attack configuration.", attack.Type))
+		return nil, result, err
+	default:
+		pods, result, err = r.pickTargetPods(ctx, experiment, ptr.To[int32](1))
+	}
+	if len(pods) == 0 {
+		return nil, result, err
+	}
+
+	targets := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		targets = append(targets, pod.Namespace+"/"+pod.Name)
+	}
+	return targets, ctrl.Result{}, nil
+}
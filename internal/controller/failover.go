@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// resumeIteration completes an iteration whose helper pods were started but
+// that was never recorded, e.g. because the previous leader crashed or lost
+// its lease in between. The helper pods carry out the attack on their own, so
+// the iteration is recorded with their targets, under the run ID they carry,
+// instead of attacking again. Iterations of container attacks, whose outcome
+// is that of their helper pods, are completed once those finished. Pod
+// kills, which start no helper pods, are finished on the pods written by
+// startRun. It reports whether such an iteration was found.
+func (r *ChaosExperimentReconciler) resumeIteration(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	iteration := strconv.Itoa(int(experiment.Status.IterationsCompleted) + 1)
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(experiment.Namespace), client.MatchingLabels{ExperimentLabel: experiment.Name, IterationLabel: iteration}); err != nil {
+		return true, ctrl.Result{}, err
+	}
+	var targets []chaosv1alpha1.AffectedTarget
+	var awaited []corev1.Pod
+	for _, helper := range podList.Items {
+		if helper.DeletionTimestamp != nil || helper.Labels[TargetPodLabel] == "" {
+			continue
+		}
+		if id := helper.Labels[RunIDLabel]; id != "" {
+			experiment.Status.RunID = id
+		}
+		targets = append(targets, chaosv1alpha1.AffectedTarget{
+			Kind:      "Pod",
+			Name:      helper.Labels[TargetPodLabel],
+			Namespace: experiment.Spec.Target.Namespace,
+			NodeName:  helper.Spec.NodeName,
+		})
+		if helper.Annotations[OutcomeReasonAnnotation] != "" {
+			awaited = append(awaited, helper)
+		}
+	}
+	if len(targets) == 0 {
+		// Pod kills start no helper pods, but write the pods they are about
+		// to kill to the status first.
+		if run := startedRun(experiment); run != nil && experiment.Spec.Attack.Type == chaosv1alpha1.PodKillAttack {
+			result, err := r.resumePodKills(ctx, experiment, run)
+			return true, result, err
+		}
+		return false, ctrl.Result{}, nil
+	}
+	// Container attacks end with their helper pods, not when they start.
+	if len(awaited) > 0 {
+		result, err := r.awaitHelperPods(ctx, experiment, awaited, targets)
+		return true, result, err
+	}
+
+	logger.Info("Resuming iteration started before", "Experiment", experiment.Name, "Iteration", iteration, "HelperPods", len(targets))
+	r.Recorder.Eventf(experiment, "Normal", "IterationResumed", "Iteration %s had already started %d helper pod(s) and is resumed instead of attacking again.", iteration, len(targets))
+	result, err := r.completeAttackIteration(ctx, experiment, "Attack iteration resumed after it was interrupted.", targets)
+	return true, result, err
+}
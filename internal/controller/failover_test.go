@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// helperPodLister lists the helper pods matching the label selector and
+// records status writes and deletions.
+type helperPodLister struct {
+	*statusRecorder
+	helpers []corev1.Pod
+	deleted []string
+}
+
+func (c *helperPodLister) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	c.deleted = append(c.deleted, obj.GetName())
+	return nil
+}
+
+func (c *helperPodLister) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	options := (&client.ListOptions{}).ApplyOptions(opts)
+	for _, helper := range c.helpers {
+		if options.LabelSelector.Matches(labels.Set(helper.Labels)) {
+			list.(*corev1.PodList).Items = append(list.(*corev1.PodList).Items, helper)
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Leader failover", func() {
+	var c *helperPodLister
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		c = &helperPodLister{statusRecorder: &statusRecorder{}}
+		scheme := runtime.NewScheme()
+		Expect(chaosv1alpha1.AddToScheme(scheme)).To(Succeed())
+		r = &ChaosExperimentReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "pause-web", Namespace: "chaos"}}
+		experiment.Spec.Attack.Type = chaosv1alpha1.PodPauseAttack
+		experiment.Spec.Target.Namespace = "default"
+		experiment.Spec.ResultsLimit = ptr.To(int32(0))
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		experiment.Status.IterationsCompleted = 2
+	})
+
+	helperFor := func(target string, iteration string) corev1.Pod {
+		pod, err := r.helperPodFor(experiment, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: target}, Spec: corev1.PodSpec{NodeName: "node-1"}}, "true")
+		Expect(err).NotTo(HaveOccurred())
+		pod.Labels[IterationLabel] = iteration
+		return *pod
+	}
+
+	It("should record an interrupted iteration instead of attacking again", func() {
+		c.helpers = []corev1.Pod{helperFor("web-1", "3"), helperFor("web-0", "2")}
+
+		resumed, _, err := r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeTrue())
+		Expect(experiment.Status.IterationsCompleted).To(BeEquivalentTo(3))
+		Expect(experiment.Status.LastIteration.Targets).To(Equal([]string{"default/web-1"}))
+		Expect(c.updates).NotTo(BeZero())
+	})
+
+	It("should complete a container attack once its helper pod succeeded", func() {
+		experiment.Spec.Attack.Type = chaosv1alpha1.ContainerKillAttack
+		helper := helperFor("web-1", "3")
+		helper.Name = "kill-web-1"
+		helper.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+		helper.Annotations = map[string]string{OutcomeReasonAnnotation: "ContainerKilled", OutcomeMessageAnnotation: "Container web of pod default/web-1 was killed."}
+		c.helpers = []corev1.Pod{helper}
+
+		resumed, _, err := r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeTrue())
+		Expect(experiment.Status.IterationsCompleted).To(BeEquivalentTo(2))
+		Expect(c.updates).To(BeZero())
+
+		c.helpers[0].Status.Phase = corev1.PodSucceeded
+		resumed, _, err = r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeTrue())
+		Expect(experiment.Status.IterationsCompleted).To(BeEquivalentTo(3))
+		Expect(experiment.Status.LastRunTime.Time).To(BeTemporally("~", helper.CreationTimestamp.Time, time.Second))
+		Expect(experiment.Status.History).To(HaveLen(1))
+		Expect(experiment.Status.History[0].Result).To(Equal(chaosv1alpha1.IterationSucceeded))
+		Expect(r.Recorder.(*record.FakeRecorder).Events).To(Receive(Equal("Normal ContainerKilled Container web of pod default/web-1 was killed.")))
+	})
+
+	It("should record a failed iteration when the helper pod of a container attack failed", func() {
+		experiment.Spec.Attack.Type = chaosv1alpha1.ContainerKillAttack
+		helper := helperFor("web-1", "3")
+		helper.Name = "kill-web-1"
+		helper.Annotations = map[string]string{OutcomeReasonAnnotation: "ContainerKilled", OutcomeMessageAnnotation: "Container web of pod default/web-1 was killed."}
+		helper.Status.Phase = corev1.PodFailed
+		helper.Status.ContainerStatuses = []corev1.ContainerStatus{{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			ExitCode: 1,
+			Message:  "no processes found for container abc\n",
+		}}}}
+		c.helpers = []corev1.Pod{helper}
+
+		resumed, result, err := r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeTrue())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(experiment.Status.IterationsCompleted).To(BeEquivalentTo(2))
+		Expect(experiment.Status.ConsecutiveFailures).To(BeEquivalentTo(1))
+		Expect(experiment.Status.Message).To(Equal("Helper pod kill-web-1 failed: exit code 1: no processes found for container abc."))
+		Expect(experiment.Status.History).To(HaveLen(1))
+		Expect(experiment.Status.History[0].Result).To(Equal(chaosv1alpha1.IterationFailed))
+		Expect(c.deleted).To(Equal([]string{"kill-web-1"}))
+	})
+
+	It("should keep the helper pod of a failed iteration until the failure is recorded", func() {
+		experiment.Spec.Attack.Type = chaosv1alpha1.ContainerKillAttack
+		helper := helperFor("web-1", "3")
+		helper.Name = "kill-web-1"
+		helper.Annotations = map[string]string{OutcomeReasonAnnotation: "ContainerKilled", OutcomeMessageAnnotation: "Container web of pod default/web-1 was killed."}
+		helper.Status.Phase = corev1.PodFailed
+		c.helpers = []corev1.Pod{helper}
+		c.err = errors.NewConflict(chaosv1alpha1.GroupVersion.WithResource("chaosexperiments").GroupResource(), experiment.Name, nil)
+
+		resumed, _, err := r.resumeIteration(context.Background(), experiment)
+		Expect(errors.IsConflict(err)).To(BeTrue())
+		Expect(resumed).To(BeTrue())
+		Expect(c.deleted).To(BeEmpty())
+	})
+
+	It("should attack when no helper pod of the iteration exists", func() {
+		stopping := helperFor("web-1", "3")
+		stopping.DeletionTimestamp = &metav1.Time{}
+		c.helpers = []corev1.Pod{stopping, helperFor("web-0", "2")}
+
+		resumed, _, err := r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeFalse())
+		Expect(experiment.Status.IterationsCompleted).To(BeEquivalentTo(2))
+	})
+})
@@ -0,0 +1,209 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// FaultRevertFinalizer is added to experiments before they inject a fault or
+// start a helper pod, so that deleting an experiment mid-run reverts what it
+// injected and stops its helper pods before the experiment goes away.
+const FaultRevertFinalizer = "chaos.shanto.dev/revert-faults"
+
+// findFault returns the active fault the given attack injected into the named
+// object, or nil if there is none.
+func findFault(experiment *chaosv1alpha1.ChaosExperiment, attack chaosv1alpha1.AttackType, kind, namespace, name string) *chaosv1alpha1.InjectedFault {
+	for i := range experiment.Status.ActiveFaults {
+		fault := &experiment.Status.ActiveFaults[i]
+		if fault.Attack == attack && fault.Kind == kind && fault.Namespace == namespace && fault.Name == name {
+			return fault
+		}
+	}
+	return nil
+}
+
+// recordFault persists faults in the experiment status. It must be called
+// before the corresponding changes are made to the cluster, so that they can
+// be reverted even if the controller crashes right after making them.
+func (r *ChaosExperimentReconciler) recordFault(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, faults ...chaosv1alpha1.InjectedFault) error {
+	if err := r.ensureFinalizer(ctx, experiment); err != nil {
+		return err
+	}
+	for _, fault := range faults {
+		if existing := findFault(experiment, fault.Attack, fault.Kind, fault.Namespace, fault.Name); existing != nil {
+			// The object is still under attack from an earlier iteration. Keep
+			// the original state captured back then and only push the revert out.
+			existing.RevertAt = fault.RevertAt
+		} else {
+			experiment.Status.ActiveFaults = append(experiment.Status.ActiveFaults, fault)
+		}
+	}
+	return r.patchStatus(ctx, experiment)
+}
+
+// revertDueFaults reverts every active fault whose revert time has passed and
+// drops it from the experiment status. Faults that fail to revert are kept so
+// the next reconcile tries again.
+func (r *ChaosExperimentReconciler) revertDueFaults(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) error {
+	return r.revertFaults(ctx, experiment, false)
+}
+
+// ensureFinalizer adds FaultRevertFinalizer to the experiment unless it
+// already carries it. The finalizer is patched onto a copy, so that the
+// response does not overwrite status changes not written yet.
+func (r *ChaosExperimentReconciler) ensureFinalizer(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) error {
+	patched := experiment.DeepCopy()
+	if !controllerutil.AddFinalizer(patched, FaultRevertFinalizer) {
+		return nil
+	}
+	if err := r.Patch(ctx, patched, client.MergeFrom(experiment)); err != nil {
+		return err
+	}
+	experiment.Finalizers = patched.Finalizers
+	experiment.ResourceVersion = patched.ResourceVersion
+	return nil
+}
+
+// finalizeFaults reverts every active fault of an experiment that is being
+// deleted, due or not, and stops its helper pods, which undo their changes
+// when terminated. It removes FaultRevertFinalizer and reports true once all
+// faults have been reverted and all helper pods, including the ones cleaning
+// up after helpers that could not, are gone.
+func (r *ChaosExperimentReconciler) finalizeFaults(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, error) {
+	if !controllerutil.ContainsFinalizer(experiment, FaultRevertFinalizer) {
+		return true, nil
+	}
+	if err := r.revertFaults(ctx, experiment, true); err != nil {
+		return false, err
+	}
+	if len(experiment.Status.ActiveFaults) > 0 {
+		return false, nil
+	}
+	remaining, err := r.stopHelperPods(ctx, experiment)
+	if err != nil || remaining > 0 {
+		return false, err
+	}
+	controllerutil.RemoveFinalizer(experiment, FaultRevertFinalizer)
+	return true, r.Update(ctx, experiment)
+}
+
+func (r *ChaosExperimentReconciler) revertFaults(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, all bool) error {
+	logger := log.FromContext(ctx)
+
+	now := time.Now()
+	remaining := make([]chaosv1alpha1.InjectedFault, 0, len(experiment.Status.ActiveFaults))
+	var revertErr error
+	for _, fault := range experiment.Status.ActiveFaults {
+		if !all && fault.RevertAt.After(now) {
+			remaining = append(remaining, fault)
+			continue
+		}
+		err := r.revertFault(ctx, experiment, fault)
+		if err == errRevertPending {
+			// Check back shortly rather than on every reconcile.
+			fault.RevertAt = metav1.NewTime(now.Add(revertPollInterval))
+			remaining = append(remaining, fault)
+			continue
+		}
+		if err != nil {
+			logger.Error(err, "Failed to revert injected fault", "Attack", fault.Attack, "Kind", fault.Kind, "Namespace", fault.Namespace, "Name", fault.Name)
+			r.Recorder.Eventf(experiment, "Warning", "FaultRevertFailed", "Failed to revert %s on %s %s: %v", fault.Attack, fault.Kind, faultObjectName(fault), err)
+			remaining = append(remaining, fault)
+			revertErr = err
+			continue
+		}
+		logger.Info("Reverted injected fault", "Attack", fault.Attack, "Kind", fault.Kind, "Namespace", fault.Namespace, "Name", fault.Name)
+		r.Recorder.Eventf(experiment, "Normal", "FaultReverted", "Reverted %s on %s %s.", fault.Attack, fault.Kind, faultObjectName(fault))
+	}
+
+	if len(remaining) == len(experiment.Status.ActiveFaults) {
+		return revertErr
+	}
+	experiment.Status.ActiveFaults = remaining
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		return err
+	}
+	return revertErr
+}
+
+// revertFault undoes a single fault, dispatching on the attack that injected
+// it. It returns errRevertPending while the revert is still under way.
+func (r *ChaosExperimentReconciler) revertFault(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, fault chaosv1alpha1.InjectedFault) error {
+	switch fault.Attack {
+	case chaosv1alpha1.NetworkChaosAttack, chaosv1alpha1.NetworkPartitionAttack:
+		return r.revertNetworkFault(ctx, experiment, fault)
+	case chaosv1alpha1.NodeTaintAttack:
+		return r.revertNodeTaint(ctx, fault)
+	case chaosv1alpha1.GRPCFaultAttack:
+		return r.revertGRPCFault(ctx, fault)
+	case chaosv1alpha1.ScaleChaosAttack:
+		return r.revertScaleChaos(ctx, fault)
+	case chaosv1alpha1.ImagePullFailureAttack:
+		return r.revertImagePullFailure(ctx, fault)
+	case chaosv1alpha1.ConfigChaosAttack:
+		return r.revertConfigChaos(ctx, fault)
+	case chaosv1alpha1.ServiceBlackholeAttack:
+		return r.revertServiceBlackhole(ctx, fault)
+	case chaosv1alpha1.CertExpiryAttack:
+		return r.revertCertExpiry(ctx, fault)
+	default:
+		return fmt.Errorf("don't know how to revert faults injected by %q", fault.Attack)
+	}
+}
+
+// nextFaultRevert returns how long it is until the next active fault is due to
+// be reverted, and false if there are no active faults.
+func nextFaultRevert(experiment *chaosv1alpha1.ChaosExperiment) (time.Duration, bool) {
+	if len(experiment.Status.ActiveFaults) == 0 {
+		return 0, false
+	}
+	next := experiment.Status.ActiveFaults[0].RevertAt.Time
+	for _, fault := range experiment.Status.ActiveFaults[1:] {
+		if fault.RevertAt.Time.Before(next) {
+			next = fault.RevertAt.Time
+		}
+	}
+	return max(time.Until(next), time.Second), true
+}
+
+// requeueForFaults makes sure the experiment is reconciled again in time to
+// revert its active faults.
+func requeueForFaults(experiment *chaosv1alpha1.ChaosExperiment, result ctrl.Result) ctrl.Result {
+	next, ok := nextFaultRevert(experiment)
+	if ok && (result.RequeueAfter == 0 || next < result.RequeueAfter) {
+		result.RequeueAfter = next
+	}
+	return result
+}
+
+func faultObjectName(fault chaosv1alpha1.InjectedFault) string {
+	if fault.Namespace == "" {
+		return fault.Name
+	}
+	return fault.Namespace + "/" + fault.Name
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// finalizerPatcher records patches and answers them with the patched object
+// as stored, whose status lags behind the reconcile.
+type finalizerPatcher struct {
+	client.Client
+	patches []string
+}
+
+func (c *finalizerPatcher) Patch(_ context.Context, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+	data, err := patch.Data(obj)
+	c.patches = append(c.patches, string(data))
+	experiment := obj.(*chaosv1alpha1.ChaosExperiment)
+	experiment.Status = chaosv1alpha1.ChaosExperimentStatus{}
+	experiment.ResourceVersion = "2"
+	return err
+}
+
+var _ = Describe("Injected faults", func() {
+	experimentWithFaults := func(revertIn ...time.Duration) *chaosv1alpha1.ChaosExperiment {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		for i, d := range revertIn {
+			experiment.Status.ActiveFaults = append(experiment.Status.ActiveFaults, chaosv1alpha1.InjectedFault{
+				Attack:   chaosv1alpha1.NodeTaintAttack,
+				Kind:     "Node",
+				Name:     string(rune('a' + i)),
+				RevertAt: metav1.NewTime(time.Now().Add(d)),
+			})
+		}
+		return experiment
+	}
+
+	It("should find faults by attack and object", func() {
+		experiment := experimentWithFaults(time.Minute, time.Hour)
+		Expect(findFault(experiment, chaosv1alpha1.NodeTaintAttack, "Node", "", "b")).To(Equal(&experiment.Status.ActiveFaults[1]))
+		Expect(findFault(experiment, chaosv1alpha1.NodeTaintAttack, "Node", "", "c")).To(BeNil())
+	})
+
+	It("should requeue in time for the next revert", func() {
+		Expect(requeueForFaults(experimentWithFaults(), ctrl.Result{})).To(Equal(ctrl.Result{}))
+
+		experiment := experimentWithFaults(time.Hour, 10*time.Minute)
+		next, ok := nextFaultRevert(experiment)
+		Expect(ok).To(BeTrue())
+		Expect(next).To(BeNumerically("~", 10*time.Minute, time.Second))
+		Expect(requeueForFaults(experiment, ctrl.Result{RequeueAfter: time.Minute}).RequeueAfter).To(Equal(time.Minute))
+		Expect(requeueForFaults(experiment, ctrl.Result{}).RequeueAfter).To(BeNumerically("~", 10*time.Minute, time.Second))
+	})
+
+	It("should never requeue overdue faults into the past", func() {
+		next, ok := nextFaultRevert(experimentWithFaults(-time.Minute))
+		Expect(ok).To(BeTrue())
+		Expect(next).To(Equal(time.Second))
+	})
+
+	It("should add the finalizer without touching the status being reconciled", func() {
+		c := &finalizerPatcher{}
+		r := &ChaosExperimentReconciler{Client: c}
+		experiment := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "pause-web", ResourceVersion: "1"}}
+		experiment.Status.RunID = "run"
+
+		Expect(r.ensureFinalizer(context.Background(), experiment)).To(Succeed())
+		Expect(c.patches).To(Equal([]string{`{"metadata":{"finalizers":["` + FaultRevertFinalizer + `"]}}`}))
+		Expect(experiment.Finalizers).To(ConsistOf(FaultRevertFinalizer))
+		Expect(experiment.ResourceVersion).To(Equal("2"))
+		Expect(experiment.Status.RunID).To(Equal("run"))
+
+		Expect(r.ensureFinalizer(context.Background(), experiment)).To(Succeed())
+		Expect(c.patches).To(HaveLen(1))
+	})
+
+	It("should match node taints by key and effect", func() {
+		node := &corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+			{Key: defaultNodeTaintKey, Effect: corev1.TaintEffectNoSchedule},
+		}}}
+		Expect(nodeHasTaint(node, corev1.Taint{Key: defaultNodeTaintKey, Value: "x", Effect: corev1.TaintEffectNoSchedule})).To(BeTrue())
+		Expect(nodeHasTaint(node, corev1.Taint{Key: defaultNodeTaintKey, Effect: corev1.TaintEffectNoExecute})).To(BeFalse())
+	})
+})
@@ -0,0 +1,293 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// GameDayReconciler reconciles a GameDay object
+type GameDayReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	// MaxConcurrentReconciles is how many game days are reconciled at once.
+	// Defaults to 1.
+	MaxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=gamedays,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=gamedays/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=gamedays/finalizers,verbs=update
+
+// Reconcile creates the experiments of a GameDay when its window opens, stops
+// them when it ends, and reports on the outcome.
+func (r *GameDayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	gameDay := &chaosv1alpha1.GameDay{}
+	if err := r.Get(ctx, req.NamespacedName, gameDay); err != nil {
+		if errors.IsNotFound(err) {
+			// The experiments are garbage collected with the game day.
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get GameDay")
+		return ctrl.Result{}, err
+	}
+	original := gameDay.DeepCopy()
+	if !gameDay.DeletionTimestamp.IsZero() || gameDay.Status.Phase == chaosv1alpha1.GameDayHalted || gameDay.Status.Phase == chaosv1alpha1.GameDayCompleted {
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	if now.Before(gameDay.Spec.StartTime.Time) {
+		message := fmt.Sprintf("Starts at %s.", gameDay.Spec.StartTime.UTC().Format(time.RFC3339))
+		if gameDay.Status.Phase != chaosv1alpha1.GameDayScheduled || gameDay.Status.Message != message {
+			gameDay.Status.Phase = chaosv1alpha1.GameDayScheduled
+			gameDay.Status.Message = message
+			if err := r.Status().Patch(ctx, gameDay, client.MergeFrom(original)); err != nil {
+				logger.Error(err, "Failed to update GameDay status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: gameDay.Spec.StartTime.Sub(now)}, nil
+	}
+
+	runs := &chaosv1alpha1.ChaosExperimentList{}
+	if err := r.List(ctx, runs, client.InNamespace(gameDay.Namespace), client.MatchingLabels{chaosv1alpha1.GameDayLabel: gameDay.Name}); err != nil {
+		logger.Error(err, "Failed to list experiments of GameDay")
+		return ctrl.Result{}, err
+	}
+	experiments := gameDayExperiments(gameDay, runs.Items)
+
+	phase, message := gameDayOutcome(gameDay, experiments, now)
+	// Experiments are created once; they are not recreated if deleted.
+	if phase == chaosv1alpha1.GameDayRunning && gameDay.Status.StartTime == nil {
+		for _, spec := range gameDay.Spec.Experiments {
+			run := gameDayRun(gameDay, spec)
+			if err := controllerutil.SetControllerReference(gameDay, run, r.Scheme); err != nil {
+				logger.Error(err, "Failed to set owner reference on experiment of GameDay")
+				return ctrl.Result{}, err
+			}
+			if err := r.Create(ctx, run); err != nil && !errors.IsAlreadyExists(err) {
+				logger.Error(err, "Failed to create experiment of GameDay", "Experiment", run.Name)
+				return ctrl.Result{}, err
+			}
+			experiments[spec.Name] = run
+		}
+		gameDay.Status.StartTime = &metav1.Time{Time: now}
+		r.Recorder.Eventf(gameDay, "Normal", "GameDayStarted", "Started %d experiment(s).", len(gameDay.Spec.Experiments))
+	}
+
+	gameDay.Status.Experiments = nil
+	for _, spec := range gameDay.Spec.Experiments {
+		status := chaosv1alpha1.GameDayExperimentStatus{Name: spec.Name}
+		if run := experiments[spec.Name]; run != nil {
+			status.Phase = run.Status.Phase
+			status.IterationsCompleted = run.Status.IterationsCompleted
+			status.Verdict = run.Status.Verdict
+			status.Message = run.Status.Message
+		}
+		gameDay.Status.Experiments = append(gameDay.Status.Experiments, status)
+	}
+	gameDay.Status.Phase = phase
+	gameDay.Status.Message = message
+
+	if phase != chaosv1alpha1.GameDayRunning {
+		// Deleting the experiments that are still running reverts their
+		// faults; their state is kept in the report.
+		for _, run := range experiments {
+			if run == nil || gameDayRunFinished(run) {
+				continue
+			}
+			if err := r.Delete(ctx, run, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+				logger.Error(err, "Failed to stop experiment of GameDay", "Experiment", run.Name)
+				return ctrl.Result{}, err
+			}
+		}
+		gameDay.Status.CompletionTime = &metav1.Time{Time: now}
+		gameDay.Status.Report = gameDayReport(gameDay, experiments, now)
+		r.Recorder.Eventf(gameDay, "Normal", "GameDay"+string(phase), "%s %s", message, gameDay.Status.Report.Summary)
+	}
+
+	if err := r.Status().Patch(ctx, gameDay, client.MergeFrom(original)); err != nil {
+		logger.Error(err, "Failed to update GameDay status")
+		return ctrl.Result{}, err
+	}
+	if phase != chaosv1alpha1.GameDayRunning {
+		logger.Info("GameDay ended", "GameDay", gameDay.Name, "Phase", phase, "Reason", message)
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: gameDay.Spec.EndTime.Sub(now)}, nil
+}
+
+// gameDayExperiments maps the names of the experiments of the game day to the
+// experiments it controls, or nil for those that do not exist.
+func gameDayExperiments(gameDay *chaosv1alpha1.GameDay, runs []chaosv1alpha1.ChaosExperiment) map[string]*chaosv1alpha1.ChaosExperiment {
+	experiments := map[string]*chaosv1alpha1.ChaosExperiment{}
+	for _, spec := range gameDay.Spec.Experiments {
+		experiments[spec.Name] = nil
+		for i := range runs {
+			if runs[i].Name == gameDayRunName(gameDay, spec) && metav1.IsControlledBy(&runs[i], gameDay) {
+				experiments[spec.Name] = &runs[i]
+			}
+		}
+	}
+	return experiments
+}
+
+// gameDayOutcome decides whether the game day keeps running, and why not if
+// it does not.
+func gameDayOutcome(gameDay *chaosv1alpha1.GameDay, experiments map[string]*chaosv1alpha1.ChaosExperiment, now time.Time) (chaosv1alpha1.GameDayPhase, string) {
+	if gameDay.Spec.Halt {
+		return chaosv1alpha1.GameDayHalted, "Halted by spec.halt."
+	}
+	if gameDay.Spec.HaltOnFailure == nil || *gameDay.Spec.HaltOnFailure {
+		for _, spec := range gameDay.Spec.Experiments {
+			run := experiments[spec.Name]
+			if run == nil {
+				continue
+			}
+			switch run.Status.Phase {
+			case chaosv1alpha1.ExperimentFailed:
+				return chaosv1alpha1.GameDayHalted, fmt.Sprintf("Experiment %s failed.", spec.Name)
+			case chaosv1alpha1.ExperimentAborted:
+				return chaosv1alpha1.GameDayHalted, fmt.Sprintf("Experiment %s was aborted.", spec.Name)
+			}
+		}
+	}
+	if !now.Before(gameDay.Spec.EndTime.Time) {
+		return chaosv1alpha1.GameDayCompleted, "The window closed."
+	}
+	if gameDay.Status.StartTime == nil {
+		return chaosv1alpha1.GameDayRunning, "Experiments are running."
+	}
+	for _, run := range experiments {
+		if run != nil && !gameDayRunFinished(run) {
+			return chaosv1alpha1.GameDayRunning, "Experiments are running."
+		}
+	}
+	return chaosv1alpha1.GameDayCompleted, "All experiments finished."
+}
+
+// gameDayRunFinished reports whether an experiment of a game day is over.
+func gameDayRunFinished(run *chaosv1alpha1.ChaosExperiment) bool {
+	switch run.Status.Phase {
+	case chaosv1alpha1.ExperimentCompleted, chaosv1alpha1.ExperimentFailed, chaosv1alpha1.ExperimentAborted:
+		return true
+	}
+	return false
+}
+
+// gameDayRunName is the name of the ChaosExperiment of an experiment of the
+// game day.
+func gameDayRunName(gameDay *chaosv1alpha1.GameDay, spec chaosv1alpha1.GameDayExperiment) string {
+	return gameDay.Name + "-" + spec.Name
+}
+
+// gameDayRun returns the ChaosExperiment for an experiment of the game day.
+func gameDayRun(gameDay *chaosv1alpha1.GameDay, spec chaosv1alpha1.GameDayExperiment) *chaosv1alpha1.ChaosExperiment {
+	run := &chaosv1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        gameDayRunName(gameDay, spec),
+			Namespace:   gameDay.Namespace,
+			Labels:      maps.Clone(spec.Template.Metadata.Labels),
+			Annotations: maps.Clone(spec.Template.Metadata.Annotations),
+		},
+		Spec: *spec.Template.Spec.DeepCopy(),
+	}
+	if run.Labels == nil {
+		run.Labels = map[string]string{}
+	}
+	run.Labels[chaosv1alpha1.GameDayLabel] = gameDay.Name
+	return run
+}
+
+// gameDayReport summarizes the experiments of a game day that ended.
+func gameDayReport(gameDay *chaosv1alpha1.GameDay, experiments map[string]*chaosv1alpha1.ChaosExperiment, now time.Time) *chaosv1alpha1.GameDayReport {
+	report := &chaosv1alpha1.GameDayReport{
+		GeneratedTime: metav1.Time{Time: now},
+		Experiments:   int32(len(gameDay.Spec.Experiments)),
+	}
+	targets := map[string]bool{}
+	for _, spec := range gameDay.Spec.Experiments {
+		run := experiments[spec.Name]
+		if run == nil {
+			report.Stopped++
+			continue
+		}
+		switch run.Status.Phase {
+		case chaosv1alpha1.ExperimentCompleted:
+			report.Completed++
+		case chaosv1alpha1.ExperimentFailed:
+			report.Failed++
+		case chaosv1alpha1.ExperimentAborted:
+			report.Aborted++
+		default:
+			report.Stopped++
+		}
+		if run.Status.Verdict == chaosv1alpha1.VerdictFailed {
+			report.HypothesesFailed++
+		}
+		report.Iterations += run.Status.IterationsCompleted
+		for _, record := range run.Status.History {
+			if record.Result == chaosv1alpha1.IterationFailed {
+				report.FailedIterations++
+			}
+			if !record.DryRun {
+				for _, target := range record.Targets {
+					targets[target] = true
+				}
+			}
+		}
+		for _, target := range targetNames(run.Status.LastAffectedTargets) {
+			targets[target] = true
+		}
+	}
+	report.AffectedTargets = slices.Sorted(maps.Keys(targets))
+	report.Summary = fmt.Sprintf("%d of %d experiment(s) completed, %d failed, %d were aborted and %d were stopped; %d iteration(s) affected %d target(s).",
+		report.Completed, report.Experiments, report.Failed, report.Aborted, report.Stopped, report.Iterations, len(report.AffectedTargets))
+	return report
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GameDayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("chaos-operator")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&chaosv1alpha1.GameDay{}).
+		Owns(&chaosv1alpha1.ChaosExperiment{}).
+		Named("gameday").
+		WithOptions(crcontroller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
+		Complete(r)
+}
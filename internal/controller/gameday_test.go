@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Game days", func() {
+	start := time.Date(2025, time.June, 5, 13, 0, 0, 0, time.UTC)
+	var gameDay *chaosv1alpha1.GameDay
+
+	BeforeEach(func() {
+		gameDay = &chaosv1alpha1.GameDay{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "demo", UID: "gameday"},
+			Spec: chaosv1alpha1.GameDaySpec{
+				StartTime: metav1.NewTime(start),
+				EndTime:   metav1.NewTime(start.Add(2 * time.Hour)),
+				Experiments: []chaosv1alpha1.GameDayExperiment{
+					{Name: "kill-pods", Template: chaosv1alpha1.ExperimentTemplate{Spec: chaosv1alpha1.ChaosExperimentSpec{
+						Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack},
+					}}},
+					{Name: "slow-network"},
+				},
+			},
+			Status: chaosv1alpha1.GameDayStatus{StartTime: &metav1.Time{Time: start}},
+		}
+	})
+
+	run := func(name string, phase chaosv1alpha1.ExperimentPhase) *chaosv1alpha1.ChaosExperiment {
+		return &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout-" + name, OwnerReferences: []metav1.OwnerReference{{UID: "gameday", Controller: ptr.To(true)}}},
+			Status:     chaosv1alpha1.ChaosExperimentStatus{Phase: phase},
+		}
+	}
+
+	It("should create labeled experiments named after the game day", func() {
+		experiment := gameDayRun(gameDay, gameDay.Spec.Experiments[0])
+		Expect(experiment.Name).To(Equal("checkout-kill-pods"))
+		Expect(experiment.Namespace).To(Equal("demo"))
+		Expect(experiment.Labels).To(HaveKeyWithValue(chaosv1alpha1.GameDayLabel, "checkout"))
+		Expect(experiment.Spec.Attack.Type).To(Equal(chaosv1alpha1.PodKillAttack))
+
+		foreign := *run("kill-pods", chaosv1alpha1.ExperimentRunning)
+		foreign.OwnerReferences = nil
+		experiments := gameDayExperiments(gameDay, []chaosv1alpha1.ChaosExperiment{foreign, *run("slow-network", chaosv1alpha1.ExperimentRunning)})
+		Expect(experiments).To(HaveKeyWithValue("kill-pods", BeNil()))
+		Expect(experiments).To(HaveKeyWithValue("slow-network", HaveField("Name", "checkout-slow-network")))
+	})
+
+	It("should keep running until every experiment finished or the window closed", func() {
+		experiments := map[string]*chaosv1alpha1.ChaosExperiment{
+			"kill-pods":    run("kill-pods", chaosv1alpha1.ExperimentCompleted),
+			"slow-network": run("slow-network", chaosv1alpha1.ExperimentRunning),
+		}
+		phase, _ := gameDayOutcome(gameDay, experiments, start.Add(time.Hour))
+		Expect(phase).To(Equal(chaosv1alpha1.GameDayRunning))
+
+		phase, message := gameDayOutcome(gameDay, experiments, start.Add(2*time.Hour))
+		Expect(phase).To(Equal(chaosv1alpha1.GameDayCompleted))
+		Expect(message).To(Equal("The window closed."))
+
+		experiments["slow-network"].Status.Phase = chaosv1alpha1.ExperimentCompleted
+		phase, message = gameDayOutcome(gameDay, experiments, start.Add(time.Hour))
+		Expect(phase).To(Equal(chaosv1alpha1.GameDayCompleted))
+		Expect(message).To(Equal("All experiments finished."))
+	})
+
+	It("should halt on request and when an experiment fails", func() {
+		experiments := map[string]*chaosv1alpha1.ChaosExperiment{
+			"kill-pods":    run("kill-pods", chaosv1alpha1.ExperimentAborted),
+			"slow-network": run("slow-network", chaosv1alpha1.ExperimentRunning),
+		}
+		phase, message := gameDayOutcome(gameDay, experiments, start.Add(time.Hour))
+		Expect(phase).To(Equal(chaosv1alpha1.GameDayHalted))
+		Expect(message).To(Equal("Experiment kill-pods was aborted."))
+
+		gameDay.Spec.HaltOnFailure = ptr.To(false)
+		phase, _ = gameDayOutcome(gameDay, experiments, start.Add(time.Hour))
+		Expect(phase).To(Equal(chaosv1alpha1.GameDayRunning))
+
+		gameDay.Spec.Halt = true
+		phase, message = gameDayOutcome(gameDay, experiments, start.Add(time.Hour))
+		Expect(phase).To(Equal(chaosv1alpha1.GameDayHalted))
+		Expect(message).To(Equal("Halted by spec.halt."))
+	})
+
+	It("should report on the outcome of the experiments", func() {
+		killPods := run("kill-pods", chaosv1alpha1.ExperimentCompleted)
+		killPods.Status.IterationsCompleted = 3
+		killPods.Status.Verdict = chaosv1alpha1.VerdictFailed
+		killPods.Status.History = []chaosv1alpha1.IterationRecord{
+			{Result: chaosv1alpha1.IterationSucceeded, Targets: []string{"checkout/web-1"}},
+			{Result: chaosv1alpha1.IterationFailed},
+			{Result: chaosv1alpha1.IterationSucceeded, Targets: []string{"checkout/web-2", "checkout/web-1"}},
+			{Result: chaosv1alpha1.IterationSucceeded, Targets: []string{"checkout/web-9"}, DryRun: true},
+		}
+		report := gameDayReport(gameDay, map[string]*chaosv1alpha1.ChaosExperiment{
+			"kill-pods":    killPods,
+			"slow-network": run("slow-network", chaosv1alpha1.ExperimentRunning),
+		}, start.Add(2*time.Hour))
+		Expect(report.Experiments).To(BeEquivalentTo(2))
+		Expect(report.Completed).To(BeEquivalentTo(1))
+		Expect(report.Stopped).To(BeEquivalentTo(1))
+		Expect(report.Iterations).To(BeEquivalentTo(3))
+		Expect(report.FailedIterations).To(BeEquivalentTo(1))
+		Expect(report.HypothesesFailed).To(BeEquivalentTo(1))
+		Expect(report.AffectedTargets).To(Equal([]string{"checkout/web-1", "checkout/web-2"}))
+		Expect(report.Summary).To(Equal("1 of 2 experiment(s) completed, 0 failed, 0 were aborted and 1 were stopped; 3 iteration(s) affected 2 target(s)."))
+	})
+})
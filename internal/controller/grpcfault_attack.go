@@ -0,0 +1,179 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// virtualServiceGVK identifies the Istio VirtualService the grpc-fault attack
+// uses to have the mesh sidecars of the clients inject the faults.
+var virtualServiceGVK = schema.GroupVersionKind{Group: "networking.istio.io", Version: "v1beta1", Kind: "VirtualService"}
+
+// reconcileGRPCFaultAttack injects gRPC status codes and delays into calls to
+// the target host by creating an Istio VirtualService with fault rules. The
+// VirtualService lives in the target namespace, where it cannot be owned by
+// the experiment, so it is tracked as an active fault and deleted once the
+// fault window ends or the experiment is deleted.
+func (r *ChaosExperimentReconciler) reconcileGRPCFaultAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "GRPCFault")
+
+	spec := experiment.Spec.Attack.GRPCFault
+	if spec == nil || spec.Host == "" || len(spec.Rules) == 0 {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "grpc-fault requires attack.grpcFault.host and at least one rule.")
+	}
+	for i, rule := range spec.Rules {
+		if rule.Method != "" && rule.Service == "" {
+			return r.failExperiment(ctx, experiment, "InvalidAttackSpec", fmt.Sprintf("attack.grpcFault.rules[%d].method requires service to be set.", i))
+		}
+		if rule.Code == "" && (rule.Delay == nil || rule.Delay.Duration <= 0) {
+			return r.failExperiment(ctx, experiment, "InvalidAttackSpec", fmt.Sprintf("attack.grpcFault.rules[%d] must set a code, a delay or both.", i))
+		}
+	}
+
+	namespace := experiment.Spec.Target.Namespace
+	name := fmt.Sprintf("%s-%s-grpc-fault", experiment.Namespace, experiment.Name)
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, []chaosv1alpha1.AffectedTarget{objectTarget("VirtualService", namespace, name)}); !allowed {
+		return result, err
+	}
+	now := metav1.Now()
+	revertAt := metav1.NewTime(now.Add(attackDuration(experiment, spec.Duration)))
+	if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+		Attack:     chaosv1alpha1.GRPCFaultAttack,
+		Kind:       virtualServiceGVK.Kind,
+		Namespace:  namespace,
+		Name:       name,
+		InjectedAt: now,
+		RevertAt:   revertAt,
+	}); err != nil {
+		logger.Error(err, "Failed to record gRPC fault in ChaosExperiment status", "Namespace", namespace, "Name", name)
+		return ctrl.Result{}, err
+	}
+
+	virtualService := &unstructured.Unstructured{}
+	virtualService.SetGroupVersionKind(virtualServiceGVK)
+	virtualService.SetNamespace(namespace)
+	virtualService.SetName(name)
+	_, err := controllerutil.CreateOrUpdate(ctx, r.targets(ctx), virtualService, func() error {
+		labels := virtualService.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[ExperimentLabel] = experiment.Name
+		labels[AttackTypeLabel] = string(experiment.Spec.Attack.Type)
+		virtualService.SetLabels(labels)
+		return unstructured.SetNestedField(virtualService.Object, map[string]interface{}{
+			"hosts": []interface{}{spec.Host},
+			"http":  grpcFaultRoutes(spec),
+		}, "spec")
+	})
+	if meta.IsNoMatchError(err) {
+		return r.failExperiment(ctx, experiment, "ServiceMeshNotFound", "grpc-fault requires Istio; the VirtualService API is not available in this cluster.")
+	}
+	if err != nil {
+		logger.Error(err, "Failed to apply VirtualService", "Namespace", namespace, "Name", name)
+		failOnError(experiment, "VirtualServiceFailed", err)
+		experiment.Status.Message = "Failed to apply VirtualService for grpc-fault."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "VirtualServiceFailed", "Failed to apply VirtualService %s/%s", namespace, name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after VirtualService error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("gRPC faults injected", "Host", spec.Host, "Rules", len(spec.Rules), "RevertAt", revertAt)
+	r.Recorder.Eventf(experiment, "Normal", "GRPCFaultInjected", "%d gRPC fault rule(s) applied to calls to %s until %s.", len(spec.Rules), spec.Host, revertAt.Format(time.RFC3339))
+
+	return r.completeAttackIteration(ctx, experiment, "gRPC-fault attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget("VirtualService", namespace, name)})
+}
+
+// revertGRPCFault deletes the VirtualService recorded in the fault.
+func (r *ChaosExperimentReconciler) revertGRPCFault(ctx context.Context, fault chaosv1alpha1.InjectedFault) error {
+	virtualService := &unstructured.Unstructured{}
+	virtualService.SetGroupVersionKind(virtualServiceGVK)
+	virtualService.SetNamespace(fault.Namespace)
+	virtualService.SetName(fault.Name)
+	if err := r.Delete(ctx, virtualService); err != nil && !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+// grpcFaultRoutes translates the fault rules into VirtualService HTTP routes,
+// followed by a catch-all route that forwards every other call unchanged.
+func grpcFaultRoutes(spec *chaosv1alpha1.GRPCFaultAttackSpec) []interface{} {
+	destination := []interface{}{map[string]interface{}{
+		"destination": map[string]interface{}{"host": spec.Host},
+	}}
+
+	routes := make([]interface{}, 0, len(spec.Rules)+1)
+	for i, rule := range spec.Rules {
+		percentage := rule.Percentage
+		if percentage < 1 || percentage > 100 {
+			percentage = 100
+		}
+		fault := map[string]interface{}{}
+		if rule.Code != "" {
+			fault["abort"] = map[string]interface{}{
+				"grpcStatus": rule.Code,
+				"percentage": map[string]interface{}{"value": float64(percentage)},
+			}
+		}
+		if rule.Delay != nil && rule.Delay.Duration > 0 {
+			fault["delay"] = map[string]interface{}{
+				// Istio expects protobuf durations, which only accept seconds.
+				"fixedDelay": strconv.FormatFloat(rule.Delay.Seconds(), 'f', -1, 64) + "s",
+				"percentage": map[string]interface{}{"value": float64(percentage)},
+			}
+		}
+		routes = append(routes, map[string]interface{}{
+			"name":  fmt.Sprintf("chaos-rule-%d", i),
+			"match": []interface{}{map[string]interface{}{"uri": grpcMethodMatch(rule)}},
+			"fault": fault,
+			"route": destination,
+		})
+	}
+	return append(routes, map[string]interface{}{"route": destination})
+}
+
+// grpcMethodMatch returns the URI match for the calls selected by the rule.
+// gRPC calls are HTTP/2 requests to /<service>/<method>.
+func grpcMethodMatch(rule chaosv1alpha1.GRPCFaultRule) map[string]interface{} {
+	switch {
+	case rule.Method != "":
+		return map[string]interface{}{"exact": "/" + rule.Service + "/" + rule.Method}
+	case rule.Service != "":
+		return map[string]interface{}{"prefix": "/" + rule.Service + "/"}
+	default:
+		return map[string]interface{}{"prefix": "/"}
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("gRPC fault routes", func() {
+	spec := &chaosv1alpha1.GRPCFaultAttackSpec{
+		Host: "orders",
+		Rules: []chaosv1alpha1.GRPCFaultRule{
+			{Service: "orders.v1.OrderService", Method: "GetOrder", Code: "UNAVAILABLE", Percentage: 50},
+			{Service: "orders.v1.OrderService", Delay: &metav1.Duration{Duration: 1500 * time.Millisecond}},
+		},
+	}
+
+	It("should match methods exactly and services by prefix", func() {
+		routes := grpcFaultRoutes(spec)
+		Expect(routes).To(HaveLen(3))
+
+		first := routes[0].(map[string]interface{})
+		Expect(first["match"]).To(Equal([]interface{}{map[string]interface{}{
+			"uri": map[string]interface{}{"exact": "/orders.v1.OrderService/GetOrder"},
+		}}))
+		Expect(first["fault"]).To(HaveKeyWithValue("abort", map[string]interface{}{
+			"grpcStatus": "UNAVAILABLE",
+			"percentage": map[string]interface{}{"value": float64(50)},
+		}))
+
+		second := routes[1].(map[string]interface{})
+		Expect(second["match"]).To(Equal([]interface{}{map[string]interface{}{
+			"uri": map[string]interface{}{"prefix": "/orders.v1.OrderService/"},
+		}}))
+		Expect(second["fault"]).To(HaveKeyWithValue("delay", map[string]interface{}{
+			"fixedDelay": "1.5s",
+			"percentage": map[string]interface{}{"value": float64(100)},
+		}))
+	})
+
+	It("should forward all other calls unchanged", func() {
+		routes := grpcFaultRoutes(spec)
+		Expect(routes[len(routes)-1]).To(Equal(map[string]interface{}{
+			"route": []interface{}{map[string]interface{}{
+				"destination": map[string]interface{}{"host": "orders"},
+			}},
+		}))
+	})
+})
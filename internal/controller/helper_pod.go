@@ -0,0 +1,448 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultHelperImage is the image used for helper pods when none is configured.
+	// It must provide a POSIX shell together with the tools used by the attack scripts.
+	DefaultHelperImage = "chaos-helper:latest"
+
+	// ExperimentLabel is set on every helper pod and points back to the
+	// ChaosExperiment that created it.
+	ExperimentLabel = "chaos.shanto.dev/experiment"
+	// AttackTypeLabel is set on every helper pod and records the attack it runs.
+	AttackTypeLabel = "chaos.shanto.dev/attack-type"
+	// IterationLabel is set on every helper pod and records the iteration of
+	// the experiment that started it.
+	IterationLabel = "chaos.shanto.dev/iteration"
+	// RunIDLabel is set on the helper pods and ChaosResults of an iteration
+	// and holds its run ID. Events of the iteration carry it as annotation.
+	RunIDLabel = "chaos.shanto.dev/run-id"
+	// TargetPodLabel is set on every helper pod and names the target pod it
+	// acts on.
+	TargetPodLabel = "chaos.shanto.dev/target-pod"
+	// CleanupLabel is set on helper pods that remove a fault left behind by a
+	// helper pod that did not get to remove it itself.
+	CleanupLabel = "chaos.shanto.dev/cleanup"
+	// OutcomeReasonAnnotation is set on helper pods whose outcome decides the
+	// outcome of their iteration and holds the reason of the event emitted
+	// once they succeed; OutcomeMessageAnnotation holds its message.
+	OutcomeReasonAnnotation = "chaos.shanto.dev/outcome-reason"
+	// OutcomeMessageAnnotation holds the message of the event emitted once a
+	// helper pod carrying OutcomeReasonAnnotation succeeds.
+	OutcomeMessageAnnotation = "chaos.shanto.dev/outcome-message"
+
+	// hostCgroupRoot is where the host cgroup hierarchy is mounted in helper pods.
+	hostCgroupRoot = "/host/sys/fs/cgroup"
+	// defaultAttackDuration bounds time-boxed attacks when neither the attack
+	// nor the experiment specifies a duration.
+	defaultAttackDuration = time.Minute
+)
+
+// helperPodFor builds a privileged pod that is pinned to the node of the
+// target pod and runs the given shell script in the host PID namespace. This
+// is how attacks that act on the processes of a target container are carried
+// out without requiring a permanently running node agent.
+func (r *ChaosExperimentReconciler) helperPodFor(experiment *chaosv1alpha1.ChaosExperiment, target *corev1.Pod, script string) (*corev1.Pod, error) {
+	image := r.HelperImage
+	if image == "" {
+		image = DefaultHelperImage
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", experiment.Name, experiment.Spec.Attack.Type),
+			Namespace:    experiment.Namespace,
+			Labels: map[string]string{
+				ExperimentLabel: experiment.Name,
+				AttackTypeLabel: string(experiment.Spec.Attack.Type),
+				IterationLabel:  strconv.Itoa(int(experiment.Status.IterationsCompleted) + 1),
+				TargetPodLabel:  target.Name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      target.Spec.NodeName,
+			HostPID:       true,
+			RestartPolicy: corev1.RestartPolicyNever,
+			// The helper must be able to run next to any target, including on tainted nodes.
+			Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:    "chaos-helper",
+				Image:   image,
+				Command: []string{"/bin/sh", "-c", script},
+				// The error a failed script prints ends up in the iteration.
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				SecurityContext: &corev1.SecurityContext{
+					Privileged: ptr.To(true),
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "cgroup",
+					MountPath: hostCgroupRoot,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "cgroup",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/sys/fs/cgroup"},
+				},
+			}},
+		},
+	}
+	if id := experiment.Status.RunID; id != "" {
+		pod.Labels[RunIDLabel] = id
+	}
+	// Helper pods have to be cached like the targets to be seen finishing.
+	maps.Copy(pod.Labels, r.PodCacheLabels)
+	if err := controllerutil.SetControllerReference(experiment, pod, r.Scheme); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+// runHelperPod creates a helper pod for the target and returns it once it has
+// been accepted by the API server. The helper runs to completion on its own.
+func (r *ChaosExperimentReconciler) runHelperPod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, target *corev1.Pod, script string) (*corev1.Pod, error) {
+	pod, err := r.helperPodFor(experiment, target, script)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.startHelperPod(ctx, experiment, target, pod); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+// startHelperPod creates a helper pod built by helperPodFor for the target,
+// once the ServiceAccount of the experiment, if it has one, is found to be
+// allowed to act on the target.
+func (r *ChaosExperimentReconciler) startHelperPod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, target, pod *corev1.Pod) error {
+	if err := r.authorizeHelperPod(ctx, experiment, target); err != nil {
+		return err
+	}
+	// Deleting the experiment has to stop the helper before it goes away.
+	if err := r.ensureFinalizer(ctx, experiment); err != nil {
+		return err
+	}
+	return r.Create(ctx, pod)
+}
+
+// activeHelperPods returns the helper pods of the experiment that have not
+// finished yet.
+func (r *ChaosExperimentReconciler) activeHelperPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) ([]corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(experiment.Namespace), client.MatchingLabels{ExperimentLabel: experiment.Name}); err != nil {
+		return nil, err
+	}
+	active := podList.Items[:0]
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			active = append(active, pod)
+		}
+	}
+	return active, nil
+}
+
+// stopHelperPods deletes the helper pods of the experiment and returns how
+// many of them still exist, including ones that are still terminating.
+func (r *ChaosExperimentReconciler) stopHelperPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (int, error) {
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(experiment.Namespace), client.MatchingLabels{ExperimentLabel: experiment.Name}); err != nil {
+		return 0, err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+			return 0, err
+		}
+	}
+	return len(podList.Items), nil
+}
+
+// enforceConcurrencyPolicy applies spec.concurrencyPolicy when an iteration of
+// a recurring experiment comes due while helper pods of an earlier iteration
+// are still running. It reports whether the iteration may go ahead; if it may
+// not, the iteration has been skipped and the result to hand back to the
+// controller is returned.
+func (r *ChaosExperimentReconciler) enforceConcurrencyPolicy(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	active, err := r.activeHelperPods(ctx, experiment)
+	if err != nil {
+		logger.Error(err, "Failed to list helper pods of ChaosExperiment")
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	if len(active) == 0 {
+		return true, ctrl.Result{}, nil
+	}
+
+	switch experiment.Spec.ConcurrencyPolicy {
+	case chaosv1alpha1.ForbidConcurrent:
+		logger.Info("Previous iteration is still running, skipping iteration", "Experiment", experiment.Name, "HelperPods", len(active))
+		r.Recorder.Eventf(experiment, "Normal", "IterationSkipped", "Skipped iteration because %d helper pod(s) of the previous one are still running.", len(active))
+		result, err := r.skipIteration(ctx, experiment, "Iteration skipped, the previous one is still running.")
+		return false, result, err
+	case chaosv1alpha1.ReplaceConcurrent:
+		for i := range active {
+			// Helper pods undo their changes when they are terminated.
+			if err := r.Delete(ctx, &active[i]); err != nil && !errors.IsNotFound(err) {
+				logger.Error(err, "Failed to stop helper pod of previous iteration", "HelperPod", active[i].Name)
+				return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+			}
+		}
+		logger.Info("Stopped previous iteration", "Experiment", experiment.Name, "HelperPods", len(active))
+		r.Recorder.Eventf(experiment, "Normal", "IterationReplaced", "Stopped %d helper pod(s) of the previous iteration.", len(active))
+	}
+	return true, ctrl.Result{}, nil
+}
+
+// reconcileContainerAttack picks a target pod, resolves the container the
+// attack is aimed at and runs the script returned by buildScript for it in a
+// helper pod. The iteration is completed by awaitHelperPods once the helper
+// pod finished. The event reason and action describe the attack in the event
+// emitted on success, e.g. "ContainerKilled" and "was killed".
+func (r *ChaosExperimentReconciler) reconcileContainerAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, containerName string, buildScript func(containerID string) string, eventReason, eventAction string) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", experiment.Spec.Attack.Type)
+
+	target, result, err := r.pickTargetPod(ctx, experiment)
+	if target == nil {
+		return result, err
+	}
+
+	container, err := targetContainerStatus(target, containerName)
+	if err != nil {
+		logger.Error(err, "Failed to resolve target container", "PodName", target.Name)
+		applyFailurePolicy(experiment, "ContainerNotFound")
+		experiment.Status.Message = "Failed to resolve target container."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "ContainerNotFound", "Failed to resolve target container: %v", err)
+		retryAfter := countFailure(experiment)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after container lookup error")
+		}
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	logger.Info("Attempting to attack container", "PodName", target.Name, "Namespace", target.Namespace, "Container", container.Name)
+	helper, err := r.helperPodFor(experiment, target, buildScript(runtimeContainerID(container.ContainerID)))
+	if err == nil {
+		helper.Annotations = map[string]string{
+			OutcomeReasonAnnotation:  eventReason,
+			OutcomeMessageAnnotation: fmt.Sprintf("Container %s of pod %s/%s %s.", container.Name, target.Namespace, target.Name, eventAction),
+		}
+		err = r.startHelperPod(ctx, experiment, target, helper)
+	}
+	if err != nil {
+		logger.Error(err, "Failed to create helper pod", "PodName", target.Name)
+		failOnError(experiment, "HelperPodFailed", err)
+		experiment.Status.Message = fmt.Sprintf("Failed to create helper pod for %s.", experiment.Spec.Attack.Type)
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "HelperPodFailed", "Failed to create helper pod for %s/%s", target.Namespace, target.Name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after helper pod error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Container attack dispatched", "PodName", target.Name, "Container", container.Name, "HelperPod", helper.Name)
+	r.Recorder.Eventf(experiment, "Normal", "AttackDispatched", "Helper pod %s dispatched against container %s of pod %s/%s.", helper.Name, container.Name, target.Namespace, target.Name)
+	experiment.Status.Message = fmt.Sprintf("Waiting for helper pod %s to finish.", helper.Name)
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status after dispatching helper pod")
+		return ctrl.Result{}, err
+	}
+	// The helper pod finishing triggers a reconcile; the requeue is a fallback.
+	return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+}
+
+// awaitHelperPods completes the iteration of a container attack once its
+// helper pods finished: as succeeded, at the time they were started, if all
+// of them succeeded and as failed otherwise. The helper pods of a failed
+// iteration are deleted once it is recorded in the status, so that it is
+// recorded once.
+func (r *ChaosExperimentReconciler) awaitHelperPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, helpers []corev1.Pod, targets []chaosv1alpha1.AffectedTarget) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	started := helpers[0].CreationTimestamp.Time
+	var failed *corev1.Pod
+	for i := range helpers {
+		switch helpers[i].Status.Phase {
+		case corev1.PodSucceeded:
+		case corev1.PodFailed:
+			failed = &helpers[i]
+		default:
+			// The helper pod finishing triggers a reconcile; the requeue is a fallback.
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+		if helpers[i].CreationTimestamp.Time.Before(started) {
+			started = helpers[i].CreationTimestamp.Time
+		}
+	}
+
+	if failed != nil {
+		reason := helperFailure(failed)
+		logger.Info("Helper pod failed", "HelperPod", failed.Name, "PodName", failed.Labels[TargetPodLabel], "Reason", reason)
+		applyFailurePolicy(experiment, "HelperPodFailed")
+		experiment.Status.Message = fmt.Sprintf("Helper pod %s failed: %s.", failed.Name, reason)
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "HelperPodFailed", "Helper pod %s against pod %s/%s failed: %s.", failed.Name, experiment.Spec.Target.Namespace, failed.Labels[TargetPodLabel], reason)
+		retryAfter := countFailure(experiment)
+		// The helper pods stay until the failure is recorded, so that a
+		// failed write is retried instead of the attack.
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status after helper pod failure")
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		for i := range helpers {
+			if err := r.Delete(ctx, &helpers[i]); err != nil && !errors.IsNotFound(err) {
+				logger.Error(err, "Failed to delete helper pod of failed iteration", "HelperPod", helpers[i].Name)
+				return ctrl.Result{RequeueAfter: time.Second * 30}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	for _, helper := range helpers {
+		r.Recorder.AnnotatedEventf(experiment, runAnnotations(experiment), "Normal", helper.Annotations[OutcomeReasonAnnotation], "%s", helper.Annotations[OutcomeMessageAnnotation])
+	}
+	attack := string(experiment.Spec.Attack.Type)
+	return r.completeAttackIterationAt(ctx, experiment, fmt.Sprintf("%s%s attack executed.", strings.ToUpper(attack[:1]), attack[1:]), targets, started)
+}
+
+// helperFailure describes why a helper pod failed, preferably by the message
+// and exit code its container terminated with.
+func helperFailure(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		terminated := status.State.Terminated
+		if terminated == nil {
+			continue
+		}
+		if message := strings.TrimSpace(terminated.Message); message != "" {
+			return fmt.Sprintf("exit code %d: %s", terminated.ExitCode, message)
+		}
+		return fmt.Sprintf("exit code %d", terminated.ExitCode)
+	}
+	if pod.Status.Message != "" {
+		return pod.Status.Message
+	}
+	return "the helper pod failed"
+}
+
+// attackDuration returns how long a time-boxed attack should last in a single
+// iteration: the attack's own duration, else the experiment interval, else the
+// experiment duration, else defaultAttackDuration.
+func attackDuration(experiment *chaosv1alpha1.ChaosExperiment, d *metav1.Duration) time.Duration {
+	if d != nil && d.Duration > 0 {
+		return d.Duration
+	}
+	if experiment.Spec.Interval != nil && experiment.Spec.Interval.Duration > 0 {
+		return experiment.Spec.Interval.Duration
+	}
+	if experiment.Spec.Duration != nil && experiment.Spec.Duration.Duration > 0 {
+		return experiment.Spec.Duration.Duration
+	}
+	return defaultAttackDuration
+}
+
+// attackSeconds returns d in whole seconds for the tools run by attack
+// scripts, rounded up: a timeout of 0 makes stress-ng run until killed.
+func attackSeconds(d time.Duration) int64 {
+	return max(1, int64(math.Ceil(d.Seconds())))
+}
+
+// targetContainerStatus returns the status of the named container of the pod,
+// or of its first container when name is empty.
+func targetContainerStatus(pod *corev1.Pod, name string) (*corev1.ContainerStatus, error) {
+	if name == "" {
+		if len(pod.Spec.Containers) == 0 {
+			return nil, fmt.Errorf("pod %s/%s has no containers", pod.Namespace, pod.Name)
+		}
+		name = pod.Spec.Containers[0].Name
+	}
+	for i := range pod.Status.ContainerStatuses {
+		status := &pod.Status.ContainerStatuses[i]
+		if status.Name != name {
+			continue
+		}
+		if status.ContainerID == "" || status.State.Running == nil {
+			return nil, fmt.Errorf("container %q of pod %s/%s is not running", name, pod.Namespace, pod.Name)
+		}
+		return status, nil
+	}
+	return nil, fmt.Errorf("container %q not found in pod %s/%s", name, pod.Namespace, pod.Name)
+}
+
+// runtimeContainerID strips the runtime scheme (e.g. "containerd://") from a
+// container ID as reported in the pod status.
+func runtimeContainerID(containerID string) string {
+	if i := strings.Index(containerID, "://"); i >= 0 {
+		return containerID[i+3:]
+	}
+	return containerID
+}
+
+// containerPIDsScript returns a shell snippet that stores the host PIDs of all
+// processes of the given container in $pids. Container runtimes embed the
+// container ID in the cgroup path, which makes it usable as a lookup key.
+func containerPIDsScript(containerID string) string {
+	return fmt.Sprintf(`pids=$(grep -l %q /proc/[0-9]*/cgroup 2>/dev/null | cut -d/ -f3)
+if [ -z "$pids" ]; then echo "no processes found for container %s" >&2; exit 1; fi
+`, containerID, containerID)
+}
+
+// shellQuote quotes s for use as a single word in a POSIX shell script.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// joinContainerCgroupScript returns a shell snippet that moves the helper
+// shell into every cgroup of the first process in $pids, so that anything it
+// starts afterwards is accounted against, and limited by, the target
+// container. Both cgroup v1 and the unified v2 hierarchy are handled.
+func joinContainerCgroupScript() string {
+	return `pid=${pids%%[[:space:]]*}
+for line in $(cat /proc/$pid/cgroup); do
+  controllers=$(echo "$line" | cut -d: -f2)
+  path=$(echo "$line" | cut -d: -f3)
+  if [ -z "$controllers" ]; then dir=` + hostCgroupRoot + `$path; else dir=` + hostCgroupRoot + `/${controllers#name=}$path; fi
+  if [ -w "$dir/cgroup.procs" ]; then echo $$ > "$dir/cgroup.procs"; fi
+done
+`
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Helper pods", func() {
+	target := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "demo"},
+		Spec: corev1.PodSpec{
+			NodeName:   "node-1",
+			Containers: []corev1.Container{{Name: "web"}, {Name: "sidecar"}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "web", ContainerID: "containerd://abc123", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "sidecar", ContainerID: "containerd://def456"},
+			},
+		},
+	}
+
+	It("should resolve the first container when no name is given", func() {
+		status, err := targetContainerStatus(target, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Name).To(Equal("web"))
+		Expect(runtimeContainerID(status.ContainerID)).To(Equal("abc123"))
+	})
+
+	It("should refuse containers that are not running", func() {
+		_, err := targetContainerStatus(target, "sidecar")
+		Expect(err).To(HaveOccurred())
+		_, err = targetContainerStatus(target, "missing")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should pin the helper pod to the target node and point it back to the experiment", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "chaos", UID: "uid-1"},
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.ContainerKillAttack},
+			},
+		}
+		r := &ChaosExperimentReconciler{Scheme: k8sClient.Scheme()}
+
+		pod, err := r.helperPodFor(experiment, target, "true")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pod.Namespace).To(Equal("chaos"))
+		Expect(pod.Spec.NodeName).To(Equal("node-1"))
+		Expect(pod.Spec.HostPID).To(BeTrue())
+		Expect(pod.Spec.Containers[0].Image).To(Equal(DefaultHelperImage))
+		Expect(pod.Labels).To(HaveKeyWithValue(ExperimentLabel, "kill-web"))
+		Expect(pod.OwnerReferences).To(HaveLen(1))
+	})
+
+	It("should round attack durations up to whole seconds", func() {
+		Expect(attackSeconds(500 * time.Millisecond)).To(BeEquivalentTo(1))
+		Expect(attackSeconds(1500 * time.Millisecond)).To(BeEquivalentTo(2))
+		Expect(attackSeconds(time.Minute)).To(BeEquivalentTo(60))
+	})
+
+	It("should quote user input as a single shell word", func() {
+		Expect(shellQuote("nginx")).To(Equal("'nginx'"))
+		Expect(shellQuote("it's $(rm -rf /)")).To(Equal(`'it'\''s $(rm -rf /)'`))
+	})
+})
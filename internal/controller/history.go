@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// defaultHistoryLimit is the number of iterations kept in status.history when
+// spec.historyLimit is not set.
+const defaultHistoryLimit = 10
+
+// historyLimit returns how many iterations status.history keeps.
+func historyLimit(experiment *chaosv1alpha1.ChaosExperiment) int {
+	if experiment.Spec.HistoryLimit != nil {
+		return int(*experiment.Spec.HistoryLimit)
+	}
+	return defaultHistoryLimit
+}
+
+// recordIteration records an iteration in status.history and, if it attacked
+// or failed to, in a new ChaosResult. It is called before the status update
+// that ends the iteration.
+func (r *ChaosExperimentReconciler) recordIteration(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, result chaosv1alpha1.IterationOutcome, message string, now time.Time) {
+	record := appendHistory(experiment, result, message, now)
+	setIterationConditions(experiment, result, message)
+	scoreIteration(experiment, result)
+	switch result {
+	case chaosv1alpha1.IterationFailed:
+		r.notify(ctx, experiment, chaosv1alpha1.NotifyFailed, message)
+	case chaosv1alpha1.IterationAborted:
+		r.notify(ctx, experiment, chaosv1alpha1.NotifyAborted, message)
+	}
+	if result == chaosv1alpha1.IterationSucceeded || result == chaosv1alpha1.IterationFailed {
+		r.createResult(ctx, experiment, record)
+	}
+	// The next iteration gets a new run ID.
+	experiment.Status.RunID = ""
+}
+
+// appendHistory appends an iteration to status.history, drops the oldest
+// entries beyond spec.historyLimit and returns the new entry. Targets and the
+// dry-run flag are taken from status.lastIteration if the iteration recorded
+// one, which is given the run ID of the iteration.
+func appendHistory(experiment *chaosv1alpha1.ChaosExperiment, result chaosv1alpha1.IterationOutcome, message string, now time.Time) chaosv1alpha1.IterationRecord {
+	record := chaosv1alpha1.IterationRecord{
+		Time:   metav1.NewTime(now),
+		RunID:  runID(experiment),
+		Attack: experiment.Spec.Attack.Type,
+		Result: result,
+	}
+	if result != chaosv1alpha1.IterationSucceeded {
+		record.Error = message
+	}
+	if last := experiment.Status.LastIteration; last != nil && currentIteration(experiment, last) {
+		last.RunID = record.RunID
+		record.Targets = last.Targets
+		record.DryRun = last.DryRun
+	}
+
+	history := append(experiment.Status.History, record)
+	limit := historyLimit(experiment)
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+	if len(history) == 0 {
+		history = nil
+	}
+	experiment.Status.History = history
+	return record
+}
+
+// currentIteration reports whether status.lastIteration was written by the
+// iteration being recorded, rather than left over from an earlier one.
+func currentIteration(experiment *chaosv1alpha1.ChaosExperiment, last *chaosv1alpha1.IterationResult) bool {
+	return experiment.Status.LastRunTime == nil || last.Time.After(experiment.Status.LastRunTime.Time)
+}
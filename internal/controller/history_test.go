@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Iteration history", func() {
+	It("should keep the most recent iterations up to the history limit", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Attack:       chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack},
+			HistoryLimit: ptr.To[int32](2),
+		}}
+		start := time.Now()
+		appendHistory(experiment, chaosv1alpha1.IterationSucceeded, "Pod-kill attack executed.", start)
+		appendHistory(experiment, chaosv1alpha1.IterationSkipped, "Iteration skipped.", start.Add(time.Minute))
+		appendHistory(experiment, chaosv1alpha1.IterationFailed, "Failed to delete target pod.", start.Add(2*time.Minute))
+
+		Expect(experiment.Status.History).To(HaveLen(2))
+		Expect(experiment.Status.History[0].Result).To(Equal(chaosv1alpha1.IterationSkipped))
+		Expect(experiment.Status.History[1].Result).To(Equal(chaosv1alpha1.IterationFailed))
+		Expect(experiment.Status.History[1].Error).To(Equal("Failed to delete target pod."))
+		Expect(experiment.Status.History[1].Attack).To(Equal(chaosv1alpha1.PodKillAttack))
+	})
+
+	It("should keep no history with a limit of zero", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			HistoryLimit: ptr.To[int32](0),
+		}}
+		appendHistory(experiment, chaosv1alpha1.IterationSucceeded, "", time.Now())
+		Expect(experiment.Status.History).To(BeNil())
+	})
+
+	It("should only take the targets of the current iteration", func() {
+		start := time.Now()
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{Time: metav1.NewTime(start), Targets: []string{"demo/a"}}
+		appendHistory(experiment, chaosv1alpha1.IterationSucceeded, "", start)
+		Expect(experiment.Status.History[0].Targets).To(ConsistOf("demo/a"))
+		Expect(experiment.Status.History[0].Error).To(BeEmpty())
+
+		// The next iteration does not write status.lastIteration.
+		experiment.Status.LastRunTime = &metav1.Time{Time: start}
+		appendHistory(experiment, chaosv1alpha1.IterationFailed, "Failed to taint target node.", start.Add(time.Minute))
+		Expect(experiment.Status.History[1].Targets).To(BeEmpty())
+	})
+})
@@ -0,0 +1,334 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const (
+	// defaultHypothesisDelay is how long after an iteration the hypothesis is
+	// verified when spec.hypothesis does not say otherwise.
+	defaultHypothesisDelay = 30 * time.Second
+	// defaultProbeTimeout bounds HTTP probes that do not set a timeout.
+	defaultProbeTimeout = 5 * time.Second
+	// probePollInterval is how often probes that run in pods are checked on.
+	probePollInterval = 2 * time.Second
+)
+
+// errProbePending is returned by probes that have not finished yet.
+var errProbePending = fmt.Errorf("probe is still running")
+
+// probeClient is used for HTTP probes. Each request is bounded by the timeout
+// of its probe.
+var probeClient = &http.Client{}
+
+// checkSteadyState runs the probes of spec.hypothesis before an iteration and
+// reports whether the attack may go ahead. If the steady state does not hold,
+// the experiment fails, since chaos cannot be validated against a system that
+// is already unhealthy, and the result to hand back to the controller is
+// returned.
+func (r *ChaosExperimentReconciler) checkSteadyState(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	if experiment.Spec.Hypothesis == nil {
+		return true, ctrl.Result{}, nil
+	}
+	failed, pending := r.runProbes(ctx, experiment, chaosv1alpha1.ProbePre)
+	if pending {
+		return false, ctrl.Result{RequeueAfter: probePollInterval}, nil
+	}
+	if len(failed) > 0 {
+		experiment.Status.Verdict = chaosv1alpha1.VerdictFailed
+		result, err := r.failExperiment(ctx, experiment, "HypothesisNotMet", fmt.Sprintf("Steady-state hypothesis does not hold before the attack: %s.", strings.Join(failed, "; ")))
+		return false, result, err
+	}
+	if resilience := experiment.Status.Resilience; resilience != nil && resilience.RecoveringSince != nil {
+		scoreCheck(experiment, false, true, time.Now())
+	}
+	return true, ctrl.Result{}, nil
+}
+
+// scheduleHypothesisCheck records when the probes of spec.hypothesis run
+// during and after the iteration that just ran.
+func scheduleHypothesisCheck(experiment *chaosv1alpha1.ChaosExperiment, now time.Time) {
+	hypothesis := experiment.Spec.Hypothesis
+	if hypothesis == nil {
+		return
+	}
+	if slices.ContainsFunc(hypothesis.Probes, func(probe chaosv1alpha1.Probe) bool {
+		return probeRuns(probe, chaosv1alpha1.ProbeDuring)
+	}) {
+		experiment.Status.DuringProbeTime = &metav1.Time{Time: now}
+	}
+	delay := defaultHypothesisDelay
+	if hypothesis.Delay != nil {
+		delay = hypothesis.Delay.Duration
+	}
+	checkTime := metav1.NewTime(now.Add(delay))
+	experiment.Status.HypothesisCheckTime = &checkTime
+}
+
+// verifyHypothesis runs the probes of spec.hypothesis that are due during the
+// last iteration, and the ones after it once the check scheduled for them is
+// due, and then records the verdict. It reports whether the reconcile has to
+// wait for the check, together with the result to hand back to the
+// controller.
+func (r *ChaosExperimentReconciler) verifyHypothesis(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if experiment.Status.HypothesisCheckTime == nil && experiment.Status.DuringProbeTime == nil {
+		return false, ctrl.Result{}, nil
+	}
+	if experiment.Spec.Hypothesis == nil {
+		experiment.Status.HypothesisCheckTime = nil
+		experiment.Status.DuringProbeTime = nil
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to clear hypothesis check of ChaosExperiment")
+			return true, ctrl.Result{}, err
+		}
+		return false, ctrl.Result{}, nil
+	}
+
+	if experiment.Status.DuringProbeTime != nil {
+		failed, pending := r.runProbes(ctx, experiment, chaosv1alpha1.ProbeDuring)
+		if pending {
+			return true, ctrl.Result{RequeueAfter: probePollInterval}, nil
+		}
+		experiment.Status.DuringProbeTime = nil
+		if len(failed) > 0 {
+			r.Recorder.Eventf(experiment, "Warning", "ProbesFailedDuringAttack", "Steady-state probes failed during the attack: %s.", strings.Join(failed, "; "))
+		}
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to record probes of ChaosExperiment during the attack")
+			return true, ctrl.Result{}, err
+		}
+	}
+
+	checkTime := experiment.Status.HypothesisCheckTime
+	if checkTime == nil {
+		return false, ctrl.Result{}, nil
+	}
+	if wait := time.Until(checkTime.Time); wait > 0 {
+		return true, ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	failed, pending := r.runProbes(ctx, experiment, chaosv1alpha1.ProbePost)
+	if pending {
+		return true, ctrl.Result{RequeueAfter: probePollInterval}, nil
+	}
+	failed = append(failedDuringAttack(experiment), failed...)
+	experiment.Status.HypothesisCheckTime = nil
+	if len(failed) > 0 {
+		experiment.Status.Verdict = chaosv1alpha1.VerdictFailed
+		r.Recorder.Eventf(experiment, "Warning", "HypothesisFailed", "Steady-state hypothesis did not hold after the attack: %s.", strings.Join(failed, "; "))
+	} else {
+		experiment.Status.Verdict = chaosv1alpha1.VerdictPassed
+		r.Recorder.Event(experiment, "Normal", "HypothesisPassed", "Steady-state hypothesis held after the attack.")
+	}
+	scoreCheck(experiment, true, len(failed) == 0, time.Now())
+	r.recordVerdict(ctx, experiment)
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to record verdict of ChaosExperiment")
+		return true, ctrl.Result{}, err
+	}
+	return false, ctrl.Result{}, nil
+}
+
+// requeueForHypothesis makes sure the experiment is reconciled again in time
+// to run the probes due during and after the last iteration.
+func requeueForHypothesis(experiment *chaosv1alpha1.ChaosExperiment, result ctrl.Result) ctrl.Result {
+	for _, checkTime := range []*metav1.Time{experiment.Status.DuringProbeTime, experiment.Status.HypothesisCheckTime} {
+		if checkTime == nil {
+			continue
+		}
+		next := max(time.Until(checkTime.Time), time.Second)
+		if result.RequeueAfter == 0 || next < result.RequeueAfter {
+			result.RequeueAfter = next
+		}
+	}
+	return result
+}
+
+// probeRuns reports whether the probe runs in the given phase of iterations.
+func probeRuns(probe chaosv1alpha1.Probe, phase chaosv1alpha1.ProbePhase) bool {
+	if len(probe.When) == 0 {
+		return phase == chaosv1alpha1.ProbePre || phase == chaosv1alpha1.ProbePost
+	}
+	return slices.Contains(probe.When, phase)
+}
+
+// failedDuringAttack describes the probes that failed during the last attack.
+func failedDuringAttack(experiment *chaosv1alpha1.ChaosExperiment) []string {
+	var failed []string
+	for _, result := range experiment.Status.ProbeResults {
+		if result.Phase == chaosv1alpha1.ProbeDuring && !result.Passed {
+			failed = append(failed, fmt.Sprintf("probe %s failed during the attack: %s", result.Name, result.Message))
+		}
+	}
+	return failed
+}
+
+// runProbes runs the probes of spec.hypothesis for the phase, records their
+// outcome in status.probeResults, replacing the results of earlier runs in
+// the phase, and returns descriptions of the probes that failed. It also
+// reports whether some probes are still running, in which case the phase has
+// to be run again to collect their results.
+func (r *ChaosExperimentReconciler) runProbes(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, phase chaosv1alpha1.ProbePhase) ([]string, bool) {
+	logger := log.FromContext(ctx)
+
+	var failed []string
+	pending, commands := false, false
+	results := slices.DeleteFunc(slices.Clone(experiment.Status.ProbeResults), func(result chaosv1alpha1.ProbeResult) bool {
+		return result.Phase == phase || result.Phase == ""
+	})
+	for _, probe := range experiment.Spec.Hypothesis.Probes {
+		if !probeRuns(probe, phase) {
+			continue
+		}
+		commands = commands || probe.Command != nil
+		err := r.runProbe(ctx, experiment, probe, phase)
+		if err == errProbePending {
+			pending = true
+			continue
+		}
+		result := chaosv1alpha1.ProbeResult{Name: probe.Name, Phase: phase, Passed: true, Time: metav1.Now()}
+		if err != nil {
+			logger.Info("Steady-state probe failed", "Probe", probe.Name, "Phase", phase, "Reason", err.Error())
+			result.Passed = false
+			result.Message = err.Error()
+			failed = append(failed, fmt.Sprintf("probe %s failed: %v", probe.Name, err))
+		}
+		results = append(results, result)
+	}
+	experiment.Status.ProbeResults = results
+	if commands && !pending {
+		if err := r.deleteProbePods(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to delete probe pods of ChaosExperiment")
+		}
+	}
+	return failed, pending
+}
+
+// runProbe runs a single probe and returns why it failed, nil if it passed,
+// or errProbePending if it has not finished yet.
+func (r *ChaosExperimentReconciler) runProbe(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, probe chaosv1alpha1.Probe, phase chaosv1alpha1.ProbePhase) error {
+	switch {
+	case probe.Command != nil:
+		return r.runCommandProbe(ctx, experiment, probe, phase)
+	case probe.HTTP != nil:
+		return runHTTPProbe(ctx, probe.HTTP)
+	case probe.PromQL != nil:
+		if r.PrometheusURL == "" {
+			return fmt.Errorf("the operator is not configured with a Prometheus URL")
+		}
+		ok, err := r.queryPrometheus(ctx, probe.PromQL.Query)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("query returned no series")
+		}
+		return nil
+	case probe.Resource != nil:
+		return r.workloadReady(ctx, experiment.Spec.Target.Namespace, probe.Resource)
+	default:
+		return fmt.Errorf("probe sets no check")
+	}
+}
+
+// runHTTPProbe sends a GET request to the probe URL and checks the status code.
+func runHTTPProbe(ctx context.Context, probe *chaosv1alpha1.HTTPProbe) error {
+	timeout := defaultProbeTimeout
+	if probe.Timeout != nil {
+		timeout = probe.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := probeClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	expected := int(probe.ExpectedStatus)
+	if expected == 0 {
+		expected = http.StatusOK
+	}
+	if resp.StatusCode != expected {
+		return fmt.Errorf("got status %d, expected %d", resp.StatusCode, expected)
+	}
+	return nil
+}
+
+// workloadReady returns an error unless all replicas of the workload are ready.
+func (r *ChaosExperimentReconciler) workloadReady(ctx context.Context, namespace string, ref *chaosv1alpha1.WorkloadReference) error {
+	var workload client.Object
+	switch ref.Kind {
+	case "Deployment":
+		workload = &appsv1.Deployment{}
+	case "StatefulSet":
+		workload = &appsv1.StatefulSet{}
+	case "DaemonSet":
+		workload = &appsv1.DaemonSet{}
+	default:
+		return fmt.Errorf("unsupported workload kind %q", ref.Kind)
+	}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, workload); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("%s %s/%s not found", ref.Kind, namespace, ref.Name)
+		}
+		return err
+	}
+
+	var ready, desired int32
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		ready, desired = w.Status.ReadyReplicas, 1
+		if w.Spec.Replicas != nil {
+			desired = *w.Spec.Replicas
+		}
+	case *appsv1.StatefulSet:
+		ready, desired = w.Status.ReadyReplicas, 1
+		if w.Spec.Replicas != nil {
+			desired = *w.Spec.Replicas
+		}
+	case *appsv1.DaemonSet:
+		ready, desired = w.Status.NumberReady, w.Status.DesiredNumberScheduled
+	}
+	if ready < desired {
+		return fmt.Errorf("%d of %d replicas of %s %s/%s are ready", ready, desired, ref.Kind, namespace, ref.Name)
+	}
+	return nil
+}
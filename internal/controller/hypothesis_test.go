@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Steady-state hypothesis", func() {
+	It("should check the status code of HTTP probes", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/healthz" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		DeferCleanup(server.Close)
+		ctx := context.Background()
+
+		Expect(runHTTPProbe(ctx, &chaosv1alpha1.HTTPProbe{URL: server.URL + "/healthz"})).To(Succeed())
+		Expect(runHTTPProbe(ctx, &chaosv1alpha1.HTTPProbe{URL: server.URL + "/ready"})).To(MatchError(ContainSubstring("got status 503")))
+		Expect(runHTTPProbe(ctx, &chaosv1alpha1.HTTPProbe{URL: server.URL + "/ready", ExpectedStatus: 503})).To(Succeed())
+	})
+
+	It("should fail PromQL probes without Prometheus", func() {
+		r := &ChaosExperimentReconciler{}
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Hypothesis: &chaosv1alpha1.Hypothesis{Probes: []chaosv1alpha1.Probe{
+				{Name: "traffic", PromQL: &chaosv1alpha1.PromQLProbe{Query: "sum(rate(http_requests_total[1m])) > 10"}},
+			}},
+		}}
+		failed, pending := r.runProbes(context.Background(), experiment, chaosv1alpha1.ProbePre)
+		Expect(pending).To(BeFalse())
+		Expect(failed).To(ConsistOf(ContainSubstring("probe traffic failed")))
+		Expect(experiment.Status.ProbeResults).To(HaveLen(1))
+		Expect(experiment.Status.ProbeResults[0].Passed).To(BeFalse())
+	})
+
+	It("should requeue in time to verify the hypothesis after an iteration", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Hypothesis: &chaosv1alpha1.Hypothesis{Delay: &metav1.Duration{Duration: time.Minute}},
+		}}
+		Expect(requeueForHypothesis(experiment, ctrl.Result{RequeueAfter: time.Hour})).To(Equal(ctrl.Result{RequeueAfter: time.Hour}))
+
+		scheduleHypothesisCheck(experiment, time.Now())
+		Expect(experiment.Status.HypothesisCheckTime).NotTo(BeNil())
+		result := requeueForHypothesis(experiment, ctrl.Result{RequeueAfter: time.Hour})
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Minute, time.Second))
+	})
+
+	It("should run probes before and after the attack unless scheduled otherwise", func() {
+		probe := chaosv1alpha1.Probe{Name: "web"}
+		Expect(probeRuns(probe, chaosv1alpha1.ProbePre)).To(BeTrue())
+		Expect(probeRuns(probe, chaosv1alpha1.ProbeDuring)).To(BeFalse())
+		Expect(probeRuns(probe, chaosv1alpha1.ProbePost)).To(BeTrue())
+
+		probe.When = []chaosv1alpha1.ProbePhase{chaosv1alpha1.ProbeDuring}
+		Expect(probeRuns(probe, chaosv1alpha1.ProbePre)).To(BeFalse())
+		Expect(probeRuns(probe, chaosv1alpha1.ProbeDuring)).To(BeTrue())
+
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Hypothesis: &chaosv1alpha1.Hypothesis{Probes: []chaosv1alpha1.Probe{probe}},
+		}}
+		scheduleHypothesisCheck(experiment, time.Now())
+		Expect(experiment.Status.DuringProbeTime).NotTo(BeNil())
+	})
+
+	It("should keep the probe results of other phases", func() {
+		r := &ChaosExperimentReconciler{}
+		experiment := &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Hypothesis: &chaosv1alpha1.Hypothesis{Probes: []chaosv1alpha1.Probe{{
+					Name:   "traffic",
+					When:   []chaosv1alpha1.ProbePhase{chaosv1alpha1.ProbeDuring},
+					PromQL: &chaosv1alpha1.PromQLProbe{Query: "up"},
+				}}},
+			},
+			Status: chaosv1alpha1.ChaosExperimentStatus{ProbeResults: []chaosv1alpha1.ProbeResult{
+				{Name: "web", Phase: chaosv1alpha1.ProbePre, Passed: true},
+				{Name: "traffic", Phase: chaosv1alpha1.ProbeDuring, Passed: true},
+			}},
+		}
+		failed, _ := r.runProbes(context.Background(), experiment, chaosv1alpha1.ProbeDuring)
+		Expect(failed).To(HaveLen(1))
+		Expect(experiment.Status.ProbeResults).To(HaveLen(2))
+		Expect(experiment.Status.ProbeResults[0].Name).To(Equal("web"))
+		Expect(experiment.Status.ProbeResults[1].Passed).To(BeFalse())
+		Expect(failedDuringAttack(experiment)).To(ConsistOf(ContainSubstring("probe traffic failed during the attack")))
+	})
+
+	It("should run command probes in a pod per phase and iteration", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "shop"}}
+		probe := chaosv1alpha1.Probe{Name: "curl", Command: &chaosv1alpha1.CommandProbe{
+			Image:   "curlimages/curl",
+			Command: []string{"curl", "-f", "http://web/healthz"},
+			Timeout: &metav1.Duration{Duration: 10 * time.Second},
+		}}
+		pod := probePod(experiment, probe, chaosv1alpha1.ProbePost)
+		Expect(pod.Namespace).To(Equal("shop"))
+		Expect(pod.Labels).To(HaveKeyWithValue(ProbeLabel, "checkout"))
+		Expect(pod.Labels).NotTo(HaveKey(ExperimentLabel))
+		Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(*pod.Spec.ActiveDeadlineSeconds).To(Equal(int64(10)))
+		Expect(pod.Spec.Containers[0].Command).To(Equal(probe.Command.Command))
+
+		Expect(probePodName(experiment, probe, chaosv1alpha1.ProbePre)).NotTo(Equal(pod.Name))
+		experiment.Status.IterationsCompleted = 1
+		Expect(probePodName(experiment, probe, chaosv1alpha1.ProbePost)).NotTo(Equal(pod.Name))
+	})
+
+	It("should derive the outcome of command probes from their pod", func() {
+		now := time.Now()
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "probe", CreationTimestamp: metav1.NewTime(now)}}
+		Expect(commandProbeOutcome(pod, time.Minute, now)).To(Equal(errProbePending))
+		Expect(commandProbeOutcome(pod, time.Minute, now.Add(3*time.Minute))).To(MatchError(ContainSubstring("did not finish")))
+
+		pod.Status.Phase = corev1.PodSucceeded
+		Expect(commandProbeOutcome(pod, time.Minute, now)).To(Succeed())
+
+		pod.Status.Phase = corev1.PodFailed
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 22},
+		}}}
+		Expect(commandProbeOutcome(pod, time.Minute, now)).To(MatchError("command exited with status 22"))
+	})
+})
@@ -0,0 +1,176 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// brokenImageTag is the tag the image-pull-failure attack rolls out when no
+// image is configured. It is not expected to exist in any registry.
+const brokenImageTag = "chaos-image-pull-failure"
+
+// imagePullFailureOriginal is stored in InjectedFault.Original for the
+// image-pull-failure attack.
+type imagePullFailureOriginal struct {
+	Container string `json:"container"`
+	Image     string `json:"image"`
+	Injected  string `json:"injected"`
+}
+
+// reconcileImagePullFailureAttack replaces the image of a workload container
+// with one that cannot be pulled, which starts a rollout that gets stuck in
+// ErrImagePull/ImagePullBackOff. The original image is recorded as an active
+// fault and restored when the window ends.
+func (r *ChaosExperimentReconciler) reconcileImagePullFailureAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "ImagePullFailure")
+
+	spec := experiment.Spec.Attack.ImagePullFailure
+	if spec == nil {
+		spec = &chaosv1alpha1.ImagePullFailureAttackSpec{}
+	}
+
+	workload, kind, result, err := r.targetWorkload(ctx, experiment, spec.Kind, spec.Name)
+	if workload == nil {
+		return result, err
+	}
+	namespace, name := workload.GetNamespace(), workload.GetName()
+
+	template := workloadPodTemplate(workload)
+	container := templateContainer(template, spec.ContainerName)
+	if container == nil {
+		return r.failExperiment(ctx, experiment, "ContainerNotFound", fmt.Sprintf("Container %q not found in %s %s/%s.", spec.ContainerName, kind, namespace, name))
+	}
+
+	// When the image is still broken from an earlier iteration, keep the
+	// original image captured back then.
+	original := imagePullFailureOriginal{Container: container.Name, Image: container.Image}
+	if existing := findFault(experiment, chaosv1alpha1.ImagePullFailureAttack, kind, namespace, name); existing != nil {
+		_ = json.Unmarshal([]byte(existing.Original), &original)
+	}
+	original.Injected = spec.Image
+	if original.Injected == "" {
+		original.Injected = brokenImage(original.Image)
+	}
+
+	encoded, err := json.Marshal(original)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	now := metav1.Now()
+	revertAt := metav1.NewTime(now.Add(attackDuration(experiment, spec.Duration)))
+	if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+		Attack:     chaosv1alpha1.ImagePullFailureAttack,
+		Kind:       kind,
+		Namespace:  namespace,
+		Name:       name,
+		Original:   string(encoded),
+		InjectedAt: now,
+		RevertAt:   revertAt,
+	}); err != nil {
+		logger.Error(err, "Failed to record image change in ChaosExperiment status", "Kind", kind, "Name", name)
+		return ctrl.Result{}, err
+	}
+
+	patch := client.MergeFromWithOptions(workload.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+	container.Image = original.Injected
+	if err := r.targets(ctx).Patch(ctx, workload, patch); err != nil {
+		logger.Error(err, "Failed to patch workload image", "Kind", kind, "Namespace", namespace, "Name", name)
+		failOnError(experiment, "ImagePatchFailed", err)
+		experiment.Status.Message = "Failed to patch target workload image."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "ImagePatchFailed", "Failed to patch image of %s %s/%s", kind, namespace, name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after image patch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Rolled out unpullable image", "Kind", kind, "Namespace", namespace, "Name", name, "Container", original.Container, "Image", original.Injected, "RevertAt", revertAt)
+	r.Recorder.Eventf(experiment, "Normal", "ImageBroken", "Container %s of %s %s/%s now uses image %s until %s.",
+		original.Container, kind, namespace, name, original.Injected, revertAt.Format(time.RFC3339))
+
+	return r.completeAttackIteration(ctx, experiment, "Image-pull-failure attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget(kind, namespace, name)})
+}
+
+// revertImagePullFailure restores the original image recorded in the fault.
+// The image is only restored while the container still runs the injected
+// image, so a rollout made by someone else in the meantime is left alone.
+func (r *ChaosExperimentReconciler) revertImagePullFailure(ctx context.Context, fault chaosv1alpha1.InjectedFault) error {
+	original := imagePullFailureOriginal{}
+	if err := json.Unmarshal([]byte(fault.Original), &original); err != nil {
+		return err
+	}
+	workload, err := newScalableWorkload(fault.Kind)
+	if err != nil {
+		return err
+	}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: fault.Namespace, Name: fault.Name}, workload); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	container := templateContainer(workloadPodTemplate(workload), original.Container)
+	if container == nil || container.Image != original.Injected {
+		return nil
+	}
+	patch := client.MergeFromWithOptions(workload.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+	container.Image = original.Image
+	return r.Patch(ctx, workload, patch)
+}
+
+// templateContainer returns the named container of the pod template, or its
+// first container when name is empty.
+func templateContainer(template *corev1.PodTemplateSpec, name string) *corev1.Container {
+	containers := template.Spec.Containers
+	if name == "" && len(containers) > 0 {
+		return &containers[0]
+	}
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+// brokenImage returns the image reference with its tag or digest replaced by
+// brokenImageTag.
+func brokenImage(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image + ":" + brokenImageTag
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// ImpersonatingClients builds clients that impersonate a user from the REST
+// config of the operator, and keeps one client per user.
+type ImpersonatingClients struct {
+	// Config is the REST config of the operator.
+	Config *rest.Config
+
+	// Options are the options of every client, e.g. its scheme and REST
+	// mapper.
+	Options client.Options
+
+	// Wrap, if set, wraps every client, e.g. to set its field owner.
+	Wrap func(client.Client) client.Client
+
+	mu      sync.Mutex
+	clients map[string]client.Client
+}
+
+// For returns the client that impersonates the user. The client reads from
+// the API server rather than from a cache.
+func (c *ImpersonatingClients) For(username string) (client.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if impersonating, ok := c.clients[username]; ok {
+		return impersonating, nil
+	}
+	config := rest.CopyConfig(c.Config)
+	config.Impersonate = rest.ImpersonationConfig{UserName: username}
+	impersonating, err := client.New(config, c.Options)
+	if err != nil {
+		return nil, err
+	}
+	if c.Wrap != nil {
+		impersonating = c.Wrap(impersonating)
+	}
+	if c.clients == nil {
+		c.clients = map[string]client.Client{}
+	}
+	c.clients[username] = impersonating
+	return impersonating, nil
+}
+
+// targetClientKey is the context key of the client that impersonates the
+// ServiceAccount of the experiment being reconciled.
+type targetClientKey struct{}
+
+// serviceAccountUsername returns the user name the API server knows the
+// ServiceAccount by.
+func serviceAccountUsername(namespace, name string) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)
+}
+
+// withTargetClient returns a context in which the targets of the experiment
+// are listed and acted on as spec.serviceAccountName, if it is set.
+func (r *ChaosExperimentReconciler) withTargetClient(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (context.Context, error) {
+	name := experiment.Spec.ServiceAccountName
+	if name == "" {
+		return ctx, nil
+	}
+	if r.ImpersonatingClient == nil {
+		return ctx, fmt.Errorf("the operator is not configured to impersonate ServiceAccounts")
+	}
+	impersonating, err := r.ImpersonatingClient(serviceAccountUsername(experiment.Namespace, name))
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, targetClientKey{}, impersonating), nil
+}
+
+// impersonatingClient returns the client that impersonates the ServiceAccount
+// of the experiment being reconciled, and false if it has none.
+func impersonatingClient(ctx context.Context) (client.Client, bool) {
+	impersonating, ok := ctx.Value(targetClientKey{}).(client.Client)
+	return impersonating, ok
+}
+
+// targets returns the client that lists and acts on the targets of the
+// experiment being reconciled: the one impersonating its ServiceAccount, if
+// it has one, or the client of the reconciler.
+func (r *ChaosExperimentReconciler) targets(ctx context.Context) client.Client {
+	if impersonating, ok := impersonatingClient(ctx); ok {
+		return impersonating
+	}
+	return r.Client
+}
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// authorizeHelperPod checks that the ServiceAccount of the experiment, if it
+// has one, may exec into the target pod. Helper pods are started by the
+// operator and act inside the target with its permissions, so exec is what
+// the ServiceAccount must be allowed instead. A denial is returned as a
+// Forbidden error.
+func (r *ChaosExperimentReconciler) authorizeHelperPod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, target *corev1.Pod) error {
+	name := experiment.Spec.ServiceAccountName
+	if name == "" {
+		return nil
+	}
+	review := &authorizationv1.SubjectAccessReview{Spec: authorizationv1.SubjectAccessReviewSpec{
+		User:   serviceAccountUsername(experiment.Namespace, name),
+		Groups: []string{"system:serviceaccounts", "system:serviceaccounts:" + experiment.Namespace, "system:authenticated"},
+		ResourceAttributes: &authorizationv1.ResourceAttributes{
+			Namespace:   target.Namespace,
+			Verb:        "create",
+			Resource:    "pods",
+			Subresource: "exec",
+			Name:        target.Name,
+		},
+	}}
+	if err := r.Create(ctx, review); err != nil {
+		return fmt.Errorf("reviewing the access of ServiceAccount %s: %w", name, err)
+	}
+	if !review.Status.Allowed {
+		return errors.NewForbidden(corev1.Resource("pods/exec"), target.Name, fmt.Errorf("ServiceAccount %s/%s may not exec into the pod, which the helper pod of the attack requires", experiment.Namespace, name))
+	}
+	return nil
+}
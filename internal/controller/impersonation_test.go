@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// accessReviewer answers SubjectAccessReviews and records them.
+type accessReviewer struct {
+	client.Client
+	allowed bool
+	reviews []authorizationv1.SubjectAccessReviewSpec
+}
+
+func (c *accessReviewer) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	review := obj.(*authorizationv1.SubjectAccessReview)
+	c.reviews = append(c.reviews, review.Spec)
+	review.Status.Allowed = c.allowed
+	return nil
+}
+
+var _ = Describe("ServiceAccount impersonation", func() {
+	var operator, impersonating *podDeleter
+	var usernames []string
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		operator, impersonating = &podDeleter{}, &podDeleter{}
+		usernames = nil
+		r = &ChaosExperimentReconciler{
+			Client:   operator,
+			Recorder: record.NewFakeRecorder(10),
+			ImpersonatingClient: func(username string) (client.Client, error) {
+				usernames = append(usernames, username)
+				return impersonating, nil
+			},
+		}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "team-a"}}
+	})
+
+	It("should kill targets as the ServiceAccount of the experiment", func() {
+		experiment.Spec.ServiceAccountName = "chaos-runner"
+
+		ctx, err := r.withTargetClient(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usernames).To(Equal([]string{"system:serviceaccount:team-a:chaos-runner"}))
+		Expect(r.deletePod(ctx, experiment, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "team-a"}})).To(Succeed())
+		Expect(impersonating.deleted).To(Equal([]string{"web-0"}))
+		Expect(operator.deleted).To(BeEmpty())
+	})
+
+	It("should act with the permissions of the operator without a ServiceAccount", func() {
+		ctx, err := r.withTargetClient(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usernames).To(BeEmpty())
+		Expect(r.targets(ctx)).To(BeIdenticalTo(r.Client))
+	})
+
+	It("should refuse a ServiceAccount when impersonation is not configured", func() {
+		experiment.Spec.ServiceAccountName = "chaos-runner"
+		r.ImpersonatingClient = nil
+
+		_, err := r.withTargetClient(context.Background(), experiment)
+		Expect(err).To(MatchError(ContainSubstring("not configured to impersonate")))
+	})
+
+	It("should only start helper pods the ServiceAccount may exec into the target for", func() {
+		reviewer := &accessReviewer{}
+		r.Client = reviewer
+		target := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "team-a"}}
+
+		Expect(r.authorizeHelperPod(context.Background(), experiment, target)).To(Succeed())
+		Expect(reviewer.reviews).To(BeEmpty())
+
+		experiment.Spec.ServiceAccountName = "chaos-runner"
+		err := r.authorizeHelperPod(context.Background(), experiment, target)
+		Expect(errors.IsForbidden(err)).To(BeTrue())
+		Expect(reviewer.reviews).To(HaveLen(1))
+		Expect(reviewer.reviews[0].User).To(Equal("system:serviceaccount:team-a:chaos-runner"))
+		Expect(*reviewer.reviews[0].ResourceAttributes).To(Equal(authorizationv1.ResourceAttributes{
+			Namespace: "team-a", Verb: "create", Resource: "pods", Subresource: "exec", Name: "web-0",
+		}))
+
+		reviewer.allowed = true
+		Expect(r.authorizeHelperPod(context.Background(), experiment, target)).To(Succeed())
+	})
+
+	It("should build one client per user", func() {
+		clients := &ImpersonatingClients{Config: &rest.Config{Host: "https://127.0.0.1:6443"}}
+
+		first, err := clients.For(serviceAccountUsername("team-a", "chaos-runner"))
+		Expect(err).NotTo(HaveOccurred())
+		again, err := clients.For(serviceAccountUsername("team-a", "chaos-runner"))
+		Expect(err).NotTo(HaveOccurred())
+		other, err := clients.For(serviceAccountUsername("team-b", "chaos-runner"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(BeIdenticalTo(first))
+		Expect(other).NotTo(BeIdenticalTo(first))
+	})
+})
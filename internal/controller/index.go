@@ -0,0 +1,68 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const (
+	// targetNamespaceField indexes ChaosExperiments by the namespace of
+	// their targets.
+	targetNamespaceField = "spec.target.namespace"
+	// targetWorkloadField indexes ChaosExperiments with a workload target by
+	// the key workloadKey returns for it.
+	targetWorkloadField = "spec.target.workload"
+)
+
+// indexExperiments registers the cache indexes that resolve pod and workload
+// events to the experiments they affect without scanning all experiments.
+func indexExperiments(ctx context.Context, mgr ctrl.Manager) error {
+	indexer := mgr.GetFieldIndexer()
+	if err := indexer.IndexField(ctx, &chaosv1alpha1.ChaosExperiment{}, targetNamespaceField, targetNamespaceIndex); err != nil {
+		return err
+	}
+	return indexer.IndexField(ctx, &chaosv1alpha1.ChaosExperiment{}, targetWorkloadField, targetWorkloadIndex)
+}
+
+// targetNamespaceIndex returns the target namespace of an experiment.
+func targetNamespaceIndex(object client.Object) []string {
+	experiment := object.(*chaosv1alpha1.ChaosExperiment)
+	return []string{experiment.Spec.Target.Namespace}
+}
+
+// targetWorkloadIndex returns the key of the target workload of an
+// experiment, if it has one.
+func targetWorkloadIndex(object client.Object) []string {
+	experiment := object.(*chaosv1alpha1.ChaosExperiment)
+	workload := experiment.Spec.Target.Workload
+	if workload == nil {
+		return nil
+	}
+	return []string{workloadKey(experiment.Spec.Target.Namespace, workload.Kind, workload.Name)}
+}
+
+// workloadKey identifies a workload across namespaces and kinds.
+func workloadKey(namespace, kind, name string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, kind, name)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// defaultIOStressSize is the file size each io-stress worker works on when
+// attack.ioStress.size is not set.
+var defaultIOStressSize = resource.MustParse("256Mi")
+
+// reconcileIOStressAttack runs fio against a directory of a target container.
+// The helper reaches the container's filesystem through /proc/<pid>/root and
+// joins its cgroup, so the I/O is throttled and accounted like the workload's.
+func (r *ChaosExperimentReconciler) reconcileIOStressAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	spec := experiment.Spec.Attack.IOStress
+	if spec == nil {
+		spec = &chaosv1alpha1.IOStressAttackSpec{}
+	}
+	dir := spec.Path
+	if dir == "" {
+		dir = "/tmp"
+	}
+	if !path.IsAbs(dir) {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "attack.ioStress.path must be an absolute path.")
+	}
+	workers := spec.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	size := defaultIOStressSize
+	if spec.Size != nil && spec.Size.Value() > 0 {
+		size = *spec.Size
+	}
+	timeout := attackDuration(experiment, spec.Duration)
+
+	fio := fmt.Sprintf("exec fio --name=chaos-io-stress --directory=/proc/$pid/root%s --rw=randrw --bs=4k --direct=1 --unlink=1 --numjobs=%d --size=%d --time_based --runtime=%d",
+		path.Clean(dir), workers, size.Value(), attackSeconds(timeout))
+	if spec.IOPS != nil {
+		fio += fmt.Sprintf(" --rate_iops=%d", *spec.IOPS)
+	}
+	if spec.Bandwidth != nil && spec.Bandwidth.Value() > 0 {
+		fio += fmt.Sprintf(" --rate=%d", spec.Bandwidth.Value())
+	}
+
+	return r.reconcileContainerAttack(ctx, experiment, spec.ContainerName, func(containerID string) string {
+		return containerPIDsScript(containerID) + joinContainerCgroupScript() + fio + "\n"
+	}, "IOStressStarted", fmt.Sprintf("is under I/O load on %s from %d worker(s) for %s", dir, workers, timeout))
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileKubeletChaosAttack stops or restarts the kubelet on the node of a
+// randomly picked target pod. The helper pod enters the mount and PID
+// namespaces of the host init process and drives the kubelet through systemd,
+// so the attack requires nodes whose kubelet runs as a systemd unit.
+func (r *ChaosExperimentReconciler) reconcileKubeletChaosAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "KubeletChaos")
+
+	spec := experiment.Spec.Attack.KubeletChaos
+	if spec == nil {
+		spec = &chaosv1alpha1.KubeletChaosAttackSpec{}
+	}
+	action := spec.Action
+	if action == "" {
+		action = chaosv1alpha1.KubeletStop
+	}
+
+	target, result, err := r.pickTargetPod(ctx, experiment)
+	if target == nil {
+		return result, err
+	}
+	if target.Spec.NodeName == "" {
+		return r.failExperiment(ctx, experiment, "TargetNotScheduled", "The selected target pod is not scheduled to a node yet.")
+	}
+	// The policy hook has to see the node the attack acts on, not just the pod.
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, []chaosv1alpha1.AffectedTarget{nodeTarget(target.Spec.NodeName)}); !allowed {
+		return result, err
+	}
+
+	timeout := attackDuration(experiment, spec.Duration)
+	helper, err := r.runHelperPod(ctx, experiment, target, kubeletChaosScript(action, timeout))
+	if err != nil {
+		logger.Error(err, "Failed to create helper pod", "NodeName", target.Spec.NodeName)
+		failOnError(experiment, "HelperPodFailed", err)
+		experiment.Status.Message = "Failed to create helper pod for kubelet-chaos."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "HelperPodFailed", "Failed to create helper pod on node %s", target.Spec.NodeName)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after helper pod error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Kubelet chaos dispatched", "NodeName", target.Spec.NodeName, "Action", action, "HelperPod", helper.Name)
+	if action == chaosv1alpha1.KubeletRestart {
+		r.Recorder.Eventf(experiment, "Normal", "KubeletRestarted", "Kubelet on node %s was restarted.", target.Spec.NodeName)
+	} else {
+		r.Recorder.Eventf(experiment, "Normal", "KubeletStopped", "Kubelet on node %s was stopped for %s.", target.Spec.NodeName, timeout)
+	}
+
+	return r.completeAttackIteration(ctx, experiment, "Kubelet-chaos attack executed.", []chaosv1alpha1.AffectedTarget{nodeTarget(target.Spec.NodeName)})
+}
+
+// kubeletChaosScript returns the helper script for the given action. While the
+// kubelet is stopped it cannot deliver termination signals to the helper, so
+// the kubelet is always started again by the helper itself once the window
+// has elapsed.
+func kubeletChaosScript(action chaosv1alpha1.KubeletAction, d time.Duration) string {
+	const host = "nsenter -t 1 -m -u -i -n -p --"
+	if action == chaosv1alpha1.KubeletRestart {
+		return host + " systemctl restart kubelet\n"
+	}
+	return fmt.Sprintf(`trap '%[1]s systemctl start kubelet' EXIT
+trap 'exit 0' INT TERM
+%[1]s systemctl stop kubelet
+sleep %[2]d & wait
+`, host, attackSeconds(d))
+}
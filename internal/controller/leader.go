@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// filterPodsByRole keeps the leader or the followers among the pods, as asked
+// for by target.role. Without a current leader, e.g. while the Lease is
+// missing or expired, every pod is a follower.
+func (r *ChaosExperimentReconciler) filterPodsByRole(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, pods []corev1.Pod) ([]corev1.Pod, error) {
+	election := experiment.Spec.Target.LeaderElection
+	if election == nil {
+		return pods, nil
+	}
+
+	holder := ""
+	if election.LeaseName != "" {
+		lease := &coordinationv1.Lease{}
+		err := r.Get(ctx, client.ObjectKey{Namespace: experiment.Spec.Target.Namespace, Name: election.LeaseName}, lease)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil && lease.Spec.HolderIdentity != nil && !leaseExpired(lease) {
+			holder = *lease.Spec.HolderIdentity
+		}
+	}
+
+	wantLeader := experiment.Spec.Target.Role == chaosv1alpha1.LeaderRole
+	kept := pods[:0]
+	for _, pod := range pods {
+		if isLeader(&pod, election, holder) == wantLeader {
+			kept = append(kept, pod)
+		}
+	}
+	return kept, nil
+}
+
+// isLeader reports whether the pod is the leader, given the holder identity of
+// the leader-election Lease when one is used.
+func isLeader(pod *corev1.Pod, election *chaosv1alpha1.LeaderElection, holder string) bool {
+	if election.LeaseName != "" {
+		return holder != "" && (holder == pod.Name || strings.HasPrefix(holder, pod.Name+"_"))
+	}
+	value, ok := pod.Annotations[election.Annotation]
+	if !ok {
+		return false
+	}
+	if election.AnnotationValue == "" {
+		return value == "true"
+	}
+	return value == election.AnnotationValue
+}
+
+// leaseExpired reports whether the holder of the Lease failed to renew it in
+// time. Leases that do not record their renewal are taken at face value.
+func leaseExpired(lease *coordinationv1.Lease) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return false
+	}
+	return time.Since(lease.Spec.RenewTime.Time) > time.Duration(*lease.Spec.LeaseDurationSeconds)*time.Second
+}
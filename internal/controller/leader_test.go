@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Leader-aware targeting", func() {
+	It("should find the leader through the Lease holder", func() {
+		election := &chaosv1alpha1.LeaderElection{LeaseName: "db-leader"}
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-1"}}
+		Expect(isLeader(pod, election, "db-1")).To(BeTrue())
+		Expect(isLeader(pod, election, "db-1_6f1c2b7e")).To(BeTrue())
+		Expect(isLeader(pod, election, "db-10")).To(BeFalse())
+		Expect(isLeader(pod, election, "")).To(BeFalse())
+	})
+
+	It("should find the leader through an annotation", func() {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-0",
+			Annotations: map[string]string{"example.com/leader": "true", "example.com/role": "primary"}}}
+		Expect(isLeader(pod, &chaosv1alpha1.LeaderElection{Annotation: "example.com/leader"}, "")).To(BeTrue())
+		Expect(isLeader(pod, &chaosv1alpha1.LeaderElection{Annotation: "example.com/role", AnnotationValue: "primary"}, "")).To(BeTrue())
+		Expect(isLeader(pod, &chaosv1alpha1.LeaderElection{Annotation: "example.com/role"}, "")).To(BeFalse())
+		Expect(isLeader(pod, &chaosv1alpha1.LeaderElection{Annotation: "example.com/missing"}, "")).To(BeFalse())
+	})
+
+	It("should ignore expired leases", func() {
+		lease := &coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To("db-1"),
+			LeaseDurationSeconds: ptr.To[int32](15),
+			RenewTime:            ptr.To(metav1.NewMicroTime(time.Now().Add(-time.Minute))),
+		}}
+		Expect(leaseExpired(lease)).To(BeTrue())
+		lease.Spec.RenewTime = ptr.To(metav1.NowMicro())
+		Expect(leaseExpired(lease)).To(BeFalse())
+	})
+})
@@ -0,0 +1,46 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileMemoryStressAttack allocates and holds memory inside the cgroup of
+// a target container. Allocations beyond the container's memory limit trigger
+// the OOM killer for that container, just like a leak in the workload would.
+func (r *ChaosExperimentReconciler) reconcileMemoryStressAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	spec := experiment.Spec.Attack.MemoryStress
+	if spec == nil {
+		spec = &chaosv1alpha1.MemoryStressAttackSpec{}
+	}
+	size := spec.Size.Value()
+	if size <= 0 {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "memory-stress requires a positive attack.memoryStress.size.")
+	}
+	timeout := attackDuration(experiment, spec.Duration)
+
+	return r.reconcileContainerAttack(ctx, experiment, spec.ContainerName, func(containerID string) string {
+		return containerPIDsScript(containerID) + joinContainerCgroupScript() +
+			fmt.Sprintf("exec stress-ng --vm 1 --vm-bytes %d --vm-keep --timeout %ds\n", size, attackSeconds(timeout))
+	}, "MemoryStressStarted", fmt.Sprintf("is holding %s of extra memory for %s", spec.Size.String(), timeout))
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileNetworkChaosAttack degrades the network of every target pod with a
+// netem qdisc on its interface: latency, jitter, loss, corruption,
+// duplication and reordering. For each target a helper pod installs the qdisc
+// in the target's network namespace and removes it again on exit.
+func (r *ChaosExperimentReconciler) reconcileNetworkChaosAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "NetworkChaos")
+
+	spec := experiment.Spec.Attack.NetworkChaos
+	if spec == nil {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "network-chaos requires attack.networkChaos.")
+	}
+	netem, err := netemArgs(spec)
+	if err != nil {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", err.Error())
+	}
+	iface := spec.Interface
+	if iface == "" {
+		iface = "eth0"
+	}
+
+	targets, result, err := r.listTargetPods(ctx, experiment)
+	if len(targets) == 0 {
+		return result, err
+	}
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, podTargets(targets)); !allowed {
+		return result, err
+	}
+
+	timeout := attackDuration(experiment, spec.Duration)
+	// Record the faults before any helper pod installs them, so that they are
+	// removed even if a helper pod dies without running its exit trap.
+	var ready []*corev1.Pod
+	var scripts []string
+	var faults []chaosv1alpha1.InjectedFault
+	for i := range targets {
+		target := &targets[i]
+		container, err := targetContainerStatus(target, "")
+		if err != nil {
+			logger.Info("Skipping target pod without a running container", "PodName", target.Name, "Reason", err.Error())
+			continue
+		}
+		fault, err := networkFault(chaosv1alpha1.NetworkChaosAttack, target, iface, timeout)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		ready = append(ready, target)
+		scripts = append(scripts, containerPIDsScript(runtimeContainerID(container.ContainerID))+netemScript(iface, netem, timeout))
+		faults = append(faults, fault)
+	}
+	if len(faults) > 0 {
+		if err := r.recordFault(ctx, experiment, faults...); err != nil {
+			logger.Error(err, "Failed to record network faults in ChaosExperiment status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	var degraded []chaosv1alpha1.AffectedTarget
+	for i, target := range ready {
+		if _, err := r.runHelperPod(ctx, experiment, target, scripts[i]); err != nil {
+			logger.Error(err, "Failed to create helper pod", "PodName", target.Name)
+			failOnError(experiment, "HelperPodFailed", err)
+			experiment.Status.Message = "Failed to create helper pod for network-chaos."
+			r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+			r.Recorder.Eventf(experiment, "Warning", "HelperPodFailed", "Failed to create helper pod for %s/%s", target.Namespace, target.Name)
+			if err := r.patchStatus(ctx, experiment); err != nil {
+				logger.Error(err, "Failed to update ChaosExperiment status to Failed after helper pod error")
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		degraded = append(degraded, podTarget(target))
+	}
+	if len(degraded) == 0 {
+		return r.failExperiment(ctx, experiment, "NoRunningTargets", "None of the target pods has a running container to degrade.")
+	}
+
+	logger.Info("Network chaos dispatched", "Targets", len(degraded), "Netem", netem, "Duration", timeout)
+	r.Recorder.Eventf(experiment, "Normal", "NetworkDegraded", "%d target pod(s) in %s degraded with netem %q for %s.",
+		len(degraded), experiment.Spec.Target.Namespace, netem, timeout)
+
+	return r.completeAttackIteration(ctx, experiment, "Network-chaos attack executed.", degraded)
+}
+
+// netemArgs returns the netem options for the spec.
+func netemArgs(spec *chaosv1alpha1.NetworkChaosAttackSpec) (string, error) {
+	var args []string
+	latency := spec.Latency != nil && spec.Latency.Duration > 0
+	if latency {
+		delay := fmt.Sprintf("delay %dms", spec.Latency.Milliseconds())
+		if spec.Jitter != nil && spec.Jitter.Duration > 0 {
+			delay += fmt.Sprintf(" %dms", spec.Jitter.Milliseconds())
+		}
+		args = append(args, delay)
+	}
+	for _, option := range []struct {
+		name    string
+		percent int32
+	}{
+		{"loss", spec.Loss},
+		{"corrupt", spec.Corrupt},
+		{"duplicate", spec.Duplicate},
+		{"reorder", spec.Reorder},
+	} {
+		if option.percent > 0 {
+			args = append(args, fmt.Sprintf("%s %d%%", option.name, option.percent))
+		}
+	}
+	if spec.Reorder > 0 && !latency {
+		return "", fmt.Errorf("attack.networkChaos.reorder requires latency to be set")
+	}
+	if len(args) == 0 {
+		return "", fmt.Errorf("attack.networkChaos must set at least one of latency, loss, corrupt, duplicate or reorder")
+	}
+	return strings.Join(args, " "), nil
+}
+
+// netemScript returns a shell snippet that installs a netem qdisc with the
+// given options on the interface in the network namespace of the first
+// process in $pids for the given duration, and removes it on exit.
+func netemScript(iface, netem string, d time.Duration) string {
+	return fmt.Sprintf(`pid=${pids%%%%[[:space:]]*}
+trap 'nsenter -t $pid -n tc qdisc del dev %[1]s root' EXIT
+trap 'exit 0' INT TERM
+nsenter -t $pid -n tc qdisc replace dev %[1]s root netem %[2]s
+sleep %[3]d & wait
+`, shellQuote(iface), netem, int64(d.Seconds()))
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Netem options", func() {
+	It("should combine latency with the packet percentages", func() {
+		args, err := netemArgs(&chaosv1alpha1.NetworkChaosAttackSpec{
+			Latency:   &metav1.Duration{Duration: 100 * time.Millisecond},
+			Jitter:    &metav1.Duration{Duration: 20 * time.Millisecond},
+			Loss:      5,
+			Corrupt:   1,
+			Duplicate: 2,
+			Reorder:   25,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).To(Equal("delay 100ms 20ms loss 5% corrupt 1% duplicate 2% reorder 25%"))
+	})
+
+	It("should reject reordering without latency", func() {
+		_, err := netemArgs(&chaosv1alpha1.NetworkChaosAttackSpec{Reorder: 25})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a spec without any effect", func() {
+		_, err := netemArgs(&chaosv1alpha1.NetworkChaosAttackSpec{})
+		Expect(err).To(HaveOccurred())
+	})
+})
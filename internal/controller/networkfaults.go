@@ -0,0 +1,230 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const (
+	// helperExitGrace is how long after the end of its window a helper pod is
+	// given to remove its network fault before the operator checks on it.
+	helperExitGrace = 30 * time.Second
+	// revertPollInterval is how often a revert that is under way is checked on.
+	revertPollInterval = 5 * time.Second
+)
+
+// errRevertPending is returned by revertFault while the revert is under way,
+// e.g. while a helper pod is still removing its fault.
+var errRevertPending = fmt.Errorf("revert is still in progress")
+
+// networkFaultOriginal is stored in InjectedFault.Original for the faults the
+// network-chaos and network-partition attacks install in the network namespace
+// of a target pod. The helper pod removes its fault when it exits; the record
+// is what allows the operator to remove it when the helper could not, e.g.
+// because it was killed outright or never got to run its exit trap.
+type networkFaultOriginal struct {
+	// PodUID tells the target pod apart from a later pod of the same name,
+	// which has a network namespace of its own.
+	PodUID types.UID `json:"podUID"`
+	// Interface is the interface carrying the netem qdisc, for network-chaos.
+	Interface string `json:"interface,omitempty"`
+}
+
+// networkFault returns the fault record for a helper pod that degrades the
+// network of the target pod for d.
+func networkFault(attack chaosv1alpha1.AttackType, target *corev1.Pod, iface string, d time.Duration) (chaosv1alpha1.InjectedFault, error) {
+	encoded, err := json.Marshal(networkFaultOriginal{PodUID: target.UID, Interface: iface})
+	if err != nil {
+		return chaosv1alpha1.InjectedFault{}, err
+	}
+	now := metav1.Now()
+	return chaosv1alpha1.InjectedFault{
+		Attack:     attack,
+		Kind:       "Pod",
+		Namespace:  target.Namespace,
+		Name:       target.Name,
+		Original:   string(encoded),
+		InjectedAt: now,
+		RevertAt:   metav1.NewTime(now.Add(d + helperExitGrace)),
+	}, nil
+}
+
+// revertNetworkFault makes sure the network fault recorded for a target pod is
+// gone. Helper pods that are still running are stopped, which makes them
+// remove their fault. If none of them finished cleanly, a cleanup pod removes
+// whatever is left of the fault in the network namespace of the target.
+func (r *ChaosExperimentReconciler) revertNetworkFault(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, fault chaosv1alpha1.InjectedFault) error {
+	logger := log.FromContext(ctx)
+
+	original := networkFaultOriginal{}
+	if err := json.Unmarshal([]byte(fault.Original), &original); err != nil {
+		return err
+	}
+
+	target := &corev1.Pod{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: fault.Namespace, Name: fault.Name}, target); err != nil {
+		if errors.IsNotFound(err) {
+			// The pod is gone, so is its network namespace.
+			return nil
+		}
+		return err
+	}
+	if target.UID != original.PodUID {
+		return nil
+	}
+
+	helpers, err := r.faultHelperPods(ctx, experiment, fault, false)
+	if err != nil {
+		return err
+	}
+	clean := len(helpers) > 0
+	pending := false
+	for i := range helpers {
+		helper := &helpers[i]
+		switch helper.Status.Phase {
+		case corev1.PodSucceeded:
+			continue
+		case corev1.PodFailed:
+			clean = false
+			continue
+		}
+		pending = true
+		if helper.DeletionTimestamp != nil {
+			continue
+		}
+		// Helper pods remove their fault when they are terminated.
+		if err := r.Delete(ctx, helper); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if pending {
+		return errRevertPending
+	}
+	if clean {
+		return nil
+	}
+
+	cleanups, err := r.faultHelperPods(ctx, experiment, fault, true)
+	if err != nil {
+		return err
+	}
+	for _, cleanup := range cleanups {
+		if cleanup.Status.Phase == corev1.PodSucceeded {
+			return r.deleteCleanupPods(ctx, cleanups)
+		}
+	}
+	for _, cleanup := range cleanups {
+		if cleanup.Status.Phase == corev1.PodFailed {
+			// Start over with a new cleanup pod on the next attempt.
+			if err := r.deleteCleanupPods(ctx, cleanups); err != nil {
+				return err
+			}
+			return fmt.Errorf("cleanup pod %s failed", cleanup.Name)
+		}
+	}
+	if len(cleanups) > 0 {
+		return errRevertPending
+	}
+
+	container, err := targetContainerStatus(target, "")
+	if err != nil {
+		return err
+	}
+	pod, err := r.helperPodFor(experiment, target, containerPIDsScript(runtimeContainerID(container.ContainerID))+networkCleanupScript(fault.Attack, original))
+	if err != nil {
+		return err
+	}
+	pod.GenerateName = fmt.Sprintf("%s-cleanup-", experiment.Name)
+	pod.Labels[AttackTypeLabel] = string(fault.Attack)
+	pod.Labels[CleanupLabel] = "true"
+	delete(pod.Labels, IterationLabel)
+	if err := r.Create(ctx, pod); err != nil {
+		return err
+	}
+	logger.Info("Started cleanup pod for network fault left behind", "Attack", fault.Attack, "PodName", target.Name, "CleanupPod", pod.Name)
+	r.Recorder.Eventf(experiment, "Normal", "FaultCleanupStarted", "No helper pod removed %s from pod %s, started %s to remove it.", fault.Attack, faultObjectName(fault), pod.Name)
+	return errRevertPending
+}
+
+// faultHelperPods returns the helper pods that the experiment started against
+// the target of the fault, or the cleanup pods started for it.
+func (r *ChaosExperimentReconciler) faultHelperPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, fault chaosv1alpha1.InjectedFault, cleanup bool) ([]corev1.Pod, error) {
+	operator := selection.DoesNotExist
+	if cleanup {
+		operator = selection.Exists
+	}
+	isCleanup, err := labels.NewRequirement(CleanupLabel, operator, nil)
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.SelectorFromSet(labels.Set{
+		ExperimentLabel: experiment.Name,
+		AttackTypeLabel: string(fault.Attack),
+		TargetPodLabel:  fault.Name,
+	}).Add(*isCleanup)
+
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(experiment.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+// deleteCleanupPods deletes cleanup pods that have done their job.
+func (r *ChaosExperimentReconciler) deleteCleanupPods(ctx context.Context, pods []corev1.Pod) error {
+	for i := range pods {
+		if err := r.Delete(ctx, &pods[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// networkCleanupScript returns a shell snippet that removes what the attack
+// installs in the network namespace of the first process in $pids: the netem
+// qdisc of network-chaos or the DROP rules of network-partition. It does
+// nothing if they are already gone.
+func networkCleanupScript(attack chaosv1alpha1.AttackType, original networkFaultOriginal) string {
+	if attack == chaosv1alpha1.NetworkPartitionAttack {
+		return fmt.Sprintf(`pid=${pids%%%%[[:space:]]*}
+for cmd in iptables ip6tables; do
+  rules=$(nsenter -t $pid -n $cmd-save) || continue
+  echo "$rules" | grep -v -- %[1]s | nsenter -t $pid -n $cmd-restore
+done
+`, shellQuote("--comment "+partitionRuleComment))
+	}
+	return fmt.Sprintf(`pid=${pids%%%%[[:space:]]*}
+if nsenter -t $pid -n tc qdisc show dev %[1]s root | grep -q netem; then
+  nsenter -t $pid -n tc qdisc del dev %[1]s root
+fi
+`, shellQuote(original.Interface))
+}
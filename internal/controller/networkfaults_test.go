@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// networkFaultClient serves the target pod and helper pods of a network fault
+// and records the pods created and deleted.
+type networkFaultClient struct {
+	*helperPodLister
+	target  *corev1.Pod
+	created []*corev1.Pod
+	deleted []string
+}
+
+func (c *networkFaultClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if c.target == nil || c.target.Name != key.Name {
+		return errors.NewNotFound(schema.GroupResource{Resource: "pods"}, key.Name)
+	}
+	c.target.DeepCopyInto(obj.(*corev1.Pod))
+	return nil
+}
+
+func (c *networkFaultClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	c.created = append(c.created, obj.(*corev1.Pod))
+	return nil
+}
+
+func (c *networkFaultClient) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	c.deleted = append(c.deleted, obj.GetName())
+	return nil
+}
+
+var _ = Describe("Network faults", func() {
+	var c *networkFaultClient
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+	var fault chaosv1alpha1.InjectedFault
+
+	BeforeEach(func() {
+		c = &networkFaultClient{helperPodLister: &helperPodLister{statusRecorder: &statusRecorder{}}}
+		c.target = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default", UID: "web-0-uid"},
+			Spec:       corev1.PodSpec{NodeName: "node-1", Containers: []corev1.Container{{Name: "web"}}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+				Name:        "web",
+				ContainerID: "containerd://abc",
+				State:       corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}}},
+		}
+		scheme := runtime.NewScheme()
+		Expect(chaosv1alpha1.AddToScheme(scheme)).To(Succeed())
+		r = &ChaosExperimentReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "latency", Namespace: "chaos"}}
+		experiment.Spec.Attack.Type = chaosv1alpha1.NetworkChaosAttack
+		experiment.Spec.Target.Namespace = "default"
+
+		var err error
+		fault, err = networkFault(chaosv1alpha1.NetworkChaosAttack, c.target, "eth0", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	helperIn := func(phase corev1.PodPhase) corev1.Pod {
+		pod, err := r.helperPodFor(experiment, c.target, "true")
+		Expect(err).NotTo(HaveOccurred())
+		pod.Name = "helper-" + string(phase)
+		pod.Status.Phase = phase
+		return *pod
+	}
+
+	It("should be recorded for after the helper pod's window", func() {
+		Expect(fault.Kind).To(Equal("Pod"))
+		Expect(fault.Namespace).To(Equal("default"))
+		Expect(fault.Name).To(Equal("web-0"))
+		Expect(fault.RevertAt.Sub(fault.InjectedAt.Time)).To(Equal(time.Minute + helperExitGrace))
+	})
+
+	It("should be reverted once the helper pod removed it", func() {
+		c.helpers = []corev1.Pod{helperIn(corev1.PodSucceeded)}
+
+		Expect(r.revertNetworkFault(context.Background(), experiment, fault)).To(Succeed())
+		Expect(c.created).To(BeEmpty())
+	})
+
+	It("should stop helper pods that are still running", func() {
+		c.helpers = []corev1.Pod{helperIn(corev1.PodSucceeded), helperIn(corev1.PodRunning)}
+
+		Expect(r.revertNetworkFault(context.Background(), experiment, fault)).To(Equal(errRevertPending))
+		Expect(c.deleted).To(Equal([]string{"helper-Running"}))
+		Expect(c.created).To(BeEmpty())
+	})
+
+	It("should start a cleanup pod when no helper pod removed it", func() {
+		c.helpers = []corev1.Pod{helperIn(corev1.PodFailed)}
+
+		Expect(r.revertNetworkFault(context.Background(), experiment, fault)).To(Equal(errRevertPending))
+		Expect(c.created).To(HaveLen(1))
+		cleanup := c.created[0]
+		Expect(cleanup.Labels).To(HaveKeyWithValue(CleanupLabel, "true"))
+		Expect(cleanup.Labels).To(HaveKeyWithValue(TargetPodLabel, "web-0"))
+		Expect(cleanup.Labels).NotTo(HaveKey(IterationLabel))
+		Expect(cleanup.Spec.NodeName).To(Equal("node-1"))
+		Expect(cleanup.Spec.Containers[0].Command[2]).To(ContainSubstring("tc qdisc del dev 'eth0' root"))
+	})
+
+	It("should be reverted once the cleanup pod succeeded", func() {
+		cleanup := helperIn(corev1.PodSucceeded)
+		cleanup.Labels[CleanupLabel] = "true"
+		c.helpers = []corev1.Pod{helperIn(corev1.PodFailed), cleanup}
+
+		Expect(r.revertNetworkFault(context.Background(), experiment, fault)).To(Succeed())
+		Expect(c.deleted).To(Equal([]string{cleanup.Name}))
+		Expect(c.created).To(BeEmpty())
+	})
+
+	It("should be reverted when the target pod is gone or was replaced", func() {
+		c.helpers = []corev1.Pod{helperIn(corev1.PodFailed)}
+		c.target.UID = "web-0-new-uid"
+		Expect(r.revertNetworkFault(context.Background(), experiment, fault)).To(Succeed())
+
+		c.target = nil
+		Expect(r.revertNetworkFault(context.Background(), experiment, fault)).To(Succeed())
+		Expect(c.created).To(BeEmpty())
+	})
+
+	It("should remove only the partition rules", func() {
+		script := networkCleanupScript(chaosv1alpha1.NetworkPartitionAttack, networkFaultOriginal{})
+		Expect(script).To(ContainSubstring("grep -v -- '--comment " + partitionRuleComment + "'"))
+	})
+})
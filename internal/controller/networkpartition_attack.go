@@ -0,0 +1,189 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// partitionRuleComment tags the iptables rules installed by the
+// network-partition attack so they can be told apart from anything else.
+const partitionRuleComment = "chaos.shanto.dev/network-partition"
+
+// reconcileNetworkPartitionAttack cuts every target pod off from every peer
+// pod. For each target a helper pod installs DROP rules for the peer addresses
+// in the target's network namespace and removes them again when the partition
+// window ends or the helper pod is terminated, e.g. because the experiment was
+// deleted and its helper pods were garbage collected.
+func (r *ChaosExperimentReconciler) reconcileNetworkPartitionAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "NetworkPartition")
+
+	spec := experiment.Spec.Attack.Partition
+	if spec == nil {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "network-partition requires attack.partition.peerSelector.")
+	}
+	peerSelector, err := metav1.LabelSelectorAsSelector(&spec.PeerSelector)
+	if err != nil {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", fmt.Sprintf("Invalid attack.partition.peerSelector: %v", err))
+	}
+	if peerSelector.Empty() {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "attack.partition.peerSelector must not be empty.")
+	}
+
+	targets, result, err := r.listTargetPods(ctx, experiment)
+	if len(targets) == 0 {
+		return result, err
+	}
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, podTargets(targets)); !allowed {
+		return result, err
+	}
+
+	peerNamespace := spec.PeerNamespace
+	if peerNamespace == "" {
+		peerNamespace = experiment.Spec.Target.Namespace
+	}
+	peers := &corev1.PodList{}
+	if err := r.targets(ctx).List(ctx, peers, client.InNamespace(peerNamespace), client.MatchingLabelsSelector{Selector: peerSelector}); err != nil {
+		logger.Error(err, "Failed to list peer pods", "Namespace", peerNamespace, "PeerSelector", peerSelector.String())
+		failOnError(experiment, "PodListFailed", err)
+		experiment.Status.Message = "Failed to list peer pods."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Event(experiment, "Warning", "PodListFailed", "Failed to list peer pods.")
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after peer listing error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	peerIPs := partitionPeerIPs(targets, peers.Items)
+	if len(peerIPs) == 0 {
+		logger.Info("No peer pods found for network partition", "Namespace", peerNamespace, "PeerSelector", peerSelector.String())
+		applyFailurePolicy(experiment, "NoPeerPods")
+		experiment.Status.Message = "No peer pods found matching the peer selector."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Event(experiment, "Warning", "NoPeerPods", "No peer pods found for the network partition.")
+		retryAfter := countFailure(experiment)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after no peers found")
+		}
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	timeout := attackDuration(experiment, spec.Duration)
+	// Record the faults before any helper pod installs them, so that they are
+	// removed even if a helper pod dies without running its exit trap.
+	var ready []*corev1.Pod
+	var scripts []string
+	var faults []chaosv1alpha1.InjectedFault
+	for i := range targets {
+		target := &targets[i]
+		container, err := targetContainerStatus(target, "")
+		if err != nil {
+			logger.Info("Skipping target pod without a running container", "PodName", target.Name, "Reason", err.Error())
+			continue
+		}
+		fault, err := networkFault(chaosv1alpha1.NetworkPartitionAttack, target, "", timeout)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		ready = append(ready, target)
+		scripts = append(scripts, containerPIDsScript(runtimeContainerID(container.ContainerID))+partitionScript(peerIPs, timeout))
+		faults = append(faults, fault)
+	}
+	if len(faults) > 0 {
+		if err := r.recordFault(ctx, experiment, faults...); err != nil {
+			logger.Error(err, "Failed to record network faults in ChaosExperiment status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	var partitioned []chaosv1alpha1.AffectedTarget
+	for i, target := range ready {
+		if _, err := r.runHelperPod(ctx, experiment, target, scripts[i]); err != nil {
+			logger.Error(err, "Failed to create helper pod", "PodName", target.Name)
+			failOnError(experiment, "HelperPodFailed", err)
+			experiment.Status.Message = "Failed to create helper pod for network-partition."
+			r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+			r.Recorder.Eventf(experiment, "Warning", "HelperPodFailed", "Failed to create helper pod for %s/%s", target.Namespace, target.Name)
+			if err := r.patchStatus(ctx, experiment); err != nil {
+				logger.Error(err, "Failed to update ChaosExperiment status to Failed after helper pod error")
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		partitioned = append(partitioned, podTarget(target))
+	}
+	if len(partitioned) == 0 {
+		return r.failExperiment(ctx, experiment, "NoRunningTargets", "None of the target pods has a running container to partition.")
+	}
+
+	logger.Info("Network partition dispatched", "Targets", len(partitioned), "PeerAddresses", len(peerIPs), "Duration", timeout)
+	r.Recorder.Eventf(experiment, "Normal", "NetworkPartitioned", "%d target pod(s) in %s cut off from %d peer address(es) in %s for %s.",
+		len(partitioned), experiment.Spec.Target.Namespace, len(peerIPs), peerNamespace, timeout)
+
+	return r.completeAttackIteration(ctx, experiment, "Network-partition attack executed.", partitioned)
+}
+
+// partitionPeerIPs returns the addresses of all peers that are not targets
+// themselves. A pod matching both selectors cannot be partitioned from itself.
+func partitionPeerIPs(targets, peers []corev1.Pod) []string {
+	isTarget := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		isTarget[string(target.UID)] = true
+	}
+
+	var ips []string
+	for _, peer := range peers {
+		if isTarget[string(peer.UID)] || peer.Spec.HostNetwork {
+			continue
+		}
+		for _, ip := range peer.Status.PodIPs {
+			ips = append(ips, ip.IP)
+		}
+	}
+	return ips
+}
+
+// partitionScript returns a shell snippet that drops traffic to and from the
+// peer addresses in the network namespace of the first process in $pids for
+// the given duration. The rules are removed on exit, including when the
+// helper pod is asked to terminate early.
+func partitionScript(peerIPs []string, d time.Duration) string {
+	var b strings.Builder
+	b.WriteString("pid=${pids%%[[:space:]]*}\npartition() {\n")
+	for _, ip := range peerIPs {
+		cmd := "iptables"
+		if strings.Contains(ip, ":") {
+			cmd = "ip6tables"
+		}
+		fmt.Fprintf(&b, "  nsenter -t $pid -n %s -$1 INPUT -s %s -m comment --comment %s -j DROP\n", cmd, ip, partitionRuleComment)
+		fmt.Fprintf(&b, "  nsenter -t $pid -n %s -$1 OUTPUT -d %s -m comment --comment %s -j DROP\n", cmd, ip, partitionRuleComment)
+	}
+	b.WriteString("}\ntrap 'partition D' EXIT\ntrap 'exit 0' INT TERM\npartition I\n")
+	fmt.Fprintf(&b, "sleep %d & wait\n", int64(d.Seconds()))
+	return b.String()
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// defaultNodeTaintKey is the taint key used when attack.nodeTaint.key is not set.
+const defaultNodeTaintKey = "chaos.shanto.dev/node-taint"
+
+// nodeTaintOriginal is stored in InjectedFault.Original for the node-taint
+// attack. It only records what the attack changed, so that a taint or cordon
+// that was already in place before the experiment is left alone on revert.
+// CordonApplied is set when the cordon was server-side applied; faults
+// recorded before cordons were applied are reverted with a patch.
+type nodeTaintOriginal struct {
+	Taint         *corev1.Taint `json:"taint,omitempty"`
+	Cordoned      bool          `json:"cordoned,omitempty"`
+	CordonApplied bool          `json:"cordonApplied,omitempty"`
+}
+
+// reconcileNodeTaintAttack taints, and optionally cordons, the node of a
+// randomly picked target pod. Unless the effect is NoExecute, pods already
+// running on the node are left alone, which makes it possible to validate
+// scheduling and tolerations without evicting anything.
+func (r *ChaosExperimentReconciler) reconcileNodeTaintAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "NodeTaint")
+
+	spec := experiment.Spec.Attack.NodeTaint
+	if spec == nil {
+		spec = &chaosv1alpha1.NodeTaintAttackSpec{}
+	}
+	taint := corev1.Taint{Key: spec.Key, Value: spec.Value, Effect: spec.Effect}
+	if taint.Key == "" {
+		taint.Key = defaultNodeTaintKey
+	}
+	if taint.Effect == "" {
+		taint.Effect = corev1.TaintEffectNoSchedule
+	}
+
+	target, result, err := r.pickTargetPod(ctx, experiment)
+	if target == nil {
+		return result, err
+	}
+	if target.Spec.NodeName == "" {
+		return r.failExperiment(ctx, experiment, "TargetNotScheduled", "The selected target pod is not scheduled to a node yet.")
+	}
+	// The policy hook has to see the node the attack acts on, not just the pod.
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, []chaosv1alpha1.AffectedTarget{nodeTarget(target.Spec.NodeName)}); !allowed {
+		return result, err
+	}
+
+	node := &corev1.Node{}
+	if err := r.Get(ctx, client.ObjectKey{Name: target.Spec.NodeName}, node); err != nil {
+		logger.Error(err, "Failed to get target node", "NodeName", target.Spec.NodeName)
+		failOnError(experiment, "NodeGetFailed", err)
+		experiment.Status.Message = "Failed to get target node."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "NodeGetFailed", "Failed to get node %s", target.Spec.NodeName)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after node lookup error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	// Work out what has to change, remembering only what the attack itself
+	// adds so that revert never removes pre-existing taints or cordons.
+	// Taints are an atomic list that server-side apply would take over as a
+	// whole, so they are patched; the cordon is applied.
+	patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	original := nodeTaintOriginal{}
+	if !nodeHasTaint(node, taint) {
+		node.Spec.Taints = append(node.Spec.Taints, taint)
+		original.Taint = &taint
+	}
+	if spec.Cordon && !node.Spec.Unschedulable {
+		original.Cordoned = true
+		original.CordonApplied = true
+	}
+
+	now := metav1.Now()
+	revertAt := metav1.NewTime(now.Add(attackDuration(experiment, spec.Duration)))
+	existing := findFault(experiment, chaosv1alpha1.NodeTaintAttack, "Node", "", node.Name)
+	if existing == nil && original.Taint == nil && !original.Cordoned {
+		logger.Info("Node is already tainted, nothing to inject", "NodeName", node.Name)
+		r.Recorder.Eventf(experiment, "Normal", "NodeAlreadyTainted", "Node %s already carries taint %s, leaving it alone.", node.Name, taint.ToString())
+		return r.completeAttackIteration(ctx, experiment, "Node-taint attack executed.", []chaosv1alpha1.AffectedTarget{nodeTarget(node.Name)})
+	}
+
+	encoded, err := json.Marshal(original)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+		Attack:     chaosv1alpha1.NodeTaintAttack,
+		Kind:       "Node",
+		Name:       node.Name,
+		Original:   string(encoded),
+		InjectedAt: now,
+		RevertAt:   revertAt,
+	}); err != nil {
+		logger.Error(err, "Failed to record node taint in ChaosExperiment status", "NodeName", node.Name)
+		return ctrl.Result{}, err
+	}
+
+	if err := r.taintNode(ctx, node, patch, original); err != nil {
+		logger.Error(err, "Failed to taint node", "NodeName", node.Name)
+		failOnError(experiment, "NodeTaintFailed", err)
+		experiment.Status.Message = "Failed to taint target node."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "NodeTaintFailed", "Failed to taint node %s", node.Name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after node taint error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Tainted node", "NodeName", node.Name, "Taint", taint.ToString(), "Cordon", spec.Cordon, "RevertAt", revertAt)
+	r.Recorder.Eventf(experiment, "Normal", "NodeTainted", "Node %s was tainted with %s until %s.", node.Name, taint.ToString(), revertAt.Format(time.RFC3339))
+
+	return r.completeAttackIteration(ctx, experiment, "Node-taint attack executed.", []chaosv1alpha1.AffectedTarget{nodeTarget(node.Name)})
+}
+
+// taintNode adds the taint and the cordon the attack adds to the node.
+func (r *ChaosExperimentReconciler) taintNode(ctx context.Context, node *corev1.Node, patch client.Patch, original nodeTaintOriginal) error {
+	if original.Taint != nil {
+		if err := r.targets(ctx).Patch(ctx, node, patch); err != nil {
+			return err
+		}
+	}
+	if original.Cordoned {
+		return r.applyFault(ctx, corev1ac.Node(node.Name).WithSpec(corev1ac.NodeSpec().WithUnschedulable(true)))
+	}
+	return nil
+}
+
+// revertNodeTaint removes the taint and cordon recorded in the fault from the node.
+func (r *ChaosExperimentReconciler) revertNodeTaint(ctx context.Context, fault chaosv1alpha1.InjectedFault) error {
+	original := nodeTaintOriginal{}
+	if err := json.Unmarshal([]byte(fault.Original), &original); err != nil {
+		return err
+	}
+
+	node := &corev1.Node{}
+	if err := r.Get(ctx, client.ObjectKey{Name: fault.Name}, node); err != nil {
+		if errors.IsNotFound(err) {
+			// The node is gone, so is the taint.
+			return nil
+		}
+		return err
+	}
+
+	patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	if original.Taint != nil {
+		taints := node.Spec.Taints[:0]
+		for _, t := range node.Spec.Taints {
+			if !t.MatchTaint(original.Taint) {
+				taints = append(taints, t)
+			}
+		}
+		node.Spec.Taints = taints
+	}
+	if original.Cordoned && !original.CordonApplied {
+		node.Spec.Unschedulable = false
+	}
+	if err := r.Patch(ctx, node, patch); err != nil {
+		return err
+	}
+	if original.CordonApplied {
+		// The cordon stays if another manager has set it as well.
+		return r.releaseFault(ctx, corev1ac.Node(node.Name))
+	}
+	return nil
+}
+
+// nodeHasTaint reports whether the node already carries a taint with the same
+// key and effect.
+func nodeHasTaint(node *corev1.Node, taint corev1.Taint) bool {
+	for i := range node.Spec.Taints {
+		if node.Spec.Taints[i].MatchTaint(&taint) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// nodeClient serves a single node and records the writes made to it.
+type nodeClient struct {
+	client.Client
+	node    *corev1.Node
+	patches []string
+	applies []string
+	owners  []string
+}
+
+func (c *nodeClient) Get(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	c.node.DeepCopyInto(obj.(*corev1.Node))
+	return nil
+}
+
+func (c *nodeClient) Patch(_ context.Context, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+	data, err := patch.Data(obj)
+	c.patches = append(c.patches, string(data))
+	return err
+}
+
+func (c *nodeClient) Apply(_ context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	data, err := json.Marshal(obj)
+	c.applies = append(c.applies, string(data))
+	applyOpts := &client.ApplyOptions{}
+	applyOpts.ApplyOptions(opts)
+	c.owners = append(c.owners, applyOpts.FieldManager)
+	return err
+}
+
+var _ = Describe("Node taint attack", func() {
+	var c *nodeClient
+	var r *ChaosExperimentReconciler
+	taint := corev1.Taint{Key: defaultNodeTaintKey, Effect: corev1.TaintEffectNoSchedule}
+
+	BeforeEach(func() {
+		c = &nodeClient{node: &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", ResourceVersion: "1"},
+			Spec:       corev1.NodeSpec{Taints: []corev1.Taint{{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoSchedule}}},
+		}}
+		r = &ChaosExperimentReconciler{Client: c}
+	})
+
+	It("should patch the taint and apply the cordon", func() {
+		node := c.node.DeepCopy()
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Taints = append(node.Spec.Taints, taint)
+		Expect(r.taintNode(context.Background(), node, patch, nodeTaintOriginal{Taint: &taint, Cordoned: true, CordonApplied: true})).To(Succeed())
+
+		Expect(c.patches).To(HaveLen(1))
+		Expect(c.patches[0]).To(ContainSubstring(defaultNodeTaintKey))
+		Expect(c.patches[0]).NotTo(ContainSubstring("unschedulable"))
+		Expect(c.applies).To(Equal([]string{`{"kind":"Node","apiVersion":"v1","metadata":{"name":"node-1"},"spec":{"unschedulable":true}}`}))
+		Expect(c.owners).To(Equal([]string{FieldManager}))
+	})
+
+	It("should release an applied cordon on revert", func() {
+		c.node.Spec.Taints = append(c.node.Spec.Taints, taint)
+		c.node.Spec.Unschedulable = true
+		original, err := json.Marshal(nodeTaintOriginal{Taint: &taint, Cordoned: true, CordonApplied: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(r.revertNodeTaint(context.Background(), chaosv1alpha1.InjectedFault{Kind: "Node", Name: "node-1", Original: string(original)})).To(Succeed())
+		Expect(c.patches).To(HaveLen(1))
+		Expect(c.patches[0]).To(ContainSubstring("dedicated"))
+		Expect(c.patches[0]).NotTo(ContainSubstring(defaultNodeTaintKey))
+		Expect(c.patches[0]).NotTo(ContainSubstring("unschedulable"))
+		Expect(c.applies).To(Equal([]string{`{"kind":"Node","apiVersion":"v1","metadata":{"name":"node-1"}}`}))
+	})
+
+	It("should uncordon nodes cordoned with a patch on revert", func() {
+		c.node.Spec.Unschedulable = true
+		original, err := json.Marshal(nodeTaintOriginal{Cordoned: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(r.revertNodeTaint(context.Background(), chaosv1alpha1.InjectedFault{Kind: "Node", Name: "node-1", Original: string(original)})).To(Succeed())
+		Expect(c.patches).To(HaveLen(1))
+		Expect(c.patches[0]).To(ContainSubstring(`"unschedulable":null`))
+		Expect(c.applies).To(BeEmpty())
+	})
+})
@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// noTargetsPollInterval is how often an experiment that waits for targets
+// looks for them by itself. Pods that start to match their labels are picked
+// up by the pod watch right away, but other filters, like the node selector,
+// are not watched.
+const noTargetsPollInterval = 5 * time.Minute
+
+// noTargetsPolicy returns spec.onNoTargets, which defaults to Fail.
+func noTargetsPolicy(experiment *chaosv1alpha1.ChaosExperiment) chaosv1alpha1.NoTargetsPolicy {
+	if experiment.Spec.OnNoTargets == "" {
+		return chaosv1alpha1.FailOnNoTargets
+	}
+	return experiment.Spec.OnNoTargets
+}
+
+// reconcileNoTargets handles an iteration whose target matched no pods as
+// spec.onNoTargets says: it fails, waits for matching pods to appear or is
+// skipped. It returns the result to hand back to the controller.
+func (r *ChaosExperimentReconciler) reconcileNoTargets(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	reason, message := "NoTargetPods", "No target pods found matching the label selector."
+	// A namespace that does not exist is told apart from one without
+	// matching pods, as it will not get any by itself.
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: experiment.Spec.Target.Namespace}, namespace); errors.IsNotFound(err) {
+		reason, message = "NamespaceNotFound", fmt.Sprintf("Target namespace %s does not exist.", experiment.Spec.Target.Namespace)
+	}
+	setCondition(experiment, chaosv1alpha1.ConditionTargetsFound, metav1.ConditionFalse, "NoTargetsFound", message)
+
+	switch noTargetsPolicy(experiment) {
+	case chaosv1alpha1.WaitForTargets:
+		// The pod watch enqueues experiments whose last lookup found no targets.
+		if !meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionWaitingForTargets) {
+			r.Recorder.Event(experiment, "Normal", "WaitingForTargets", message)
+		}
+		setCondition(experiment, chaosv1alpha1.ConditionWaitingForTargets, metav1.ConditionTrue, reason, message)
+		experiment.Status.Message = "Waiting for target pods: " + message
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status while waiting for targets")
+			return ctrl.Result{}, err
+		}
+		return requeueForFaults(experiment, ctrl.Result{RequeueAfter: noTargetsPollInterval}), nil
+	case chaosv1alpha1.SkipOnNoTargets:
+		r.Recorder.Event(experiment, "Normal", reason, message)
+		if experiment.Spec.Mode != chaosv1alpha1.RecurringMode {
+			// A one-shot experiment has no further iteration to wait for.
+			experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+			experiment.Status.Reason = "NoTargetsFound"
+			setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionTrue, "NoTargetsFound", "The only iteration was skipped: "+message)
+		}
+		return r.skipIteration(ctx, experiment, "Iteration skipped: "+message)
+	}
+
+	applyFailurePolicy(experiment, reason)
+	experiment.Status.Message = message
+	r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+	r.Recorder.Event(experiment, "Warning", reason, experiment.Status.Message)
+	retryAfter := countFailure(experiment)
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status after no pods found")
+	}
+	return ctrl.Result{RequeueAfter: retryAfter}, nil // Requeue to check again later
+}
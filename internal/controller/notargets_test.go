@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// namespaceGetter serves Gets of the namespaces it knows.
+type namespaceGetter struct {
+	*statusRecorder
+	namespaces map[string]bool
+}
+
+func (c *namespaceGetter) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if _, ok := obj.(*corev1.Namespace); !ok || !c.namespaces[key.Name] {
+		return errors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, key.Name)
+	}
+	return nil
+}
+
+var _ = Describe("Experiments without targets", func() {
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		r = &ChaosExperimentReconciler{
+			Client:   &namespaceGetter{statusRecorder: &statusRecorder{}, namespaces: map[string]bool{"demo": true}},
+			Recorder: record.NewFakeRecorder(10),
+		}
+		experiment = &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Target:       chaosv1alpha1.ExperimentTarget{Namespace: "demo"},
+			ResultsLimit: ptr.To[int32](0),
+		}}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+	})
+
+	It("should fail by default and tell a missing namespace apart", func() {
+		result, err := r.reconcileNoTargets(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentFailed))
+		Expect(experiment.Status.Reason).To(Equal("NoTargetPods"))
+		Expect(waitingForTargets(experiment)).To(BeTrue())
+
+		experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+		experiment.Spec.Target.Namespace = "missing"
+		_, err = r.reconcileNoTargets(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(experiment.Status.Reason).To(Equal("NamespaceNotFound"))
+	})
+
+	It("should wait for targets in the phase the experiment is in", func() {
+		experiment.Spec.OnNoTargets = chaosv1alpha1.WaitForTargets
+		result, err := r.reconcileNoTargets(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(noTargetsPollInterval))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPending))
+		Expect(experiment.Status.History).To(BeEmpty())
+		Expect(experiment.Status.ConsecutiveFailures).To(BeZero())
+		Expect(meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionWaitingForTargets)).To(BeTrue())
+		Expect(waitingForTargets(experiment)).To(BeTrue())
+	})
+
+	It("should skip the iteration and complete one-shot experiments", func() {
+		experiment.Spec.OnNoTargets = chaosv1alpha1.SkipOnNoTargets
+		_, err := r.reconcileNoTargets(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentCompleted))
+		Expect(experiment.Status.History).To(HaveLen(1))
+		Expect(experiment.Status.History[0].Result).To(Equal(chaosv1alpha1.IterationSkipped))
+
+		experiment.Spec.Mode = chaosv1alpha1.RecurringMode
+		experiment.Spec.Interval = &metav1.Duration{Duration: time.Minute}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		result, err := r.reconcileNoTargets(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+	})
+})
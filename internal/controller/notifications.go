@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/notify"
+)
+
+// notify sends a notification about the experiment to every channel of
+// spec.notifications that wants the event. Notifications are best effort:
+// failures are logged and reported as events, but never hold up the
+// experiment.
+func (r *ChaosExperimentReconciler) notify(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, event chaosv1alpha1.NotificationEvent, message string) {
+	logger := log.FromContext(ctx)
+
+	if experiment.Spec.Notifications == nil {
+		return
+	}
+	notifiers, err := r.notifiers(ctx, experiment, event)
+	if err != nil {
+		logger.Error(err, "Failed to set up notification channels", "Event", event)
+		r.Recorder.Eventf(experiment, "Warning", "NotificationFailed", "Failed to notify %s: %v", event, err)
+		return
+	}
+	notification := notificationFor(experiment, event, message, time.Now())
+	for _, notifier := range notifiers {
+		if err := notifier.Notify(ctx, notification); err != nil {
+			logger.Error(err, "Failed to send notification", "Event", event)
+			r.Recorder.Eventf(experiment, "Warning", "NotificationFailed", "Failed to notify %s: %v", event, err)
+		}
+	}
+}
+
+// notifiers returns the channels of spec.notifications that want the event.
+func (r *ChaosExperimentReconciler) notifiers(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, event chaosv1alpha1.NotificationEvent) ([]notify.Notifier, error) {
+	var notifiers []notify.Notifier
+	if slack := experiment.Spec.Notifications.Slack; slack != nil && notify.Wants(slack.Events, event) {
+		url, err := r.secretValue(ctx, experiment.Namespace, slack.WebhookURLSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("slack webhook URL: %w", err)
+		}
+		notifiers = append(notifiers, &notify.Slack{WebhookURL: url, Template: slack.Template})
+	}
+	if webhook := experiment.Spec.Notifications.Webhook; webhook != nil && notify.Wants(webhook.Events, event) {
+		notifier := &notify.Webhook{URL: webhook.URL, Format: webhook.Format}
+		if ref := webhook.AuthorizationSecretRef; ref != nil {
+			authorization, err := r.secretValue(ctx, experiment.Namespace, *ref)
+			if err != nil {
+				return nil, fmt.Errorf("webhook authorization: %w", err)
+			}
+			notifier.Authorization = authorization
+		}
+		notifiers = append(notifiers, notifier)
+	}
+	if pagerDuty := experiment.Spec.Notifications.PagerDuty; pagerDuty != nil && notify.Wants(pagerDutyEvents(pagerDuty), event) {
+		routingKey, err := r.secretValue(ctx, experiment.Namespace, pagerDuty.RoutingKeySecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("pagerduty routing key: %w", err)
+		}
+		notifiers = append(notifiers, &notify.PagerDuty{RoutingKey: routingKey, Severity: pagerDuty.Severity})
+	}
+	if grafana := experiment.Spec.Notifications.Grafana; grafana != nil && event == chaosv1alpha1.NotifyAttackExecuted {
+		token, err := r.secretValue(ctx, experiment.Namespace, grafana.APITokenSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("grafana api token: %w", err)
+		}
+		notifiers = append(notifiers, &notify.Grafana{URL: grafana.URL, Token: token, DashboardUID: grafana.DashboardUID, Tags: grafana.Tags})
+	}
+	return notifiers, nil
+}
+
+// pagerDutyEvents returns the events that open a PagerDuty incident.
+func pagerDutyEvents(pagerDuty *chaosv1alpha1.PagerDutyNotification) []chaosv1alpha1.NotificationEvent {
+	if len(pagerDuty.Events) == 0 {
+		return []chaosv1alpha1.NotificationEvent{chaosv1alpha1.NotifyFailed, chaosv1alpha1.NotifyAborted}
+	}
+	return pagerDuty.Events
+}
+
+// notificationFor describes an event of the experiment.
+func notificationFor(experiment *chaosv1alpha1.ChaosExperiment, event chaosv1alpha1.NotificationEvent, message string, now time.Time) notify.Notification {
+	notification := notify.Notification{
+		Event:      event,
+		Experiment: experiment.Name,
+		Namespace:  experiment.Namespace,
+		Attack:     experiment.Spec.Attack.Type,
+		Phase:      experiment.Status.Phase,
+		Iteration:  experiment.Status.IterationsCompleted,
+		Message:    message,
+		Time:       now,
+	}
+	if last := experiment.Status.LastIteration; last != nil && event == chaosv1alpha1.NotifyAttackExecuted {
+		notification.Targets = last.Targets
+	}
+	return notification
+}
+
+// secretValue returns the value of the selected key of a Secret.
+func (r *ChaosExperimentReconciler) secretValue(ctx context.Context, namespace string, selector corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: selector.Name}, secret); err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s/%s has no key %q", namespace, selector.Name, selector.Key)
+	}
+	return string(value), nil
+}
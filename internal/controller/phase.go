@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// pauseExperiment moves an experiment that has not finished to the Paused
+// phase for the given reason and reports whether that changed its status.
+// The change is persisted by the next status update.
+func pauseExperiment(experiment *chaosv1alpha1.ChaosExperiment, reason string) bool {
+	if experimentFinished(experiment) {
+		return false
+	}
+	if experiment.Status.Phase == chaosv1alpha1.ExperimentPaused && experiment.Status.Reason == reason {
+		return false
+	}
+	experiment.Status.Phase = chaosv1alpha1.ExperimentPaused
+	experiment.Status.Reason = reason
+	return true
+}
+
+// resumeExperiment moves an experiment paused for one of the given reasons
+// back to Running, or to Pending if it has not run an iteration yet, and
+// reports whether it did.
+func resumeExperiment(experiment *chaosv1alpha1.ChaosExperiment, reasons ...string) bool {
+	if experiment.Status.Phase != chaosv1alpha1.ExperimentPaused || !slices.Contains(reasons, experiment.Status.Reason) {
+		return false
+	}
+	experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+	if experiment.Status.StartTime != nil {
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+	}
+	experiment.Status.Reason = ""
+	return true
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Experiment phases", func() {
+	It("should pause unfinished experiments and resume them for the same reason", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+		Expect(pauseExperiment(experiment, "BudgetExhausted")).To(BeTrue())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPaused))
+		Expect(experiment.Status.Reason).To(Equal("BudgetExhausted"))
+		Expect(pauseExperiment(experiment, "BudgetExhausted")).To(BeFalse())
+
+		Expect(resumeExperiment(experiment, "Suspended")).To(BeFalse())
+		Expect(resumeExperiment(experiment, "Suspended", "BudgetExhausted")).To(BeTrue())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPending))
+		Expect(experiment.Status.Reason).To(BeEmpty())
+
+		experiment.Status.StartTime = &metav1.Time{}
+		Expect(pauseExperiment(experiment, "Suspended")).To(BeTrue())
+		Expect(resumeExperiment(experiment, "Suspended")).To(BeTrue())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+
+		experiment.Status.Phase = chaosv1alpha1.ExperimentAborted
+		experiment.Status.Reason = "AbortRequested"
+		Expect(pauseExperiment(experiment, "Suspended")).To(BeFalse())
+		Expect(experiment.Status.Reason).To(Equal("AbortRequested"))
+	})
+
+	It("should pause suspended experiments", func() {
+		c := &statusRecorder{}
+		r := &ChaosExperimentReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{Suspend: true}}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		experiment.Status.StartTime = &metav1.Time{}
+
+		suspended, err := r.reconcileSuspension(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(suspended).To(BeTrue())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPaused))
+		Expect(experiment.Status.Reason).To(Equal("Suspended"))
+		Expect(c.updates).To(Equal(1))
+
+		experiment.Spec.Suspend = false
+		suspended, err = r.reconcileSuspension(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(suspended).To(BeFalse())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+		Expect(experiment.Status.Reason).To(BeEmpty())
+		Expect(meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionPaused)).To(BeFalse())
+		Expect(c.updates).To(Equal(2))
+	})
+
+	It("should pause experiments held back by safeguards", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		holdBackIteration(experiment, "BlastRadiusLimited", "safeguards.maxAffectedPercentage has been reached.")
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPaused))
+		Expect(experiment.Status.Reason).To(Equal("BlastRadiusLimited"))
+		condition := meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionSafeguardsSatisfied)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("BlastRadiusLimited"))
+	})
+})
@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// DefaultPodKillWorkers is how many pods an iteration deletes or evicts at
+// once when PodKillWorkers is not set.
+const DefaultPodKillWorkers = 10
+
+// killPods deletes or evicts the pods concurrently, with at most
+// PodKillWorkers requests in flight, and returns the error for each pod in
+// the order of pods.
+func (r *ChaosExperimentReconciler) killPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, pods []*corev1.Pod, evict bool) []error {
+	workers := r.PodKillWorkers
+	if workers <= 0 {
+		workers = DefaultPodKillWorkers
+	}
+	errs := make([]error, len(pods))
+	slots := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, pod := range pods {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			errs[i] = r.killPod(ctx, experiment, pod, evict)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// killPod deletes or evicts a single pod and, for forced kills, makes sure it
+// does not linger in Terminating.
+func (r *ChaosExperimentReconciler) killPod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, pod *corev1.Pod, evict bool) error {
+	var err error
+	if evict {
+		err = r.evictPod(ctx, experiment, pod)
+	} else {
+		err = r.deletePod(ctx, experiment, pod)
+	}
+	if err != nil || experiment.Spec.Attack.PodKill == nil || !experiment.Spec.Attack.PodKill.Force {
+		return err
+	}
+	return r.forceRemovePod(ctx, experiment, pod)
+}
+
+// forceRemovePod strips the finalizers of a pod that was killed with a zero
+// grace period. The API server removes such a pod at once unless finalizers
+// hold it back, so a pod that is still there is stuck in Terminating until
+// whoever owns its finalizers gets to them, if ever.
+func (r *ChaosExperimentReconciler) forceRemovePod(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, pod *corev1.Pod) error {
+	logger := log.FromContext(ctx).WithValues("AttackType", "PodKill")
+
+	// The cache may not have seen the deletion yet.
+	var reader client.Reader = r.Client
+	if impersonating, ok := impersonatingClient(ctx); ok {
+		reader = impersonating
+	} else if r.APIReader != nil {
+		reader = r.APIReader
+	}
+	current := &corev1.Pod{}
+	if err := reader.Get(ctx, client.ObjectKeyFromObject(pod), current); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if current.UID != pod.UID || current.DeletionTimestamp == nil || len(current.Finalizers) == 0 {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(current.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	finalizers := current.Finalizers
+	current.Finalizers = nil
+	if err := r.targets(ctx).Patch(ctx, current, patch); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	logger.Info("Removed finalizers of pod stuck in Terminating", "PodName", pod.Name, "Finalizers", finalizers)
+	r.Recorder.Eventf(experiment, "Normal", "PodForceRemoved", "Pod %s/%s was stuck in Terminating, removed its finalizers %v.", pod.Namespace, pod.Name, finalizers)
+	return nil
+}
+
+// resumePodKills finishes a pod-kill iteration that was interrupted after
+// startRun wrote the pods it picked to the status, without picking others.
+// Pods that are gone, terminating or were replaced by a pod of the same name
+// since count as killed; the others are killed now.
+func (r *ChaosExperimentReconciler) resumePodKills(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, run *chaosv1alpha1.IterationResult) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "PodKill")
+
+	var killed []chaosv1alpha1.AffectedTarget
+	var remaining []*corev1.Pod
+	for _, target := range run.Targets {
+		namespace, name, _ := strings.Cut(target, "/")
+		pod := &corev1.Pod{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod)
+		switch {
+		case errors.IsNotFound(err):
+			killed = append(killed, chaosv1alpha1.AffectedTarget{Kind: "Pod", Name: name, Namespace: namespace})
+		case err != nil:
+			logger.Error(err, "Failed to get pod picked by interrupted run", "RunID", run.RunID, "PodName", name)
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		case pod.DeletionTimestamp != nil || pod.CreationTimestamp.After(run.Time.Time):
+			killed = append(killed, podTarget(pod))
+		default:
+			remaining = append(remaining, pod)
+		}
+	}
+
+	logger.Info("Resuming interrupted run", "Experiment", experiment.Name, "RunID", run.RunID, "Killed", len(killed), "Remaining", len(remaining))
+	evict := experiment.Spec.Attack.PodKill != nil && experiment.Spec.Attack.PodKill.DeletionMethod == chaosv1alpha1.EvictPod
+	for i, err := range r.killPods(ctx, experiment, remaining, evict) {
+		switch {
+		case err == nil:
+			killed = append(killed, podTarget(remaining[i]))
+		case errors.IsTooManyRequests(err):
+			logger.Info("Eviction refused by PodDisruptionBudget", "PodName", remaining[i].Name, "Reason", err.Error())
+		default:
+			// The run stays started, so the next attempt resumes it again.
+			logger.Error(err, "Failed to kill pod picked by interrupted run", "RunID", run.RunID, "PodName", remaining[i].Name)
+			return ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+	}
+	r.Recorder.AnnotatedEventf(experiment, runAnnotations(experiment), "Normal", "IterationResumed", "Run %s was interrupted after picking %d pod(s) and is finished on them instead of killing others.", run.RunID, len(run.Targets))
+
+	if len(killed) == 0 {
+		return r.skipIteration(ctx, experiment, "Iteration skipped: the PodDisruptionBudgets of the pods picked by the interrupted run allow no disruptions.")
+	}
+	experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{
+		Time:    metav1.Now(),
+		Targets: targetNames(killed),
+		Skipped: int32(len(run.Targets) - len(killed)),
+	}
+	return r.completeAttackIteration(ctx, experiment, "Pod-kill attack resumed after it was interrupted.", killed)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// podDeleter deletes pods slowly, fails the ones named in failing and records
+// how many deletions were in flight at once and the grace periods asked for.
+type podDeleter struct {
+	client.Client
+	failing map[string]bool
+
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+	deleted  []string
+	graces   []*int64
+}
+
+func (c *podDeleter) Delete(_ context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	c.mu.Lock()
+	c.inFlight++
+	c.peak = max(c.peak, c.inFlight)
+	c.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+	if c.failing[obj.GetName()] {
+		return fmt.Errorf("admission webhook denied the request")
+	}
+	c.deleted = append(c.deleted, obj.GetName())
+	c.graces = append(c.graces, (&client.DeleteOptions{}).ApplyOptions(opts).GracePeriodSeconds)
+	return nil
+}
+
+// stuckPodDeleter deletes pods like podDeleter, but leaves the pods in stuck
+// behind in Terminating and records the patches made to them.
+type stuckPodDeleter struct {
+	*podDeleter
+	stuck   map[string]*corev1.Pod
+	patched []*corev1.Pod
+}
+
+func (c *stuckPodDeleter) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	pod, ok := c.stuck[key.Name]
+	if !ok {
+		return errors.NewNotFound(schema.GroupResource{Resource: "pods"}, key.Name)
+	}
+	pod.DeepCopyInto(obj.(*corev1.Pod))
+	return nil
+}
+
+func (c *stuckPodDeleter) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	c.patched = append(c.patched, obj.(*corev1.Pod))
+	return nil
+}
+
+var _ = Describe("Pod kills", func() {
+	var c *podDeleter
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+	var pods []*corev1.Pod
+
+	BeforeEach(func() {
+		c = &podDeleter{failing: map[string]bool{"web-3": true}}
+		r = &ChaosExperimentReconciler{Client: c, Recorder: record.NewFakeRecorder(100), PodKillWorkers: 2}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "demo"}}
+		pods = nil
+		for i := range 6 {
+			pods = append(pods, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("web-%d", i), Namespace: "default"}})
+		}
+	})
+
+	It("should delete the pods concurrently without exceeding the worker count", func() {
+		errs := r.killPods(context.Background(), experiment, pods, false)
+
+		Expect(c.deleted).To(ConsistOf("web-0", "web-1", "web-2", "web-4", "web-5"))
+		Expect(c.peak).To(Equal(2))
+		Expect(errs).To(HaveLen(6))
+		for i, err := range errs {
+			if i == 3 {
+				Expect(err).To(MatchError(ContainSubstring("denied")))
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+	})
+
+	It("should default the worker count", func() {
+		r.PodKillWorkers = 0
+		for i := 6; i < 30; i++ {
+			pods = append(pods, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("web-%d", i), Namespace: "default"}})
+		}
+
+		r.killPods(context.Background(), experiment, pods, false)
+		Expect(c.peak).To(BeNumerically("<=", DefaultPodKillWorkers))
+		Expect(c.deleted).To(HaveLen(29))
+	})
+
+	It("should give the pods the configured grace period", func() {
+		Expect(r.deletePod(context.Background(), experiment, pods[0])).To(Succeed())
+
+		experiment.Spec.Attack.PodKill = &chaosv1alpha1.PodKillAttackSpec{GracePeriodSeconds: ptr.To[int64](0)}
+		Expect(r.deletePod(context.Background(), experiment, pods[1])).To(Succeed())
+
+		experiment.Spec.Attack.PodKill.GracePeriodSeconds = ptr.To[int64](5)
+		Expect(r.deletePod(context.Background(), experiment, pods[2])).To(Succeed())
+
+		Expect(c.graces).To(Equal([]*int64{nil, ptr.To[int64](0), ptr.To[int64](5)}))
+	})
+
+	It("should force pods out of Terminating", func() {
+		stuck := pods[1].DeepCopy()
+		stuck.DeletionTimestamp = ptr.To(metav1.Now())
+		stuck.Finalizers = []string{"example.com/sidecar"}
+		s := &stuckPodDeleter{podDeleter: c, stuck: map[string]*corev1.Pod{stuck.Name: stuck}}
+		r.Client = s
+		experiment.Spec.Attack.PodKill = &chaosv1alpha1.PodKillAttackSpec{GracePeriodSeconds: ptr.To[int64](30), Force: true}
+
+		Expect(r.killPods(context.Background(), experiment, pods[:3], false)).To(HaveEach(Succeed()))
+		Expect(c.graces).To(HaveEach(Equal(ptr.To[int64](0))))
+		Expect(s.patched).To(HaveLen(1))
+		Expect(s.patched[0].Name).To(Equal("web-1"))
+		Expect(s.patched[0].Finalizers).To(BeEmpty())
+	})
+
+	It("should leave pods alone unless forced", func() {
+		stuck := pods[0].DeepCopy()
+		stuck.DeletionTimestamp = ptr.To(metav1.Now())
+		stuck.Finalizers = []string{"example.com/sidecar"}
+		s := &stuckPodDeleter{podDeleter: c, stuck: map[string]*corev1.Pod{stuck.Name: stuck}}
+		r.Client = s
+
+		Expect(r.killPod(context.Background(), experiment, pods[0], false)).To(Succeed())
+		Expect(s.patched).To(BeEmpty())
+	})
+})
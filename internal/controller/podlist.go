@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// listPodPage lists the page of pods that follows continueToken into pods,
+// and leaves the token of the next page in pods.Continue, empty after the
+// last one. With PodListPageSize set the pages are read from the API server
+// through APIReader; otherwise all pods are read from the cache at once.
+// Experiments with a ServiceAccount read them as it from the API server.
+func (r *ChaosExperimentReconciler) listPodPage(ctx context.Context, pods *corev1.PodList, continueToken string, opts ...client.ListOption) error {
+	if r.PodListPageSize <= 0 || r.APIReader == nil {
+		if err := r.targets(ctx).List(ctx, pods, opts...); err != nil {
+			return err
+		}
+		// The cache does not support continuing and says so in the token.
+		pods.Continue = ""
+		return nil
+	}
+	var reader client.Reader = r.APIReader
+	if impersonating, ok := impersonatingClient(ctx); ok {
+		reader = impersonating
+	}
+	opts = slices.Concat(opts, []client.ListOption{client.Limit(r.PodListPageSize), client.Continue(continueToken)})
+	return reader.List(ctx, pods, opts...)
+}
+
+// samplesTargetPods reports whether pickTargetPods samples the target pods as
+// they are listed instead of holding all of them: pods are listed in pages
+// and picked at random.
+func (r *ChaosExperimentReconciler) samplesTargetPods(experiment *chaosv1alpha1.ChaosExperiment) bool {
+	strategy := experiment.Spec.Target.SelectionStrategy
+	return r.PodListPageSize > 0 && r.APIReader != nil && (strategy == "" || strategy == chaosv1alpha1.SelectRandom)
+}
+
+// sampleTargetPods picks a uniform random sample of the pods matching the
+// experiment target, large enough for the count or target.percentage of the
+// iteration, and returns it with the number of matching pods. Pods are
+// listed a page at a time and only the sample is kept. Targeting a
+// percentage takes a second pass to count the pods first. If nothing
+// matches, the experiment status is updated accordingly and no pods are
+// returned together with the result the caller should hand back to the
+// controller.
+func (r *ChaosExperimentReconciler) sampleTargetPods(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, count *int32, rng *rand.Rand) ([]corev1.Pod, int, ctrl.Result, error) {
+	size := 1
+	switch {
+	case count != nil:
+		size = int(*count)
+	case experiment.Spec.Target.Percentage != nil:
+		matching, result, err := r.visitTargetPods(ctx, experiment, func([]corev1.Pod) {})
+		if matching == 0 {
+			return nil, 0, result, err
+		}
+		size = targetPodCount(experiment, matching)
+	}
+
+	reservoir := &podReservoir{size: size, rand: rng}
+	matching, result, err := r.visitTargetPods(ctx, experiment, reservoir.offer)
+	if matching == 0 {
+		return nil, 0, result, err
+	}
+	return reservoir.pods, matching, ctrl.Result{}, nil
+}
+
+// podReservoir keeps a uniform random sample of up to size of the pods
+// offered to it, without holding on to the others.
+type podReservoir struct {
+	size int
+	rand *rand.Rand
+	seen int
+	pods []corev1.Pod
+}
+
+// offer considers pods for the sample. After n pods have been offered, each
+// of them is in the sample with the same probability.
+func (s *podReservoir) offer(pods []corev1.Pod) {
+	for _, pod := range pods {
+		s.seen++
+		if len(s.pods) < s.size {
+			s.pods = append(s.pods, pod)
+			continue
+		}
+		if i := s.rand.Intn(s.seen); i < s.size {
+			s.pods[i] = pod
+		}
+	}
+}
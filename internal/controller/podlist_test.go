@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Pod sampling", func() {
+	page := func(from, to int) []corev1.Pod {
+		var pods []corev1.Pod
+		for i := from; i < to; i++ {
+			pods = append(pods, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%d", i)}})
+		}
+		return pods
+	}
+
+	It("should keep every pod while the sample is not full", func() {
+		reservoir := &podReservoir{size: 5, rand: rand.New(rand.NewSource(1))}
+		reservoir.offer(page(0, 2))
+		reservoir.offer(page(2, 3))
+		Expect(reservoir.pods).To(HaveLen(3))
+		Expect(reservoir.seen).To(Equal(3))
+	})
+
+	It("should sample pods from all pages uniformly", func() {
+		picked := map[string]int{}
+		rng := rand.New(rand.NewSource(1))
+		for range 2000 {
+			reservoir := &podReservoir{size: 2, rand: rng}
+			for from := 0; from < 10; from += 3 {
+				reservoir.offer(page(from, min(from+3, 10)))
+			}
+			Expect(reservoir.pods).To(HaveLen(2))
+			Expect(reservoir.seen).To(Equal(10))
+			for _, pod := range reservoir.pods {
+				picked[pod.Name]++
+			}
+		}
+		// Each of the 10 pods is expected in 2 of 10 samples, 400 times.
+		Expect(picked).To(HaveLen(10))
+		for name, n := range picked {
+			Expect(n).To(BeNumerically("~", 400, 100), name)
+		}
+	})
+})
@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcilePodPauseAttack pauses every running container of a randomly picked
+// target pod and resumes it once the window has elapsed. The pod keeps its
+// IP and stays Running, so clients see a hung but alive backend.
+func (r *ChaosExperimentReconciler) reconcilePodPauseAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "PodPause")
+
+	spec := experiment.Spec.Attack.PodPause
+	if spec == nil {
+		spec = &chaosv1alpha1.PodPauseAttackSpec{}
+	}
+	method := spec.Method
+	if method == "" {
+		method = chaosv1alpha1.FreezePause
+	}
+
+	target, result, err := r.pickTargetPod(ctx, experiment)
+	if target == nil {
+		return result, err
+	}
+
+	var containerIDs []string
+	for _, status := range target.Status.ContainerStatuses {
+		if status.ContainerID != "" && status.State.Running != nil {
+			containerIDs = append(containerIDs, runtimeContainerID(status.ContainerID))
+		}
+	}
+	if len(containerIDs) == 0 {
+		return r.failExperiment(ctx, experiment, "NoRunningTargets", fmt.Sprintf("Pod %s/%s has no running containers to pause.", target.Namespace, target.Name))
+	}
+
+	timeout := attackDuration(experiment, spec.Duration)
+	helper, err := r.runHelperPod(ctx, experiment, target, podPauseScript(containerIDs, method, timeout))
+	if err != nil {
+		logger.Error(err, "Failed to create helper pod", "PodName", target.Name)
+		failOnError(experiment, "HelperPodFailed", err)
+		experiment.Status.Message = "Failed to create helper pod for pod-pause."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "HelperPodFailed", "Failed to create helper pod for %s/%s", target.Namespace, target.Name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after helper pod error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Pod pause dispatched", "PodName", target.Name, "Method", method, "Containers", len(containerIDs), "HelperPod", helper.Name)
+	r.Recorder.Eventf(experiment, "Normal", "PodPaused", "Pod %s/%s was paused (%s) for %s.", target.Namespace, target.Name, method, timeout)
+
+	return r.completeAttackIteration(ctx, experiment, "Pod-pause attack executed.", []chaosv1alpha1.AffectedTarget{podTarget(target)})
+}
+
+// podPauseScript returns a helper script that pauses all processes of the
+// given containers for the given duration and resumes them on exit, including
+// when the helper pod is asked to terminate early.
+func podPauseScript(containerIDs []string, method chaosv1alpha1.PauseMethod, d time.Duration) string {
+	var b strings.Builder
+	b.WriteString("targets=\n")
+	for _, id := range containerIDs {
+		b.WriteString(containerPIDsScript(id))
+		if method == chaosv1alpha1.SigstopPause {
+			b.WriteString("targets=\"$targets $pids\"\n")
+			continue
+		}
+		// Prefer the v1 freezer hierarchy and fall back to the unified one.
+		b.WriteString(`pid=${pids%%[[:space:]]*}
+dir=$(awk -F: '$2 == "freezer" { print "` + hostCgroupRoot + `/freezer" $3 }' /proc/$pid/cgroup)
+if [ -z "$dir" ]; then dir=$(awk -F: '$1 == "0" { print "` + hostCgroupRoot + `" $3 }' /proc/$pid/cgroup); fi
+targets="$targets $dir"
+`)
+	}
+
+	if method == chaosv1alpha1.SigstopPause {
+		b.WriteString("pause() { kill -s $1 $targets; }\ntrap 'pause CONT' EXIT\ntrap 'exit 0' INT TERM\npause STOP\n")
+	} else {
+		b.WriteString(`pause() {
+  for dir in $targets; do
+    if [ -e "$dir/cgroup.freeze" ]; then echo $1 > "$dir/cgroup.freeze"
+    elif [ $1 = 1 ]; then echo FROZEN > "$dir/freezer.state"
+    else echo THAWED > "$dir/freezer.state"; fi
+  done
+}
+trap 'pause 0' EXIT
+trap 'exit 0' INT TERM
+pause 1
+`)
+	}
+	fmt.Fprintf(&b, "sleep %d & wait\n", attackSeconds(d))
+	return b.String()
+}
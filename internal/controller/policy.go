@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// policyClient is used for the requests to the policy endpoint.
+var policyClient = &http.Client{Timeout: 10 * time.Second}
+
+// policyInput is what the policy endpoint is asked to decide on: the
+// experiment about to attack and the targets it resolved.
+type policyInput struct {
+	Experiment policyExperiment               `json:"experiment"`
+	Targets    []chaosv1alpha1.AffectedTarget `json:"targets"`
+}
+
+// policyExperiment is the part of an experiment sent to the policy endpoint.
+type policyExperiment struct {
+	Name        string                            `json:"name"`
+	Namespace   string                            `json:"namespace"`
+	Labels      map[string]string                 `json:"labels,omitempty"`
+	Annotations map[string]string                 `json:"annotations,omitempty"`
+	Spec        chaosv1alpha1.ChaosExperimentSpec `json:"spec"`
+}
+
+// policyDecision is the result returned by the policy endpoint. It is either
+// a plain boolean or an object with allow and an optional reason.
+type policyDecision struct {
+	Allow  bool   `json:"allow"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// UnmarshalJSON accepts both forms of a decision.
+func (d *policyDecision) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &d.Allow); err == nil {
+		return nil
+	}
+	type decision policyDecision
+	return json.Unmarshal(data, (*decision)(d))
+}
+
+// authorizeAttack asks the policy endpoint whether the experiment may attack
+// the targets it resolved. A denied iteration is skipped: PolicyDenied turns
+// true and the experiment is paused for the same reason until the policy
+// allows an iteration again. It reports whether the attack may go ahead,
+// otherwise the result to hand back to the controller; this is also the case
+// while the policy cannot be evaluated, so that no attack runs unchecked.
+// Dry runs and operators without a policy URL are not checked.
+func (r *ChaosExperimentReconciler) authorizeAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, targets []chaosv1alpha1.AffectedTarget) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if r.PolicyURL == "" || experiment.Spec.DryRun {
+		return true, ctrl.Result{}, nil
+	}
+
+	decision, err := r.queryPolicy(ctx, experiment, targets)
+	if err != nil {
+		logger.Error(err, "Failed to evaluate policy", "PolicyURL", r.PolicyURL)
+		r.Recorder.Eventf(experiment, "Warning", "PolicyCheckFailed", "Failed to evaluate the policy: %v", err)
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	if !decision.Allow {
+		cause := "the policy denied the attack"
+		if decision.Reason != "" {
+			cause = fmt.Sprintf("%s (%s)", cause, decision.Reason)
+		}
+		logger.Info("Policy denied the attack", "Reason", decision.Reason)
+		r.Recorder.Eventf(experiment, "Warning", "PolicyDenied", "Iteration skipped because %s.", cause)
+		setCondition(experiment, chaosv1alpha1.ConditionPolicyDenied, metav1.ConditionTrue, "PolicyDenied", fmt.Sprintf("Attack skipped because %s.", cause))
+		pauseExperiment(experiment, "PolicyDenied")
+		result, err := r.skipIteration(ctx, experiment, fmt.Sprintf("Iteration skipped: %s.", cause))
+		return false, result, err
+	}
+	setCondition(experiment, chaosv1alpha1.ConditionPolicyDenied, metav1.ConditionFalse, "PolicyAllowed", "The policy allowed the last attack.")
+	return true, ctrl.Result{}, nil
+}
+
+// queryPolicy posts the experiment and its targets to the policy endpoint as
+// the input of an OPA data API query, {"input": ...}, and returns the result
+// of the response. An undefined result denies the attack.
+func (r *ChaosExperimentReconciler) queryPolicy(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, targets []chaosv1alpha1.AffectedTarget) (policyDecision, error) {
+	payload, err := json.Marshal(map[string]policyInput{"input": {
+		Experiment: policyExperiment{
+			Name:        experiment.Name,
+			Namespace:   experiment.Namespace,
+			Labels:      experiment.Labels,
+			Annotations: experiment.Annotations,
+			Spec:        experiment.Spec,
+		},
+		Targets: targets,
+	}})
+	if err != nil {
+		return policyDecision{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.PolicyURL, bytes.NewReader(payload))
+	if err != nil {
+		return policyDecision{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := policyClient.Do(req)
+	if err != nil {
+		return policyDecision{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return policyDecision{}, fmt.Errorf("policy endpoint returned status %s", resp.Status)
+	}
+
+	var body struct {
+		Result *policyDecision `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return policyDecision{}, fmt.Errorf("decoding policy response: %w", err)
+	}
+	if body.Result == nil {
+		return policyDecision{Reason: "no decision"}, nil
+	}
+	return *body.Result, nil
+}
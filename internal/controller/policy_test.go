@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Policy", func() {
+	var server *httptest.Server
+	var inputs []policyInput
+	var response string
+	var c *statusRecorder
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+	targets := []chaosv1alpha1.AffectedTarget{objectTarget("Pod", "shop", "web-0")}
+
+	BeforeEach(func() {
+		inputs = nil
+		response = `{"result":true}`
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var body struct {
+				Input policyInput `json:"input"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil || req.Method != http.MethodPost {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			inputs = append(inputs, body.Input)
+			if response == "" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprint(w, response)
+		}))
+		DeferCleanup(server.Close)
+
+		c = &statusRecorder{}
+		r = &ChaosExperimentReconciler{Client: c, Recorder: record.NewFakeRecorder(10), PolicyURL: server.URL}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "chaos"}}
+		experiment.Spec.Mode = chaosv1alpha1.RecurringMode
+		experiment.Spec.Interval = &metav1.Duration{Duration: 5 * time.Minute}
+		experiment.Spec.Attack.Type = chaosv1alpha1.PodKillAttack
+		experiment.Spec.ResultsLimit = ptr.To[int32](0)
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+	})
+
+	It("should send the experiment and its targets and let allowed attacks go ahead", func() {
+		allowed, _, err := r.authorizeAttack(context.Background(), experiment, targets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(inputs).To(HaveLen(1))
+		Expect(inputs[0].Experiment.Name).To(Equal("kill-web"))
+		Expect(inputs[0].Experiment.Spec.Attack.Type).To(Equal(chaosv1alpha1.PodKillAttack))
+		Expect(inputs[0].Targets).To(Equal(targets))
+
+		condition := meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionPolicyDenied)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(c.updates).To(BeZero())
+	})
+
+	It("should skip the iteration when the policy denies the attack", func() {
+		response = `{"result":{"allow":false,"reason":"shop is frozen"}}`
+
+		allowed, result, err := r.authorizeAttack(context.Background(), experiment, targets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 5*time.Minute, time.Second))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPaused))
+		Expect(experiment.Status.Reason).To(Equal("PolicyDenied"))
+		Expect(experiment.Status.Message).To(Equal("Iteration skipped: the policy denied the attack (shop is frozen)."))
+		Expect(meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionPolicyDenied)).To(BeTrue())
+		Expect(c.updates).To(Equal(1))
+	})
+
+	It("should deny attacks the policy does not decide on", func() {
+		response = `{}`
+
+		allowed, _, err := r.authorizeAttack(context.Background(), experiment, targets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(experiment.Status.Message).To(Equal("Iteration skipped: the policy denied the attack (no decision)."))
+	})
+
+	It("should hold the attack back while the policy cannot be evaluated", func() {
+		response = ""
+
+		allowed, result, err := r.authorizeAttack(context.Background(), experiment, targets)
+		Expect(err).To(MatchError(ContainSubstring("500")))
+		Expect(allowed).To(BeFalse())
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+	})
+
+	It("should not check dry runs or without a policy URL", func() {
+		experiment.Spec.DryRun = true
+		allowed, _, err := r.authorizeAttack(context.Background(), experiment, targets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		experiment.Spec.DryRun = false
+		r.PolicyURL = ""
+		allowed, _, err = r.authorizeAttack(context.Background(), experiment, targets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(inputs).To(BeEmpty())
+		Expect(experiment.Status.Conditions).To(BeEmpty())
+	})
+})
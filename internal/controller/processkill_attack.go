@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileProcessKillAttack sends a signal to selected processes of a
+// container in a randomly picked target pod. Unlike container-kill, the other
+// processes keep running, which exercises supervisors and partial failures.
+func (r *ChaosExperimentReconciler) reconcileProcessKillAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	spec := experiment.Spec.Attack.ProcessKill
+	if spec == nil {
+		spec = &chaosv1alpha1.ProcessKillAttackSpec{}
+	}
+	if spec.ProcessName != "" && spec.Pattern != "" {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "attack.processKill.processName and pattern are mutually exclusive.")
+	}
+	signal := spec.Signal
+	if signal == "" {
+		signal = "SIGKILL"
+	}
+
+	target := "PID 1"
+	switch {
+	case spec.ProcessName != "":
+		target = fmt.Sprintf("process %q", spec.ProcessName)
+	case spec.Pattern != "":
+		target = fmt.Sprintf("processes matching %q", spec.Pattern)
+	}
+
+	return r.reconcileContainerAttack(ctx, experiment, spec.ContainerName, func(containerID string) string {
+		return containerPIDsScript(containerID) + processKillScript(spec.ProcessName, spec.Pattern, signal)
+	}, "ProcessKilled", fmt.Sprintf("had %s sent to %s", signal, target))
+}
+
+// processKillScript returns a shell snippet that sends the signal to the
+// processes in $pids selected by name, by command line pattern or, when
+// neither is given, to the one that is PID 1 in the container's PID namespace.
+func processKillScript(processName, pattern, signal string) string {
+	var match string
+	switch {
+	case processName != "":
+		match = fmt.Sprintf(`[ "$(cat /proc/$p/comm 2>/dev/null)" = %s ]`, shellQuote(processName))
+	case pattern != "":
+		match = fmt.Sprintf(`tr '\0' ' ' < /proc/$p/cmdline 2>/dev/null | grep -Eq -- %s`, shellQuote(pattern))
+	default:
+		match = `[ "$(awk '/^NSpid:/ { print $NF }' /proc/$p/status 2>/dev/null)" = 1 ]`
+	}
+	return fmt.Sprintf(`matched=
+for p in $pids; do
+  if %s; then matched="$matched $p"; fi
+done
+if [ -z "$matched" ]; then echo "no matching processes found" >&2; exit 1; fi
+kill -s %s $matched
+`, match, strings.TrimPrefix(signal, "SIG"))
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// attackQuotaWindow is the window MaxAttacksPerHour applies to.
+const attackQuotaWindow = time.Hour
+
+// quotaScope returns what the budget counts experiments targeting the
+// namespace against: the team owning it as "<teamLabel>=<team>" when the
+// budget groups namespaces by team and the namespace carries the label, or
+// else the namespace itself.
+func quotaScope(budget *chaosv1alpha1.ChaosBudget, namespace string, namespaceLabels map[string]string) string {
+	if label := budget.Spec.TeamLabel; label != "" {
+		if team, ok := namespaceLabels[label]; ok {
+			return label + "=" + team
+		}
+	}
+	return namespace
+}
+
+// enforceActiveExperimentQuotas defers the first iteration of an experiment
+// while as many experiments as a ChaosBudget covering its target namespace
+// allows are already active in the namespace or its team. Experiments are
+// active from their first iteration until they finish, and reserve their slot
+// in the budget status before it. It reports whether the iteration may go
+// ahead; if it may not, the iteration has been skipped and the result to hand
+// back to the controller is returned.
+func (r *ChaosExperimentReconciler) enforceActiveExperimentQuotas(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Active experiments already count against the quotas.
+	if experiment.Status.StartTime != nil {
+		return true, ctrl.Result{}, nil
+	}
+	namespace := experiment.Spec.Target.Namespace
+	budgets, err := r.coveringBudgets(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to look up chaos budgets", "Namespace", namespace)
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	// Experiments deferred after reserving their slot keep it.
+	var limited []chaosv1alpha1.ChaosBudget
+	for _, budget := range budgets {
+		if budget.Spec.MaxActiveExperiments != nil && !slotReserved(&budget, experiment.UID) {
+			limited = append(limited, budget)
+		}
+	}
+	if len(limited) == 0 {
+		return true, ctrl.Result{}, nil
+	}
+
+	var experiments chaosv1alpha1.ChaosExperimentList
+	if err := r.List(ctx, &experiments); err != nil {
+		logger.Error(err, "Failed to list ChaosExperiments")
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	var namespaces corev1.NamespaceList
+	if err := r.List(ctx, &namespaces); err != nil {
+		logger.Error(err, "Failed to list Namespaces")
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	namespaceLabels := make(map[string]map[string]string, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		namespaceLabels[ns.Name] = ns.Labels
+	}
+	// Only the other experiments that have not finished may hold a slot.
+	live := make(map[types.UID]*chaosv1alpha1.ChaosExperiment, len(experiments.Items))
+	for i, other := range experiments.Items {
+		if other.UID != experiment.UID && !other.Spec.DryRun && !experimentFinished(&other) {
+			live[other.UID] = &experiments.Items[i]
+		}
+	}
+
+	for _, budget := range limited {
+		scope := quotaScope(&budget, namespace, namespaceLabels[namespace])
+		active := activeExperiments(&budget, scope, live, namespaceLabels)
+		if active < int(*budget.Spec.MaxActiveExperiments) {
+			continue
+		}
+
+		logger.Info("Chaos budget quota allows no further active experiments, deferring iteration", "ChaosBudget", budget.Name, "Scope", scope, "Active", active)
+		r.Recorder.Eventf(experiment, "Warning", "QuotaExceeded", "Iteration deferred because %d experiment(s) are already active in %s, the most chaos budget %s allows.", active, scope, budget.Name)
+		holdBackIteration(experiment, "QuotaExceeded", fmt.Sprintf("Chaos budget %s allows no more than %d active experiment(s) in %s.", budget.Name, *budget.Spec.MaxActiveExperiments, scope))
+		result, err := r.skipIteration(ctx, experiment, "Iteration deferred: the quota of active experiments is exhausted.")
+		return false, result, err
+	}
+	for i := range limited {
+		// Experiments are listed from the cache and start concurrently, so
+		// the slot is only theirs if the budget did not change since it was
+		// read.
+		patch := client.MergeFromWithOptions(limited[i].DeepCopy(), client.MergeFromWithOptimisticLock{})
+		reserveSlot(&limited[i], quotaScope(&limited[i], namespace, namespaceLabels[namespace]), experiment, live)
+		if err := r.Status().Patch(ctx, &limited[i], patch); err != nil {
+			logger.Error(err, "Failed to reserve an active experiment slot in chaos budget", "ChaosBudget", limited[i].Name)
+			return false, ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("reserving an active experiment slot in chaos budget %s: %w", limited[i].Name, err)
+		}
+	}
+	return true, ctrl.Result{}, nil
+}
+
+// slotReserved reports whether the experiment with the UID holds a slot of
+// the budget.
+func slotReserved(budget *chaosv1alpha1.ChaosBudget, uid types.UID) bool {
+	for _, record := range budget.Status.ActiveExperiments {
+		if record.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// activeExperiments returns how many of the live experiments hold a slot of
+// the budget in the scope: those that reserved one, and those that were
+// active before slots were reserved.
+func activeExperiments(budget *chaosv1alpha1.ChaosBudget, scope string, live map[types.UID]*chaosv1alpha1.ChaosExperiment, namespaceLabels map[string]map[string]string) int {
+	active := make(map[types.UID]bool)
+	for _, record := range budget.Status.ActiveExperiments {
+		if record.Scope == scope && live[record.UID] != nil {
+			active[record.UID] = true
+		}
+	}
+	for uid, other := range live {
+		if other.Status.StartTime != nil && quotaScope(budget, other.Spec.Target.Namespace, namespaceLabels[other.Spec.Target.Namespace]) == scope {
+			active[uid] = true
+		}
+	}
+	return len(active)
+}
+
+// reserveSlot records the experiment as holding a slot of the budget in the
+// scope and releases the slots of the experiments that are no longer live.
+func reserveSlot(budget *chaosv1alpha1.ChaosBudget, scope string, experiment *chaosv1alpha1.ChaosExperiment, live map[types.UID]*chaosv1alpha1.ChaosExperiment) {
+	kept := budget.Status.ActiveExperiments[:0]
+	for _, record := range budget.Status.ActiveExperiments {
+		if live[record.UID] != nil {
+			kept = append(kept, record)
+		}
+	}
+	budget.Status.ActiveExperiments = append(kept, chaosv1alpha1.ActiveExperimentRecord{Scope: scope, Experiment: experiment.Namespace + "/" + experiment.Name, UID: experiment.UID})
+}
+
+// chargeAttackQuotas charges the iteration about to attack to the ChaosBudgets
+// covering the target namespace that limit attacks per hour, or defers it if
+// one of them has no attacks left in the namespace or its team. Iterations
+// are charged once, before they attack, so an attack that fails still
+// counts. It reports whether the iteration may go ahead; if it may not, the
+// iteration has been skipped and the result to hand back to the controller
+// is returned.
+func (r *ChaosExperimentReconciler) chargeAttackQuotas(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	namespace := experiment.Spec.Target.Namespace
+	budgets, err := r.coveringBudgets(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to look up chaos budgets", "Namespace", namespace)
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	// Iterations retried under the same run ID have been charged to some or
+	// all of the budgets already.
+	var limited []chaosv1alpha1.ChaosBudget
+	for _, budget := range budgets {
+		if budget.Spec.MaxAttacksPerHour != nil && !attackCharged(&budget, experiment.Status.RunID) {
+			limited = append(limited, budget)
+		}
+	}
+	if len(limited) == 0 {
+		return true, ctrl.Result{}, nil
+	}
+	namespaceLabels, err := r.namespaceLabels(ctx, namespace)
+	if err != nil {
+		logger.Error(err, "Failed to look up namespace", "Namespace", namespace)
+		return false, ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	now := time.Now()
+	for _, budget := range limited {
+		scope := quotaScope(&budget, namespace, namespaceLabels)
+		if attacksRemaining(&budget, scope, now) > 0 {
+			continue
+		}
+
+		logger.Info("Chaos budget quota allows no further attacks, deferring iteration", "ChaosBudget", budget.Name, "Scope", scope)
+		r.Recorder.Eventf(experiment, "Warning", "QuotaExceeded", "Iteration deferred because %s has run the %d attack(s) per hour chaos budget %s allows.", scope, *budget.Spec.MaxAttacksPerHour, budget.Name)
+		holdBackIteration(experiment, "QuotaExceeded", fmt.Sprintf("Chaos budget %s allows no more than %d attack(s) per hour in %s.", budget.Name, *budget.Spec.MaxAttacksPerHour, scope))
+		result, err := r.skipIteration(ctx, experiment, "Iteration deferred: the quota of attacks is exhausted.")
+		return false, result, err
+	}
+
+	// The charges are keyed on the run ID, which is stored first so that a
+	// retry after charging only some of the budgets keeps it.
+	if experiment.Status.RunID == "" {
+		runID(experiment)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status with the run ID")
+			return false, ctrl.Result{}, err
+		}
+	}
+	for i := range limited {
+		// Experiments charge the same budget concurrently, so the patch is
+		// rejected if the budget changed since it was read.
+		patch := client.MergeFromWithOptions(limited[i].DeepCopy(), client.MergeFromWithOptimisticLock{})
+		chargeAttack(&limited[i], quotaScope(&limited[i], namespace, namespaceLabels), experiment.Namespace+"/"+experiment.Name, experiment.Status.RunID, now)
+		if err := r.Status().Patch(ctx, &limited[i], patch); err != nil {
+			logger.Error(err, "Failed to charge the attack to chaos budget", "ChaosBudget", limited[i].Name)
+			return false, ctrl.Result{RequeueAfter: time.Second * 30}, fmt.Errorf("charging the attack to chaos budget %s: %w", limited[i].Name, err)
+		}
+	}
+	return true, ctrl.Result{}, nil
+}
+
+// attackCharged reports whether the iteration with the run ID has been
+// charged to the budget.
+func attackCharged(budget *chaosv1alpha1.ChaosBudget, runID string) bool {
+	if runID == "" {
+		return false
+	}
+	for _, record := range budget.Status.Attacks {
+		if record.RunID == runID {
+			return true
+		}
+	}
+	return false
+}
+
+// attacksRemaining returns how many more attacks the budget allows in the
+// scope at now.
+func attacksRemaining(budget *chaosv1alpha1.ChaosBudget, scope string, now time.Time) int {
+	since := now.Add(-attackQuotaWindow)
+	used := 0
+	for _, record := range budget.Status.Attacks {
+		if record.Scope == scope && record.Time.Time.After(since) {
+			used++
+		}
+	}
+	return max(int(*budget.Spec.MaxAttacksPerHour)-used, 0)
+}
+
+// chargeAttack records an attack by the iteration of the experiment with the
+// run ID in the scope in the budget status and drops the records that fell
+// out of the window.
+func chargeAttack(budget *chaosv1alpha1.ChaosBudget, scope, experiment, runID string, now time.Time) {
+	since := now.Add(-attackQuotaWindow)
+	kept := budget.Status.Attacks[:0]
+	for _, record := range budget.Status.Attacks {
+		if record.Time.Time.After(since) {
+			kept = append(kept, record)
+		}
+	}
+	budget.Status.Attacks = append(kept, chaosv1alpha1.AttackRecord{Scope: scope, Experiment: experiment, RunID: runID, Time: metav1.NewTime(now)})
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// quotaLister serves ChaosBudgets, ChaosExperiments and namespaces.
+type quotaLister struct {
+	*statusRecorder
+	budgets     []chaosv1alpha1.ChaosBudget
+	experiments []chaosv1alpha1.ChaosExperiment
+	namespaces  map[string]map[string]string
+}
+
+func (c *quotaLister) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	switch list := list.(type) {
+	case *chaosv1alpha1.ChaosBudgetList:
+		list.Items = c.budgets
+	case *chaosv1alpha1.ChaosExperimentList:
+		list.Items = c.experiments
+	case *corev1.NamespaceList:
+		for name, labels := range c.namespaces {
+			list.Items = append(list.Items, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}})
+		}
+	}
+	return nil
+}
+
+func (c *quotaLister) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if ns, ok := obj.(*corev1.Namespace); ok {
+		ns.Labels = c.namespaces[key.Name]
+	}
+	return nil
+}
+
+var _ = Describe("Chaos budget quotas", func() {
+	var c *quotaLister
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	// activeExperiment returns a started experiment targeting the namespace.
+	activeExperiment := func(name, namespace string) chaosv1alpha1.ChaosExperiment {
+		other := chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "chaos", UID: types.UID("uid-" + name)}}
+		other.Spec.Target.Namespace = namespace
+		other.Status.Phase = chaosv1alpha1.ExperimentRunning
+		other.Status.StartTime = &metav1.Time{Time: time.Now()}
+		return other
+	}
+
+	BeforeEach(func() {
+		c = &quotaLister{statusRecorder: &statusRecorder{}, namespaces: map[string]map[string]string{
+			"shop":     {"team": "payments"},
+			"checkout": {"team": "payments"},
+			"search":   nil,
+		}}
+		r = &ChaosExperimentReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "chaos", UID: "uid-kill-web"}}
+		experiment.Spec.Mode = chaosv1alpha1.RecurringMode
+		experiment.Spec.Interval = &metav1.Duration{Duration: 5 * time.Minute}
+		experiment.Spec.Target.Namespace = "shop"
+		experiment.Spec.Attack.Type = chaosv1alpha1.PodKillAttack
+		experiment.Spec.ResultsLimit = ptr.To[int32](0)
+		experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+	})
+
+	It("should count namespaces against their team when the budget groups them", func() {
+		budget := &chaosv1alpha1.ChaosBudget{}
+		Expect(quotaScope(budget, "shop", c.namespaces["shop"])).To(Equal("shop"))
+
+		budget.Spec.TeamLabel = "team"
+		Expect(quotaScope(budget, "shop", c.namespaces["shop"])).To(Equal("team=payments"))
+		Expect(quotaScope(budget, "checkout", c.namespaces["checkout"])).To(Equal("team=payments"))
+		Expect(quotaScope(budget, "search", c.namespaces["search"])).To(Equal("search"))
+	})
+
+	It("should track attacks per scope within the last hour", func() {
+		budget := &chaosv1alpha1.ChaosBudget{Spec: chaosv1alpha1.ChaosBudgetSpec{MaxAttacksPerHour: ptr.To[int32](2)}}
+		start := time.Now()
+		chargeAttack(budget, "shop", "chaos/kill-web", "", start)
+		chargeAttack(budget, "shop", "chaos/other", "", start.Add(30*time.Minute))
+		chargeAttack(budget, "search", "chaos/other", "", start.Add(30*time.Minute))
+		Expect(attacksRemaining(budget, "shop", start.Add(30*time.Minute))).To(Equal(0))
+		Expect(attacksRemaining(budget, "search", start.Add(30*time.Minute))).To(Equal(1))
+
+		// The first attack falls out of the window after an hour.
+		chargeAttack(budget, "search", "chaos/other", "", start.Add(time.Hour+time.Second))
+		Expect(budget.Status.Attacks).To(HaveLen(3))
+		Expect(attacksRemaining(budget, "shop", start.Add(time.Hour+time.Second))).To(Equal(1))
+	})
+
+	It("should defer the first iteration while the team has its quota of active experiments", func() {
+		c.budgets = []chaosv1alpha1.ChaosBudget{{
+			ObjectMeta: metav1.ObjectMeta{Name: "teams", ResourceVersion: "1"},
+			Spec:       chaosv1alpha1.ChaosBudgetSpec{TeamLabel: "team", MaxActiveExperiments: ptr.To[int32](1)},
+		}}
+		finished := activeExperiment("finished", "checkout")
+		finished.Status.Phase = chaosv1alpha1.ExperimentCompleted
+		c.experiments = []chaosv1alpha1.ChaosExperiment{activeExperiment("search", "search"), finished}
+
+		allowed, _, err := r.enforceActiveExperimentQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		// An experiment against another namespace of the team uses up the quota.
+		c.experiments = append(c.experiments, activeExperiment("checkout", "checkout"))
+		allowed, _, err = r.enforceActiveExperimentQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPaused))
+		Expect(experiment.Status.Reason).To(Equal("QuotaExceeded"))
+		condition := meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionSafeguardsSatisfied)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Message).To(Equal("Chaos budget teams allows no more than 1 active experiment(s) in team=payments."))
+		Expect(c.updates).To(Equal(1))
+
+		// Experiments that are active already are not held back.
+		experiment.Status.StartTime = &metav1.Time{Time: time.Now()}
+		allowed, _, err = r.enforceActiveExperimentQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+	})
+
+	It("should reserve a slot before the first iteration and count the reserved slots", func() {
+		c.budgets = []chaosv1alpha1.ChaosBudget{{
+			ObjectMeta: metav1.ObjectMeta{Name: "shops", ResourceVersion: "1"},
+			Spec:       chaosv1alpha1.ChaosBudgetSpec{MaxActiveExperiments: ptr.To[int32](1)},
+			Status: chaosv1alpha1.ChaosBudgetStatus{ActiveExperiments: []chaosv1alpha1.ActiveExperimentRecord{
+				{Scope: "shop", Experiment: "chaos/deleted", UID: "uid-deleted"},
+			}},
+		}}
+
+		allowed, _, err := r.enforceActiveExperimentQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(c.patches).To(HaveLen(1))
+		Expect(c.patches[0]).To(ContainSubstring(`"resourceVersion":"1"`))
+		Expect(c.patches[0]).To(ContainSubstring(`"uid":"uid-kill-web"`))
+		Expect(c.patches[0]).NotTo(ContainSubstring("uid-deleted"))
+
+		// A pending experiment that reserved the slot holds it before the
+		// cache shows it as active.
+		pending := activeExperiment("pending", "shop")
+		pending.Status.Phase = chaosv1alpha1.ExperimentPending
+		pending.Status.StartTime = nil
+		c.experiments = []chaosv1alpha1.ChaosExperiment{pending}
+		c.budgets[0].Status.ActiveExperiments = []chaosv1alpha1.ActiveExperimentRecord{{Scope: "shop", Experiment: "chaos/pending", UID: "uid-pending"}}
+		allowed, _, err = r.enforceActiveExperimentQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(experiment.Status.Reason).To(Equal("QuotaExceeded"))
+		Expect(c.patches).To(HaveLen(1))
+
+		// Experiments that reserved their slot already keep it.
+		c.budgets[0].Status.ActiveExperiments = append(c.budgets[0].Status.ActiveExperiments, chaosv1alpha1.ActiveExperimentRecord{Scope: "shop", Experiment: "chaos/kill-web", UID: "uid-kill-web"})
+		allowed, _, err = r.enforceActiveExperimentQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(c.patches).To(HaveLen(1))
+	})
+
+	It("should charge attacks to the budget and defer them once the quota is exhausted", func() {
+		c.budgets = []chaosv1alpha1.ChaosBudget{{
+			ObjectMeta: metav1.ObjectMeta{Name: "teams", ResourceVersion: "1"},
+			Spec:       chaosv1alpha1.ChaosBudgetSpec{TeamLabel: "team", MaxAttacksPerHour: ptr.To[int32](1)},
+		}}
+
+		allowed, _, err := r.chargeAttackQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(c.patches).To(HaveLen(1))
+		Expect(c.patches[0]).To(ContainSubstring(`"scope":"team=payments"`))
+		Expect(c.patches[0]).To(ContainSubstring(`"resourceVersion":"1"`))
+		Expect(c.patches[0]).To(ContainSubstring(`"runID":"` + experiment.Status.RunID + `"`))
+		Expect(c.updates).To(Equal(1))
+
+		// Retries of the same iteration are not charged again.
+		c.budgets[0].Status.Attacks = []chaosv1alpha1.AttackRecord{{Scope: "team=payments", Experiment: "chaos/kill-web", RunID: experiment.Status.RunID, Time: metav1.Now()}}
+		allowed, _, err = r.chargeAttackQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(c.patches).To(HaveLen(1))
+
+		experiment.Status.RunID = ""
+		c.budgets[0].Status.Attacks = []chaosv1alpha1.AttackRecord{{Scope: "team=payments", Experiment: "chaos/other", Time: metav1.Now()}}
+		allowed, _, err = r.chargeAttackQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(experiment.Status.Reason).To(Equal("QuotaExceeded"))
+		Expect(experiment.Status.Message).To(Equal("Iteration deferred: the quota of attacks is exhausted."))
+		Expect(c.patches).To(HaveLen(1))
+	})
+
+	It("should only charge the budgets a retried iteration has not been charged to", func() {
+		experiment.Status.RunID = "run"
+		c.budgets = []chaosv1alpha1.ChaosBudget{{
+			ObjectMeta: metav1.ObjectMeta{Name: "charged", ResourceVersion: "1"},
+			Spec:       chaosv1alpha1.ChaosBudgetSpec{MaxAttacksPerHour: ptr.To[int32](1)},
+			Status:     chaosv1alpha1.ChaosBudgetStatus{Attacks: []chaosv1alpha1.AttackRecord{{Scope: "shop", Experiment: "chaos/kill-web", RunID: "run", Time: metav1.Now()}}},
+		}, {
+			ObjectMeta: metav1.ObjectMeta{Name: "conflicted", ResourceVersion: "1"},
+			Spec:       chaosv1alpha1.ChaosBudgetSpec{MaxAttacksPerHour: ptr.To[int32](1)},
+		}}
+
+		allowed, _, err := r.chargeAttackQuotas(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		Expect(c.patches).To(HaveLen(1))
+		Expect(c.patches[0]).To(ContainSubstring(`"runID":"run"`))
+		Expect(c.updates).To(BeZero())
+	})
+})
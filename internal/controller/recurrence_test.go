@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Recurring experiments", func() {
+	It("should keep jittered intervals within bounds", func() {
+		r := &ChaosExperimentReconciler{}
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		Expect(r.jitter(experiment, 10*time.Minute)).To(Equal(10 * time.Minute))
+
+		experiment.Spec.Jitter = &metav1.Duration{Duration: 2 * time.Minute}
+		seen := map[bool]bool{}
+		for range 100 {
+			d := r.jitter(experiment, 10*time.Minute)
+			Expect(d).To(BeNumerically(">=", 8*time.Minute))
+			Expect(d).To(BeNumerically("<=", 12*time.Minute))
+			seen[d > 10*time.Minute] = true
+		}
+		Expect(seen).To(HaveLen(2))
+
+		Expect(r.jitter(experiment, time.Minute)).To(BeNumerically(">=", time.Second))
+	})
+
+	It("should plan the next iteration from the interval", func() {
+		r := &ChaosExperimentReconciler{}
+		now := time.Now()
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode: chaosv1alpha1.RecurringMode,
+		}}
+		_, ok := r.nextIteration(experiment, now)
+		Expect(ok).To(BeFalse())
+
+		experiment.Spec.Interval = &metav1.Duration{Duration: 5 * time.Minute}
+		next, ok := r.nextIteration(experiment, now)
+		Expect(ok).To(BeTrue())
+		Expect(next).To(Equal(5 * time.Minute))
+		Expect(experiment.Status.NextScheduledTime.Time).To(Equal(now.Add(5 * time.Minute)))
+	})
+
+	It("should wait for the interval and not beyond the lifetime", func() {
+		r := &ChaosExperimentReconciler{Client: &statusRecorder{}, Recorder: record.NewFakeRecorder(10)}
+		experiment := &chaosv1alpha1.ChaosExperiment{
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Mode:     chaosv1alpha1.RecurringMode,
+				Interval: &metav1.Duration{Duration: time.Hour},
+				Duration: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+			Status: chaosv1alpha1.ChaosExperimentStatus{Phase: chaosv1alpha1.ExperimentRunning},
+		}
+		due, _, err := r.waitForInterval(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(BeTrue())
+
+		experiment.Status.StartTime = &metav1.Time{Time: time.Now().Add(-20 * time.Minute)}
+		experiment.Status.NextScheduledTime = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		due, result, err := r.waitForInterval(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(BeFalse())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 10*time.Minute, time.Second))
+
+		Expect(requeueForNextIteration(experiment, ctrl.Result{}).RequeueAfter).To(BeNumerically("~", 10*time.Minute, time.Second))
+		Expect(requeueForNextIteration(experiment, ctrl.Result{RequeueAfter: time.Second}).RequeueAfter).To(Equal(time.Second))
+	})
+
+	It("should keep using the duration as interval of recurring experiments that set only it", func() {
+		recorder := record.NewFakeRecorder(10)
+		r := &ChaosExperimentReconciler{Client: &statusRecorder{}, Recorder: recorder}
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode:         chaosv1alpha1.RecurringMode,
+			ResultsLimit: ptr.To[int32](0),
+			Duration:     &metav1.Duration{Duration: time.Minute},
+		}}
+		due, _, err := r.waitForInterval(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(BeTrue())
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning DeprecatedInterval")))
+
+		interval, ok := recurrenceInterval(experiment)
+		Expect(ok).To(BeTrue())
+		Expect(interval).To(Equal(time.Minute))
+		_, bounded := experimentLifetime(experiment)
+		Expect(bounded).To(BeFalse())
+	})
+
+	It("should fail recurring experiments without interval or schedule", func() {
+		r := &ChaosExperimentReconciler{Client: &statusRecorder{}, Recorder: record.NewFakeRecorder(10)}
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode:         chaosv1alpha1.RecurringMode,
+			ResultsLimit: ptr.To[int32](0),
+		}}
+		due, _, err := r.waitForInterval(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(BeFalse())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentFailed))
+	})
+
+	It("should keep recurring experiments running after a failed iteration", func() {
+		r := &ChaosExperimentReconciler{Client: &statusRecorder{}, Recorder: record.NewFakeRecorder(10)}
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode:         chaosv1alpha1.RecurringMode,
+			ResultsLimit: ptr.To[int32](0),
+			Interval:     &metav1.Duration{Duration: time.Minute},
+		}}
+		_, err := r.failExperiment(context.Background(), experiment, "NoRunningTargets", "No running pods found.")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+		Expect(experiment.Status.StartTime).NotTo(BeNil())
+		Expect(experiment.Status.History).To(HaveLen(1))
+		Expect(experiment.Status.History[0].Result).To(Equal(chaosv1alpha1.IterationFailed))
+
+		_, err = r.failExperiment(context.Background(), experiment, "TargetProtected", "Target is protected.")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentFailed))
+		Expect(experimentFinished(experiment)).To(BeTrue())
+	})
+})
@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"html/template"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const (
+	// ReportJSONKey holds the report in the report ConfigMap.
+	ReportJSONKey = "report.json"
+	// ReportHTMLKey holds the report rendered as HTML in the report ConfigMap.
+	ReportHTMLKey = "report.html"
+)
+
+// ExperimentReport is the report written once an experiment has finished.
+type ExperimentReport struct {
+	Experiment     string                          `json:"experiment"`
+	Namespace      string                          `json:"namespace"`
+	Attack         chaosv1alpha1.AttackType        `json:"attack"`
+	Mode           chaosv1alpha1.ExperimentMode    `json:"mode,omitempty"`
+	Phase          chaosv1alpha1.ExperimentPhase   `json:"phase"`
+	Message        string                          `json:"message,omitempty"`
+	StartTime      *metav1.Time                    `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time                    `json:"completionTime,omitempty"`
+	Iterations     int32                           `json:"iterations"`
+	Targets        []string                        `json:"targets,omitempty"`
+	Timeline       []chaosv1alpha1.IterationRecord `json:"timeline,omitempty"`
+	Verdict        chaosv1alpha1.Verdict           `json:"verdict,omitempty"`
+	ProbeResults   []chaosv1alpha1.ProbeResult     `json:"probeResults,omitempty"`
+	Resilience     *chaosv1alpha1.ResilienceScore  `json:"resilience,omitempty"`
+	Events         []ReportEvent                   `json:"events,omitempty"`
+}
+
+// ReportEvent is a Kubernetes event recorded for the experiment.
+type ReportEvent struct {
+	Time    metav1.Time `json:"time"`
+	Type    string      `json:"type"`
+	Reason  string      `json:"reason"`
+	Message string      `json:"message"`
+	Count   int32       `json:"count,omitempty"`
+}
+
+// reportTemplate renders a report as a standalone HTML page.
+var reportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Chaos experiment {{.Namespace}}/{{.Experiment}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+.Failed, .Aborted, .Warning { color: #b00020; }
+</style>
+</head>
+<body>
+<h1>Chaos experiment {{.Namespace}}/{{.Experiment}}</h1>
+<table>
+<tr><th>Attack</th><td>{{.Attack}}</td></tr>
+<tr><th>Mode</th><td>{{.Mode}}</td></tr>
+<tr><th>Phase</th><td class="{{.Phase}}">{{.Phase}}</td></tr>
+<tr><th>Message</th><td>{{.Message}}</td></tr>
+<tr><th>Started</th><td>{{with .StartTime}}{{.UTC}}{{end}}</td></tr>
+<tr><th>Finished</th><td>{{with .CompletionTime}}{{.UTC}}{{end}}</td></tr>
+<tr><th>Iterations</th><td>{{.Iterations}}</td></tr>
+<tr><th>Verdict</th><td class="{{.Verdict}}">{{.Verdict}}</td></tr>
+{{- with .Resilience}}
+<tr><th>Resilience score</th><td>{{.Score}}</td></tr>
+<tr><th>Mean recovery time</th><td>{{with .MeanRecoveryTime}}{{.Duration}}{{end}}</td></tr>
+{{- end}}
+</table>
+{{- with .Targets}}
+<h2>Targets</h2>
+<ul>
+{{- range .}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- with .Timeline}}
+<h2>Timeline</h2>
+<table>
+<tr><th>Time</th><th>Attack</th><th>Result</th><th>Targets</th><th>Error</th></tr>
+{{- range .}}
+<tr><td>{{.Time.UTC}}</td><td>{{.Attack}}{{if .DryRun}} (dry run){{end}}</td><td class="{{.Result}}">{{.Result}}</td><td>{{range $i, $t := .Targets}}{{if $i}}, {{end}}{{$t}}{{end}}</td><td>{{.Error}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .ProbeResults}}
+<h2>Probe results</h2>
+<table>
+<tr><th>Probe</th><th>Phase</th><th>Passed</th><th>Time</th><th>Message</th></tr>
+{{- range .}}
+<tr><td>{{.Name}}</td><td>{{.Phase}}</td><td>{{.Passed}}</td><td>{{.Time.UTC}}</td><td>{{.Message}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Events}}
+<h2>Events</h2>
+<table>
+<tr><th>Time</th><th>Type</th><th>Reason</th><th>Message</th></tr>
+{{- range .}}
+<tr><td>{{.Time.UTC}}</td><td class="{{.Type}}">{{.Type}}</td><td>{{.Reason}}</td><td>{{.Message}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// buildReport assembles the report of the experiment from its status and the
+// events recorded for it.
+func buildReport(experiment *chaosv1alpha1.ChaosExperiment, events []corev1.Event) *ExperimentReport {
+	report := &ExperimentReport{
+		Experiment:     experiment.Name,
+		Namespace:      experiment.Namespace,
+		Attack:         experiment.Spec.Attack.Type,
+		Mode:           experiment.Spec.Mode,
+		Phase:          experiment.Status.Phase,
+		Message:        experiment.Status.Message,
+		StartTime:      experiment.Status.StartTime,
+		CompletionTime: experiment.Status.CompletionTime,
+		Iterations:     experiment.Status.IterationsCompleted,
+		Timeline:       experiment.Status.History,
+		Verdict:        experiment.Status.Verdict,
+		ProbeResults:   experiment.Status.ProbeResults,
+		Resilience:     experiment.Status.Resilience,
+	}
+	for _, record := range experiment.Status.History {
+		for _, target := range record.Targets {
+			if !slices.Contains(report.Targets, target) {
+				report.Targets = append(report.Targets, target)
+			}
+		}
+	}
+
+	for _, event := range events {
+		if event.InvolvedObject.UID != experiment.UID {
+			continue
+		}
+		eventTime := event.LastTimestamp
+		if eventTime.IsZero() {
+			eventTime = metav1.NewTime(event.EventTime.Time)
+		}
+		report.Events = append(report.Events, ReportEvent{
+			Time:    eventTime,
+			Type:    event.Type,
+			Reason:  event.Reason,
+			Message: event.Message,
+			Count:   event.Count,
+		})
+	}
+	sort.SliceStable(report.Events, func(i, j int) bool {
+		return report.Events[i].Time.Before(&report.Events[j].Time)
+	})
+	return report
+}
+
+// renderReport encodes the report as JSON and as HTML.
+func renderReport(report *ExperimentReport) (string, string, error) {
+	encoded, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", "", err
+	}
+	var html bytes.Buffer
+	if err := reportTemplate.Execute(&html, report); err != nil {
+		return "", "", err
+	}
+	return string(encoded), html.String(), nil
+}
+
+// writeReport writes the report of a finished experiment to a ConfigMap owned
+// by it, named <experiment>-report, and points status.reportRef at it.
+func (r *ChaosExperimentReconciler) writeReport(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) error {
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+	events := &corev1.EventList{}
+	if err := reader.List(ctx, events, client.InNamespace(experiment.Namespace)); err != nil {
+		return err
+	}
+	encoded, html, err := renderReport(buildReport(experiment, events.Items))
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: experiment.Name + "-report", Namespace: experiment.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		if configMap.Labels == nil {
+			configMap.Labels = map[string]string{}
+		}
+		configMap.Labels[ExperimentLabel] = experiment.Name
+		configMap.Data = map[string]string{ReportJSONKey: encoded, ReportHTMLKey: html}
+		return controllerutil.SetControllerReference(experiment, configMap, r.Scheme)
+	}); err != nil {
+		return err
+	}
+	experiment.Status.ReportRef = &corev1.LocalObjectReference{Name: configMap.Name}
+	return r.patchStatus(ctx, experiment)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Experiment reports", func() {
+	It("should collect targets, timeline, probe results and events of the experiment", func() {
+		now := time.Now()
+		experiment := &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "shop", UID: "uid-1"},
+			Spec:       chaosv1alpha1.ChaosExperimentSpec{Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack}},
+			Status: chaosv1alpha1.ChaosExperimentStatus{
+				Phase:   chaosv1alpha1.ExperimentCompleted,
+				Verdict: chaosv1alpha1.VerdictFailed,
+				History: []chaosv1alpha1.IterationRecord{
+					{Time: metav1.NewTime(now), Result: chaosv1alpha1.IterationSucceeded, Targets: []string{"Pod shop/web-1"}},
+					{Time: metav1.NewTime(now.Add(time.Minute)), Result: chaosv1alpha1.IterationSucceeded, Targets: []string{"Pod shop/web-1", "Pod shop/web-2"}},
+				},
+				ProbeResults: []chaosv1alpha1.ProbeResult{{Name: "web", Phase: chaosv1alpha1.ProbePost, Message: "got status 503 <html>"}},
+			},
+		}
+		events := []corev1.Event{
+			{InvolvedObject: corev1.ObjectReference{UID: "uid-1"}, Type: "Warning", Reason: "HypothesisFailed", LastTimestamp: metav1.NewTime(now.Add(2 * time.Minute))},
+			{InvolvedObject: corev1.ObjectReference{UID: "uid-1"}, Type: "Normal", Reason: "AttackExecuted", LastTimestamp: metav1.NewTime(now)},
+			{InvolvedObject: corev1.ObjectReference{UID: "uid-2"}, Type: "Normal", Reason: "AttackExecuted"},
+		}
+
+		report := buildReport(experiment, events)
+		Expect(report.Targets).To(Equal([]string{"Pod shop/web-1", "Pod shop/web-2"}))
+		Expect(report.Timeline).To(HaveLen(2))
+		Expect(report.Events).To(HaveLen(2))
+		Expect(report.Events[0].Reason).To(Equal("AttackExecuted"))
+
+		encoded, html, err := renderReport(report)
+		Expect(err).NotTo(HaveOccurred())
+		decoded := &ExperimentReport{}
+		Expect(json.Unmarshal([]byte(encoded), decoded)).To(Succeed())
+		Expect(decoded.Verdict).To(Equal(chaosv1alpha1.VerdictFailed))
+		Expect(html).To(ContainSubstring("<h1>Chaos experiment shop/checkout</h1>"))
+		Expect(html).To(ContainSubstring("got status 503 &lt;html&gt;"))
+	})
+})
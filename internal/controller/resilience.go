@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"math"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// defaultRecoveryObjective is how soon after an attack the steady state should
+// hold again when spec.hypothesis does not set it.
+const defaultRecoveryObjective = 5 * time.Minute
+
+// recoveryObjective returns spec.hypothesis.recoveryObjective.
+func recoveryObjective(experiment *chaosv1alpha1.ChaosExperiment) time.Duration {
+	if hypothesis := experiment.Spec.Hypothesis; hypothesis != nil && hypothesis.RecoveryObjective != nil && hypothesis.RecoveryObjective.Duration > 0 {
+		return hypothesis.RecoveryObjective.Duration
+	}
+	return defaultRecoveryObjective
+}
+
+// serviceOf returns the service the experiment exercises: its ServiceLabel,
+// or else its target workload, or else its target namespace.
+func serviceOf(experiment *chaosv1alpha1.ChaosExperiment) string {
+	if service := experiment.Labels[chaosv1alpha1.ServiceLabel]; service != "" {
+		return service
+	}
+	if workload := experiment.Spec.Target.Workload; workload != nil {
+		return workload.Name
+	}
+	return experiment.Spec.Target.Namespace
+}
+
+// resilienceOf returns status.resilience, creating it if needed.
+func resilienceOf(experiment *chaosv1alpha1.ChaosExperiment) *chaosv1alpha1.ResilienceScore {
+	if experiment.Status.Resilience == nil {
+		experiment.Status.Resilience = &chaosv1alpha1.ResilienceScore{}
+	}
+	return experiment.Status.Resilience
+}
+
+// scoreIteration counts an iteration that attacked, failed to or was aborted
+// towards status.resilience.
+func scoreIteration(experiment *chaosv1alpha1.ChaosExperiment, result chaosv1alpha1.IterationOutcome) {
+	switch result {
+	case chaosv1alpha1.IterationSucceeded, chaosv1alpha1.IterationFailed:
+		resilienceOf(experiment).Iterations++
+	case chaosv1alpha1.IterationAborted:
+		resilience := resilienceOf(experiment)
+		resilience.Iterations++
+		resilience.SafeguardTriggers++
+	default:
+		return
+	}
+	updateResilienceScore(experiment)
+}
+
+// scoreCheck counts a check of spec.hypothesis at now towards
+// status.resilience. Checks after an attack count towards the pass rate;
+// every check that passes while the system is recovering from an attack
+// records the recovery time.
+func scoreCheck(experiment *chaosv1alpha1.ChaosExperiment, afterAttack, passed bool, now time.Time) {
+	resilience := resilienceOf(experiment)
+	if afterAttack {
+		resilience.Checks++
+		if passed {
+			resilience.ChecksPassed++
+		}
+		if resilience.RecoveringSince == nil && experiment.Status.LastRunTime != nil {
+			resilience.RecoveringSince = experiment.Status.LastRunTime.DeepCopy()
+		}
+	}
+	if passed && resilience.RecoveringSince != nil {
+		recovery := max(now.Sub(resilience.RecoveringSince.Time), 0)
+		var mean time.Duration
+		if resilience.MeanRecoveryTime != nil {
+			mean = resilience.MeanRecoveryTime.Duration
+		}
+		n := time.Duration(resilience.Recoveries)
+		resilience.MeanRecoveryTime = &metav1.Duration{Duration: (mean*n + recovery) / (n + 1)}
+		resilience.Recoveries++
+		resilience.RecoveringSince = nil
+	}
+	updateResilienceScore(experiment)
+}
+
+// updateResilienceScore recomputes status.resilience.score and exports it.
+func updateResilienceScore(experiment *chaosv1alpha1.ChaosExperiment) {
+	resilience := experiment.Status.Resilience
+	passRate, recovery, safeguards := 1.0, 1.0, 1.0
+	if resilience.Checks > 0 {
+		passRate = float64(resilience.ChecksPassed) / float64(resilience.Checks)
+	}
+	if resilience.MeanRecoveryTime != nil {
+		recovery = 1 - min(float64(resilience.MeanRecoveryTime.Duration)/float64(recoveryObjective(experiment)), 1)
+	}
+	if resilience.Iterations > 0 {
+		safeguards = 1 - float64(resilience.SafeguardTriggers)/float64(resilience.Iterations)
+	}
+	resilience.Score = int32(math.Round(60*passRate + 20*recovery + 20*safeguards))
+	forgetResilienceScore(experiment.Namespace, experiment.Name)
+	resilienceScoreGauge.WithLabelValues(experiment.Namespace, experiment.Name, serviceOf(experiment)).Set(float64(resilience.Score))
+}
+
+// forgetResilienceScore stops exporting the score of a deleted experiment.
+func forgetResilienceScore(namespace, name string) {
+	resilienceScoreGauge.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "experiment": name})
+}
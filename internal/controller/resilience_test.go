@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Resilience score", func() {
+	newExperiment := func() *chaosv1alpha1.ChaosExperiment {
+		return &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "shop"},
+			Spec: chaosv1alpha1.ChaosExperimentSpec{Target: chaosv1alpha1.ExperimentTarget{
+				Namespace: "shop",
+				Workload:  &chaosv1alpha1.WorkloadReference{Kind: "Deployment", Name: "web"},
+			}},
+		}
+	}
+
+	It("should weigh probe pass rate, recovery time and safeguard triggers", func() {
+		experiment := newExperiment()
+		DeferCleanup(forgetResilienceScore, "shop", "checkout")
+		now := time.Now()
+
+		scoreIteration(experiment, chaosv1alpha1.IterationSucceeded)
+		Expect(experiment.Status.Resilience.Score).To(Equal(int32(100)))
+
+		experiment.Status.LastRunTime = &metav1.Time{Time: now}
+		scoreCheck(experiment, true, false, now.Add(30*time.Second))
+		Expect(experiment.Status.Resilience.RecoveringSince).NotTo(BeNil())
+		Expect(experiment.Status.Resilience.Score).To(Equal(int32(40)))
+
+		// The next check before an attack sees the system recovered.
+		scoreCheck(experiment, false, true, now.Add(150*time.Second))
+		Expect(experiment.Status.Resilience.RecoveringSince).To(BeNil())
+		Expect(experiment.Status.Resilience.Recoveries).To(Equal(int32(1)))
+		Expect(experiment.Status.Resilience.MeanRecoveryTime.Duration).To(Equal(150 * time.Second))
+		Expect(experiment.Status.Resilience.Score).To(Equal(int32(30)))
+
+		scoreIteration(experiment, chaosv1alpha1.IterationAborted)
+		Expect(experiment.Status.Resilience.SafeguardTriggers).To(Equal(int32(1)))
+		Expect(experiment.Status.Resilience.Score).To(Equal(int32(20)))
+
+		metric := &dto.Metric{}
+		Expect(resilienceScoreGauge.WithLabelValues("shop", "checkout", "web").Write(metric)).To(Succeed())
+		Expect(metric.GetGauge().GetValue()).To(Equal(20.0))
+	})
+
+	It("should not count skipped iterations", func() {
+		experiment := newExperiment()
+		scoreIteration(experiment, chaosv1alpha1.IterationSkipped)
+		Expect(experiment.Status.Resilience).To(BeNil())
+	})
+
+	It("should aggregate scores by the service label", func() {
+		experiment := newExperiment()
+		Expect(serviceOf(experiment)).To(Equal("web"))
+		experiment.Spec.Target.Workload = nil
+		Expect(serviceOf(experiment)).To(Equal("shop"))
+		experiment.Labels = map[string]string{chaosv1alpha1.ServiceLabel: "payments"}
+		Expect(serviceOf(experiment)).To(Equal("payments"))
+	})
+})
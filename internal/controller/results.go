@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// defaultResultsLimit is the number of ChaosResults kept for an experiment
+// when spec.resultsLimit is not set.
+const defaultResultsLimit = 100
+
+// resultsLimit returns how many ChaosResults are kept for the experiment.
+func resultsLimit(experiment *chaosv1alpha1.ChaosExperiment) int {
+	if experiment.Spec.ResultsLimit != nil {
+		return int(*experiment.Spec.ResultsLimit)
+	}
+	return defaultResultsLimit
+}
+
+// resultFor builds the ChaosResult recording an iteration of the experiment.
+func resultFor(experiment *chaosv1alpha1.ChaosExperiment, record chaosv1alpha1.IterationRecord) *chaosv1alpha1.ChaosResult {
+	result := &chaosv1alpha1.ChaosResult{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: experiment.Name + "-",
+			Namespace:    experiment.Namespace,
+			Labels:       map[string]string{ExperimentLabel: experiment.Name},
+		},
+		Spec: chaosv1alpha1.ChaosResultSpec{
+			Experiment: experiment.Name,
+			Attack:     record.Attack,
+			Iteration:  experiment.Status.IterationsCompleted + 1,
+			RunID:      record.RunID,
+			Time:       record.Time,
+			Targets:    record.Targets,
+			DryRun:     record.DryRun,
+			Result:     record.Result,
+			Error:      record.Error,
+		},
+	}
+	if record.RunID != "" {
+		result.Labels[RunIDLabel] = record.RunID
+	}
+	if last := experiment.Status.LastIteration; last != nil && currentIteration(experiment, last) {
+		result.Spec.Skipped = last.Skipped
+		result.Spec.Failures = last.Failures
+	}
+	return result
+}
+
+// createResult creates the ChaosResult recording an iteration, owned by the
+// experiment, and deletes the oldest ones beyond spec.resultsLimit. The
+// result is only a record, so failing to write it does not fail the
+// iteration.
+func (r *ChaosExperimentReconciler) createResult(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, record chaosv1alpha1.IterationRecord) {
+	logger := log.FromContext(ctx)
+
+	limit := resultsLimit(experiment)
+	if limit == 0 {
+		return
+	}
+	// Make room for the new result first.
+	if err := r.pruneResults(ctx, experiment, limit-1); err != nil {
+		logger.Error(err, "Failed to delete old ChaosResults")
+	}
+
+	result := resultFor(experiment, record)
+	if err := controllerutil.SetControllerReference(experiment, result, r.Scheme); err != nil {
+		logger.Error(err, "Failed to set owner of ChaosResult")
+		return
+	}
+	if err := r.Create(ctx, result); err != nil {
+		logger.Error(err, "Failed to create ChaosResult")
+		return
+	}
+	experiment.Status.LastResult = result.Name
+}
+
+// pruneResults deletes the oldest ChaosResults of the experiment until at
+// most keep of them are left.
+func (r *ChaosExperimentReconciler) pruneResults(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, keep int) error {
+	results := &chaosv1alpha1.ChaosResultList{}
+	if err := r.List(ctx, results, client.InNamespace(experiment.Namespace), client.MatchingLabels{ExperimentLabel: experiment.Name}); err != nil {
+		return err
+	}
+	if len(results.Items) <= keep {
+		return nil
+	}
+	slices.SortFunc(results.Items, func(a, b chaosv1alpha1.ChaosResult) int {
+		return a.Spec.Time.Compare(b.Spec.Time.Time)
+	})
+	for i := range results.Items[:len(results.Items)-keep] {
+		if err := r.Delete(ctx, &results.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordVerdict copies the outcome of the hypothesis check to the ChaosResult
+// of the iteration it followed.
+func (r *ChaosExperimentReconciler) recordVerdict(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) {
+	logger := log.FromContext(ctx)
+
+	if experiment.Status.LastResult == "" {
+		return
+	}
+	result := &chaosv1alpha1.ChaosResult{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: experiment.Namespace, Name: experiment.Status.LastResult}, result); err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to get ChaosResult", "ChaosResult", experiment.Status.LastResult)
+		}
+		return
+	}
+	original := result.DeepCopy()
+	now := metav1.Now()
+	result.Status.Verdict = experiment.Status.Verdict
+	result.Status.ProbeResults = experiment.Status.ProbeResults
+	result.Status.VerificationTime = &now
+	if err := r.Status().Patch(ctx, result, client.MergeFrom(original)); err != nil {
+		logger.Error(err, "Failed to record verdict in ChaosResult", "ChaosResult", result.Name)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("ChaosResults", func() {
+	It("should record the iteration being run", func() {
+		now := time.Now()
+		experiment := &chaosv1alpha1.ChaosExperiment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kill-nginx", Namespace: "demo"},
+			Spec: chaosv1alpha1.ChaosExperimentSpec{
+				Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack},
+			},
+		}
+		experiment.Status.IterationsCompleted = 2
+		experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{
+			Time:    metav1.NewTime(now),
+			Targets: []string{"demo/nginx-a"},
+			Skipped: 1,
+		}
+
+		record := appendHistory(experiment, chaosv1alpha1.IterationSucceeded, "Pod-kill attack executed.", now)
+		result := resultFor(experiment, record)
+		Expect(result.GenerateName).To(Equal("kill-nginx-"))
+		Expect(result.Namespace).To(Equal("demo"))
+		Expect(result.Labels).To(HaveKeyWithValue(ExperimentLabel, "kill-nginx"))
+		Expect(result.Spec.Experiment).To(Equal("kill-nginx"))
+		Expect(result.Spec.Attack).To(Equal(chaosv1alpha1.PodKillAttack))
+		Expect(result.Spec.Iteration).To(Equal(int32(3)))
+		Expect(result.Spec.Targets).To(ConsistOf("demo/nginx-a"))
+		Expect(result.Spec.Skipped).To(Equal(int32(1)))
+		Expect(result.Spec.Result).To(Equal(chaosv1alpha1.IterationSucceeded))
+		Expect(result.Spec.Error).To(BeEmpty())
+	})
+
+	It("should keep 100 results unless configured otherwise", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		Expect(resultsLimit(experiment)).To(Equal(100))
+		experiment.Spec.ResultsLimit = ptr.To[int32](0)
+		Expect(resultsLimit(experiment)).To(Equal(0))
+	})
+})
@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const (
+	// defaultBackoffBase is the delay before the first retry when
+	// spec.retryPolicy.backoffBase is not set.
+	defaultBackoffBase = 30 * time.Second
+	// defaultBackoffCap bounds the delay between retries when
+	// spec.retryPolicy.backoffCap is not set.
+	defaultBackoffCap = 10 * time.Minute
+)
+
+// retryBackoff returns how long to wait before retrying after the given
+// number of consecutive failures: the base delay, doubled for every failure
+// after the first, up to the cap.
+func retryBackoff(experiment *chaosv1alpha1.ChaosExperiment, failures int32) time.Duration {
+	base, limit := defaultBackoffBase, defaultBackoffCap
+	if policy := experiment.Spec.RetryPolicy; policy != nil {
+		if policy.BackoffBase != nil && policy.BackoffBase.Duration > 0 {
+			base = policy.BackoffBase.Duration
+		}
+		if policy.BackoffCap != nil && policy.BackoffCap.Duration > 0 {
+			limit = policy.BackoffCap.Duration
+		}
+	}
+	backoff := base
+	for i := int32(1); i < failures && backoff < limit; i++ {
+		backoff *= 2
+	}
+	return min(backoff, limit)
+}
+
+// countFailure records a failed attempt in the experiment status and returns
+// how long to wait before the next one.
+func countFailure(experiment *chaosv1alpha1.ChaosExperiment) time.Duration {
+	experiment.Status.ConsecutiveFailures++
+	return retryBackoff(experiment, experiment.Status.ConsecutiveFailures)
+}
+
+// retriesExhausted reports whether the experiment has failed as often in a
+// row as spec.retryPolicy.maxFailures allows. Experiments that wait for the
+// cause of their failures to be fixed never run out of retries.
+func retriesExhausted(experiment *chaosv1alpha1.ChaosExperiment) bool {
+	policy := experiment.Spec.RetryPolicy
+	if failurePolicy(experiment) == chaosv1alpha1.WaitOnFailure {
+		return false
+	}
+	return policy != nil && policy.MaxFailures != nil && experiment.Status.ConsecutiveFailures >= *policy.MaxFailures
+}
+
+// invalidSpecReasons are the reasons for failing an experiment that mean its
+// spec cannot work as it is, so that it fails for good whatever
+// spec.failurePolicy says.
+var invalidSpecReasons = map[string]bool{
+	"InvalidAttackSpec":       true,
+	"InvalidSchedule":         true,
+	"InvalidTarget":           true,
+	"TargetProtected":         true,
+	"PrometheusNotConfigured": true,
+	"UnsupportedAttackType":   true,
+}
+
+// transientError reports whether err is likely to go away by itself, like a
+// timeout or an API server that is briefly unavailable. Errors without a
+// reason from the API server, such as connection failures, count as
+// transient; denied access, missing objects and rejected requests do not.
+func transientError(err error) bool {
+	switch errors.ReasonForError(err) {
+	case metav1.StatusReasonForbidden, metav1.StatusReasonUnauthorized, metav1.StatusReasonNotFound,
+		metav1.StatusReasonInvalid, metav1.StatusReasonBadRequest, metav1.StatusReasonMethodNotAllowed,
+		metav1.StatusReasonNotAcceptable, metav1.StatusReasonUnsupportedMediaType, metav1.StatusReasonRequestEntityTooLarge:
+		return false
+	}
+	return !meta.IsNoMatchError(err)
+}
+
+// failurePolicy returns spec.failurePolicy. It defaults to Terminate for
+// one-shot experiments and to Retry for recurring ones.
+func failurePolicy(experiment *chaosv1alpha1.ChaosExperiment) chaosv1alpha1.FailurePolicy {
+	if experiment.Spec.FailurePolicy != "" {
+		return experiment.Spec.FailurePolicy
+	}
+	if experiment.Spec.Mode == chaosv1alpha1.RecurringMode {
+		return chaosv1alpha1.RetryOnFailure
+	}
+	return chaosv1alpha1.TerminateOnFailure
+}
+
+// failOnError updates the phase of an experiment whose iteration failed with
+// err, for the given reason. Transient errors are always retried, others
+// are handled as spec.failurePolicy says. The error is still returned to the
+// controller, which retries after the backoff unless the experiment failed.
+func failOnError(experiment *chaosv1alpha1.ChaosExperiment, reason string, err error) {
+	if transientError(err) {
+		keepRetrying(experiment)
+		return
+	}
+	applyFailurePolicy(experiment, reason)
+}
+
+// applyFailurePolicy updates the phase of an experiment whose iteration failed
+// for the given reason as spec.failurePolicy says: Terminate fails it, Wait
+// pauses it for that reason and Retry keeps it going. Experiments whose spec
+// is invalid always fail.
+func applyFailurePolicy(experiment *chaosv1alpha1.ChaosExperiment, reason string) {
+	switch policy := failurePolicy(experiment); {
+	case invalidSpecReasons[reason], policy == chaosv1alpha1.TerminateOnFailure:
+		experiment.Status.Phase = chaosv1alpha1.ExperimentFailed
+		experiment.Status.Reason = reason
+		experiment.Status.NextScheduledTime = nil
+	case policy == chaosv1alpha1.WaitOnFailure:
+		pauseExperiment(experiment, reason)
+	default:
+		keepRetrying(experiment)
+	}
+}
+
+// keepRetrying keeps an experiment whose iteration failed going. Recurring
+// experiments keep running, and their lifetime counts, from their first
+// iteration on; one-shot experiments stay where they are.
+func keepRetrying(experiment *chaosv1alpha1.ChaosExperiment) {
+	if experiment.Spec.Mode != chaosv1alpha1.RecurringMode || experimentFinished(experiment) {
+		return
+	}
+	experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+	experiment.Status.Reason = ""
+	if experiment.Status.StartTime == nil {
+		now := metav1.Now()
+		experiment.Status.StartTime = &now
+	}
+}
+
+// retryFailures applies spec.retryPolicy to the outcome of a reconcile. A
+// failed reconcile is counted and retried after the backoff instead of the
+// rate limit of the controller, and once the failures run out the experiment
+// fails for good. Conflicts only need the experiment to be read again and are
+// not counted, and neither are failures while the experiment is deleted.
+func (r *ChaosExperimentReconciler) retryFailures(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, result ctrl.Result, err error) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !experiment.DeletionTimestamp.IsZero() || errors.IsConflict(err) || errors.IsNotFound(err) {
+		return result, err
+	}
+	if err != nil {
+		backoff := countFailure(experiment)
+		logger.Error(err, "Failed to reconcile ChaosExperiment, retrying after backoff", "ConsecutiveFailures", experiment.Status.ConsecutiveFailures, "RequeueAfter", backoff)
+		result = ctrl.Result{RequeueAfter: backoff}
+		if patchErr := r.patchStatus(ctx, experiment); patchErr != nil {
+			// Without the count the backoff cannot grow; leave it to the controller.
+			return ctrl.Result{}, err
+		}
+	}
+	if !retriesExhausted(experiment) || meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionRetriesExhausted) {
+		return result, nil
+	}
+
+	message := fmt.Sprintf("Experiment failed %d times in a row and is not retried any more.", experiment.Status.ConsecutiveFailures)
+	if experiment.Status.Message != "" {
+		message = fmt.Sprintf("%s Last failure: %s", message, experiment.Status.Message)
+	}
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               chaosv1alpha1.ConditionRetriesExhausted,
+		Status:             metav1.ConditionTrue,
+		Reason:             "MaxFailuresReached",
+		Message:            message,
+		ObservedGeneration: experiment.Generation,
+	})
+	experiment.Status.Phase = chaosv1alpha1.ExperimentFailed
+	experiment.Status.Reason = "MaxFailuresReached"
+	experiment.Status.Message = message
+	experiment.Status.NextScheduledTime = nil
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		logger.Error(err, "Failed to update ChaosExperiment status after its retries ran out")
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(experiment, "Warning", "RetriesExhausted", message)
+	r.notify(ctx, experiment, chaosv1alpha1.NotifyFailed, message)
+	return requeueForFaults(experiment, ctrl.Result{}), nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Retry policy", func() {
+	var c *statusRecorder
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		c = &statusRecorder{}
+		r = &ChaosExperimentReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "demo"}}
+		experiment.Spec.Mode = chaosv1alpha1.RecurringMode
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+	})
+
+	It("should double the backoff with every failure up to the cap", func() {
+		Expect(retryBackoff(experiment, 1)).To(Equal(30 * time.Second))
+		Expect(retryBackoff(experiment, 3)).To(Equal(2 * time.Minute))
+		Expect(retryBackoff(experiment, 30)).To(Equal(10 * time.Minute))
+
+		experiment.Spec.RetryPolicy = &chaosv1alpha1.RetryPolicy{
+			BackoffBase: &metav1.Duration{Duration: time.Second},
+			BackoffCap:  &metav1.Duration{Duration: 5 * time.Second},
+		}
+		Expect(retryBackoff(experiment, 2)).To(Equal(2 * time.Second))
+		Expect(retryBackoff(experiment, 4)).To(Equal(5 * time.Second))
+	})
+
+	It("should count failed reconciles and retry them after the backoff", func() {
+		result, err := r.retryFailures(context.Background(), experiment, ctrl.Result{RequeueAfter: time.Second}, fmt.Errorf("helper pod rejected"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+		Expect(experiment.Status.ConsecutiveFailures).To(BeEquivalentTo(1))
+		Expect(c.updates).To(Equal(1))
+
+		result, err = r.retryFailures(context.Background(), experiment, ctrl.Result{}, fmt.Errorf("helper pod rejected"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+	})
+
+	It("should leave conflicts to the controller", func() {
+		conflict := errors.NewConflict(schema.GroupResource{Resource: "chaosexperiments"}, "demo", fmt.Errorf("stale"))
+		_, err := r.retryFailures(context.Background(), experiment, ctrl.Result{}, conflict)
+		Expect(errors.IsConflict(err)).To(BeTrue())
+		Expect(experiment.Status.ConsecutiveFailures).To(BeZero())
+	})
+
+	It("should fail the experiment for good once the failures run out", func() {
+		experiment.Spec.RetryPolicy = &chaosv1alpha1.RetryPolicy{MaxFailures: ptr.To(int32(2))}
+		experiment.Status.ConsecutiveFailures = 1
+		experiment.Status.Message = "Failed to list target pods."
+
+		result, err := r.retryFailures(context.Background(), experiment, ctrl.Result{}, fmt.Errorf("connection refused"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentFailed))
+		Expect(meta.IsStatusConditionTrue(experiment.Status.Conditions, chaosv1alpha1.ConditionRetriesExhausted)).To(BeTrue())
+		Expect(experiment.Status.Message).To(ContainSubstring("Last failure: Failed to list target pods."))
+	})
+
+	It("should tell transient errors apart from terminal ones", func() {
+		pods := schema.GroupResource{Resource: "pods"}
+		Expect(transientError(errors.NewTimeoutError("list pods", 5))).To(BeTrue())
+		Expect(transientError(errors.NewServiceUnavailable("overloaded"))).To(BeTrue())
+		Expect(transientError(fmt.Errorf("listing pods: %w", errors.NewTooManyRequests("slow down", 1)))).To(BeTrue())
+		Expect(transientError(fmt.Errorf("connection refused"))).To(BeTrue())
+		Expect(transientError(errors.NewForbidden(pods, "", fmt.Errorf("RBAC denied")))).To(BeFalse())
+		Expect(transientError(errors.NewNotFound(pods, "web-0"))).To(BeFalse())
+		Expect(transientError(errors.NewBadRequest("invalid continue token"))).To(BeFalse())
+	})
+
+	It("should apply the failure policy to terminal failures only", func() {
+		Expect(failurePolicy(experiment)).To(Equal(chaosv1alpha1.RetryOnFailure))
+		failOnError(experiment, "PodListFailed", errors.NewTimeoutError("list pods", 5))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+		Expect(experiment.Status.StartTime).NotTo(BeNil())
+
+		experiment.Spec.FailurePolicy = chaosv1alpha1.WaitOnFailure
+		failOnError(experiment, "PodListFailed", errors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", fmt.Errorf("RBAC denied")))
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPaused))
+		Expect(experiment.Status.Reason).To(Equal("PodListFailed"))
+
+		applyFailurePolicy(experiment, "InvalidTarget")
+		Expect(experiment.Status.Phase).To(Equal(chaosv1alpha1.ExperimentFailed))
+		Expect(experiment.Status.Reason).To(Equal("InvalidTarget"))
+
+		oneShot := &chaosv1alpha1.ChaosExperiment{}
+		oneShot.Status.Phase = chaosv1alpha1.ExperimentPending
+		Expect(failurePolicy(oneShot)).To(Equal(chaosv1alpha1.TerminateOnFailure))
+		failOnError(oneShot, "PodListFailed", errors.NewServerTimeout(schema.GroupResource{Resource: "pods"}, "list", 1))
+		Expect(oneShot.Status.Phase).To(Equal(chaosv1alpha1.ExperimentPending))
+		failOnError(oneShot, "NodeGetFailed", errors.NewNotFound(schema.GroupResource{Resource: "nodes"}, "node-1"))
+		Expect(oneShot.Status.Phase).To(Equal(chaosv1alpha1.ExperimentFailed))
+		Expect(oneShot.Status.Reason).To(Equal("NodeGetFailed"))
+	})
+
+	It("should keep waiting experiments retrying past maxFailures", func() {
+		experiment.Spec.RetryPolicy = &chaosv1alpha1.RetryPolicy{MaxFailures: ptr.To(int32(1))}
+		experiment.Status.ConsecutiveFailures = 3
+		Expect(retriesExhausted(experiment)).To(BeTrue())
+		experiment.Spec.FailurePolicy = chaosv1alpha1.WaitOnFailure
+		Expect(retriesExhausted(experiment)).To(BeFalse())
+	})
+})
@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// runID returns the run ID of the iteration in progress, generating one the
+// first time it is asked for.
+func runID(experiment *chaosv1alpha1.ChaosExperiment) string {
+	if experiment.Status.RunID == "" {
+		experiment.Status.RunID = string(uuid.NewUUID())
+	}
+	return experiment.Status.RunID
+}
+
+// runAnnotations returns the annotations that tie an event to the iteration
+// in progress, or nil if none is.
+func runAnnotations(experiment *chaosv1alpha1.ChaosExperiment) map[string]string {
+	if experiment.Status.RunID == "" {
+		return nil
+	}
+	return map[string]string{RunIDLabel: experiment.Status.RunID}
+}
+
+// startRun writes the run ID of the iteration together with the targets it
+// is about to act on to status.lastIteration before it acts on them. Should
+// the iteration not get to record its outcome, the next reconcile finishes it
+// on the same targets instead of picking new ones.
+func (r *ChaosExperimentReconciler) startRun(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, targets []chaosv1alpha1.AffectedTarget) error {
+	experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{
+		Time:    metav1.Now(),
+		RunID:   runID(experiment),
+		Targets: targetNames(targets),
+	}
+	return r.patchStatus(ctx, experiment)
+}
+
+// startedRun returns what startRun wrote for the iteration in progress, and
+// nil if it has not started acting on its targets.
+func startedRun(experiment *chaosv1alpha1.ChaosExperiment) *chaosv1alpha1.IterationResult {
+	last := experiment.Status.LastIteration
+	if experiment.Status.RunID == "" || last == nil || last.RunID != experiment.Status.RunID {
+		return nil
+	}
+	return last
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// targetPodKiller serves Gets of the target pods it knows and records which
+// of them were deleted.
+type targetPodKiller struct {
+	*helperPodLister
+	pods    map[string]*corev1.Pod
+	deleted []string
+}
+
+func (c *targetPodKiller) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	pod, ok := c.pods[key.Name]
+	if !ok {
+		return errors.NewNotFound(schema.GroupResource{Resource: "pods"}, key.Name)
+	}
+	pod.DeepCopyInto(obj.(*corev1.Pod))
+	return nil
+}
+
+func (c *targetPodKiller) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	c.deleted = append(c.deleted, obj.GetName())
+	return nil
+}
+
+var _ = Describe("Run IDs", func() {
+	var c *targetPodKiller
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		c = &targetPodKiller{helperPodLister: &helperPodLister{statusRecorder: &statusRecorder{}}}
+		scheme := runtime.NewScheme()
+		Expect(chaosv1alpha1.AddToScheme(scheme)).To(Succeed())
+		r = &ChaosExperimentReconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(100)}
+		lastRunTime := metav1.NewTime(time.Now().Add(-time.Hour))
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "kill-web", Namespace: "chaos"}}
+		experiment.Spec.Attack.Type = chaosv1alpha1.PodKillAttack
+		experiment.Spec.Target.Namespace = "default"
+		experiment.Spec.ResultsLimit = ptr.To[int32](0)
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		experiment.Status.IterationsCompleted = 1
+		experiment.Status.LastRunTime = &lastRunTime
+	})
+
+	It("should give every iteration its own run ID", func() {
+		r.recordIteration(context.Background(), experiment, chaosv1alpha1.IterationSkipped, "Iteration skipped.", time.Now())
+		r.recordIteration(context.Background(), experiment, chaosv1alpha1.IterationSkipped, "Iteration skipped.", time.Now())
+
+		Expect(experiment.Status.History).To(HaveLen(2))
+		Expect(experiment.Status.History[0].RunID).NotTo(BeEmpty())
+		Expect(experiment.Status.History[1].RunID).NotTo(BeEmpty())
+		Expect(experiment.Status.History[0].RunID).NotTo(Equal(experiment.Status.History[1].RunID))
+		Expect(experiment.Status.RunID).To(BeEmpty())
+	})
+
+	It("should label helper pods and results with the run ID", func() {
+		id := runID(experiment)
+
+		pod, err := r.helperPodFor(experiment, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0"}}, "true")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pod.Labels).To(HaveKeyWithValue(RunIDLabel, id))
+
+		result := resultFor(experiment, appendHistory(experiment, chaosv1alpha1.IterationSucceeded, "", time.Now()))
+		Expect(result.Labels).To(HaveKeyWithValue(RunIDLabel, id))
+		Expect(result.Spec.RunID).To(Equal(id))
+	})
+
+	It("should record an iteration resumed from its helper pods under their run ID", func() {
+		experiment.Status.RunID = "run-2"
+		helper, err := r.helperPodFor(experiment, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0"}}, "true")
+		Expect(err).NotTo(HaveOccurred())
+		c.helpers = []corev1.Pod{*helper}
+		experiment.Status.RunID = ""
+
+		resumed, _, err := r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeTrue())
+		Expect(experiment.Status.History).To(HaveLen(1))
+		Expect(experiment.Status.History[0].RunID).To(Equal("run-2"))
+	})
+
+	It("should finish an interrupted pod kill on the pods it picked", func() {
+		started := metav1.NewTime(time.Now().Add(-time.Minute))
+		experiment.Status.RunID = "run-2"
+		experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{
+			Time:    started,
+			RunID:   "run-2",
+			Targets: []string{"default/web-0", "default/web-1", "default/web-2"},
+		}
+		c.pods = map[string]*corev1.Pod{
+			"web-0": {ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default", CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour))}},
+			"web-2": {ObjectMeta: metav1.ObjectMeta{Name: "web-2", Namespace: "default", CreationTimestamp: metav1.Now()}},
+			"web-3": {ObjectMeta: metav1.ObjectMeta{Name: "web-3", Namespace: "default", CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour))}},
+		}
+
+		resumed, _, err := r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeTrue())
+		Expect(c.deleted).To(Equal([]string{"web-0"}))
+		Expect(experiment.Status.IterationsCompleted).To(BeEquivalentTo(2))
+		Expect(experiment.Status.History).To(HaveLen(1))
+		Expect(experiment.Status.History[0].RunID).To(Equal("run-2"))
+		Expect(experiment.Status.History[0].Targets).To(ConsistOf("default/web-0", "default/web-1", "default/web-2"))
+		Expect(experiment.Status.RunID).To(BeEmpty())
+	})
+
+	It("should not resume a pod kill that never started", func() {
+		experiment.Status.RunID = "run-2"
+		experiment.Status.LastIteration = &chaosv1alpha1.IterationResult{Time: metav1.Now(), RunID: "run-1", Targets: []string{"default/web-0"}}
+
+		resumed, _, err := r.resumeIteration(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed).To(BeFalse())
+		Expect(c.deleted).To(BeEmpty())
+	})
+})
@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// defaultSafeguardWindow is the rolling window of spec.safeguards when it does
+// not set one.
+const defaultSafeguardWindow = time.Hour
+
+// safeguardWindow returns the rolling window of spec.safeguards.
+func safeguardWindow(experiment *chaosv1alpha1.ChaosExperiment) time.Duration {
+	if safeguards := experiment.Spec.Safeguards; safeguards != nil && safeguards.Window != nil && safeguards.Window.Duration > 0 {
+		return safeguards.Window.Duration
+	}
+	return defaultSafeguardWindow
+}
+
+// affectedBudget returns how many more pods of a target pool of the given
+// size the experiment may affect at now, and false if it is not limited.
+// Pods picked more than once within the window only count once.
+func affectedBudget(experiment *chaosv1alpha1.ChaosExperiment, pool int, now time.Time) (int, bool) {
+	safeguards := experiment.Spec.Safeguards
+	if safeguards == nil || safeguards.MaxAffectedPercentage == nil {
+		return 0, false
+	}
+
+	since := now.Add(-safeguardWindow(experiment))
+	affected := map[string]bool{}
+	for _, pod := range experiment.Status.AffectedPods {
+		if pod.Time.Time.After(since) {
+			affected[pod.Name] = true
+		}
+	}
+	allowed := pool * int(*safeguards.MaxAffectedPercentage) / 100
+	return max(allowed-len(affected), 0), true
+}
+
+// holdBackIteration records that a safeguard or budget holds the iteration
+// back: SafeguardsSatisfied turns false and the experiment is paused for the
+// same reason until it runs an iteration again.
+func holdBackIteration(experiment *chaosv1alpha1.ChaosExperiment, reason, message string) {
+	setCondition(experiment, chaosv1alpha1.ConditionSafeguardsSatisfied, metav1.ConditionFalse, reason, message)
+	pauseExperiment(experiment, reason)
+}
+
+// recordAffected adds the pods to status.affectedPods and drops the entries
+// that fell out of the window. Nothing is recorded without safeguards.
+func recordAffected(experiment *chaosv1alpha1.ChaosExperiment, pods []corev1.Pod, now time.Time) {
+	if experiment.Spec.Safeguards == nil {
+		experiment.Status.AffectedPods = nil
+		return
+	}
+
+	since := now.Add(-safeguardWindow(experiment))
+	kept := experiment.Status.AffectedPods[:0]
+	for _, pod := range experiment.Status.AffectedPods {
+		if pod.Time.Time.After(since) {
+			kept = append(kept, pod)
+		}
+	}
+	for _, pod := range pods {
+		kept = append(kept, chaosv1alpha1.AffectedPod{Name: pod.Namespace + "/" + pod.Name, Time: metav1.NewTime(now)})
+	}
+	experiment.Status.AffectedPods = kept
+}
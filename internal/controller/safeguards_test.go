@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Safeguards", func() {
+	pod := func(name string) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo"}}
+	}
+
+	It("should not limit experiments without safeguards", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		_, limited := affectedBudget(experiment, 10, time.Now())
+		Expect(limited).To(BeFalse())
+	})
+
+	It("should bound the pods affected within the rolling window", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Safeguards: &chaosv1alpha1.Safeguards{MaxAffectedPercentage: ptr.To[int32](30)},
+		}}
+		start := time.Now()
+		remaining, limited := affectedBudget(experiment, 10, start)
+		Expect(limited).To(BeTrue())
+		Expect(remaining).To(Equal(3))
+
+		recordAffected(experiment, []corev1.Pod{pod("a"), pod("b")}, start)
+		remaining, _ = affectedBudget(experiment, 10, start.Add(time.Minute))
+		Expect(remaining).To(Equal(1))
+
+		recordAffected(experiment, []corev1.Pod{pod("a"), pod("c")}, start.Add(time.Minute))
+		remaining, _ = affectedBudget(experiment, 10, start.Add(time.Minute))
+		Expect(remaining).To(Equal(0))
+
+		// The first two pods fall out of the window after an hour.
+		recordAffected(experiment, nil, start.Add(time.Hour+time.Second))
+		Expect(experiment.Status.AffectedPods).To(HaveLen(2))
+		remaining, _ = affectedBudget(experiment, 10, start.Add(time.Hour+time.Second))
+		Expect(remaining).To(Equal(1))
+	})
+})
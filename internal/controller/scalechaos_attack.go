@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileScaleChaosAttack scales a Deployment or StatefulSet down and
+// records the original replica count as an active fault, so it is restored
+// when the window ends, the experiment is deleted or the controller restarts.
+func (r *ChaosExperimentReconciler) reconcileScaleChaosAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "ScaleChaos")
+
+	spec := experiment.Spec.Attack.ScaleChaos
+	if spec == nil {
+		spec = &chaosv1alpha1.ScaleChaosAttackSpec{}
+	}
+
+	workload, kind, result, err := r.targetWorkload(ctx, experiment, spec.Kind, spec.Name)
+	if workload == nil {
+		return result, err
+	}
+	namespace, name := workload.GetNamespace(), workload.GetName()
+
+	// When the workload is still scaled down from an earlier iteration, keep
+	// scaling relative to the replica count captured back then.
+	original := *workloadReplicas(workload)
+	if existing := findFault(experiment, chaosv1alpha1.ScaleChaosAttack, kind, namespace, name); existing != nil {
+		recorded, err := strconv.ParseInt(existing.Original, 10, 32)
+		if err == nil {
+			original = int32(recorded)
+		}
+	}
+	replicas := int32(0)
+	if spec.ScaleDownBy != nil {
+		replicas = max(original-*spec.ScaleDownBy, 0)
+	}
+
+	now := metav1.Now()
+	revertAt := metav1.NewTime(now.Add(attackDuration(experiment, spec.Duration)))
+	if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+		Attack:     chaosv1alpha1.ScaleChaosAttack,
+		Kind:       kind,
+		Namespace:  namespace,
+		Name:       name,
+		Original:   strconv.Itoa(int(original)),
+		InjectedAt: now,
+		RevertAt:   revertAt,
+	}); err != nil {
+		logger.Error(err, "Failed to record scale-down in ChaosExperiment status", "Kind", kind, "Name", name)
+		return ctrl.Result{}, err
+	}
+
+	patch := client.MergeFromWithOptions(workload.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+	*workloadReplicas(workload) = replicas
+	if err := r.targets(ctx).Patch(ctx, workload, patch); err != nil {
+		logger.Error(err, "Failed to scale workload", "Kind", kind, "Namespace", namespace, "Name", name)
+		failOnError(experiment, "ScaleFailed", err)
+		experiment.Status.Message = "Failed to scale target workload."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "ScaleFailed", "Failed to scale %s %s/%s", kind, namespace, name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after scale error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Scaled workload down", "Kind", kind, "Namespace", namespace, "Name", name, "From", original, "To", replicas, "RevertAt", revertAt)
+	r.Recorder.Eventf(experiment, "Normal", "WorkloadScaledDown", "%s %s/%s was scaled from %d to %d replica(s) until %s.",
+		kind, namespace, name, original, replicas, revertAt.Format(time.RFC3339))
+
+	return r.completeAttackIteration(ctx, experiment, "Scale-chaos attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget(kind, namespace, name)})
+}
+
+// revertScaleChaos restores the replica count recorded in the fault.
+func (r *ChaosExperimentReconciler) revertScaleChaos(ctx context.Context, fault chaosv1alpha1.InjectedFault) error {
+	original, err := strconv.ParseInt(fault.Original, 10, 32)
+	if err != nil {
+		return err
+	}
+	workload, err := newScalableWorkload(fault.Kind)
+	if err != nil {
+		return err
+	}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: fault.Namespace, Name: fault.Name}, workload); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	patch := client.MergeFromWithOptions(workload.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+	*workloadReplicas(workload) = int32(original)
+	return r.Patch(ctx, workload, patch)
+}
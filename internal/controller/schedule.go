@@ -0,0 +1,440 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// cronSchedule is a parsed standard five-field cron expression. Each field is
+// a bit set of the values it matches.
+type cronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// Like cron, a day matches either field when both day fields are
+	// restricted, and only the restricted one otherwise.
+	dayOfMonthAny, dayOfWeekAny bool
+}
+
+// cronField describes the range and names of a cron field.
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinute     = cronField{min: 0, max: 59}
+	cronHour       = cronField{min: 0, max: 23}
+	cronDayOfMonth = cronField{min: 1, max: 31}
+	cronMonth      = cronField{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	cronDayOfWeek = cronField{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// cronMacros are the supported shorthands for common schedules.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseCronSchedule parses a cron expression with the fields minute, hour,
+// day of month, month and day of week, as accepted by Kubernetes CronJobs.
+func parseCronSchedule(spec string) (*cronSchedule, error) {
+	if macro, ok := cronMacros[strings.ToLower(strings.TrimSpace(spec))]; ok {
+		spec = macro
+	}
+	parts := strings.Fields(spec)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, found %d: %q", len(parts), spec)
+	}
+
+	schedule := &cronSchedule{
+		dayOfMonthAny: parts[2] == "*" || parts[2] == "?",
+		dayOfWeekAny:  parts[4] == "*" || parts[4] == "?",
+	}
+	for i, field := range []struct {
+		bits *uint64
+		cronField
+	}{
+		{&schedule.minute, cronMinute},
+		{&schedule.hour, cronHour},
+		{&schedule.dayOfMonth, cronDayOfMonth},
+		{&schedule.month, cronMonth},
+		{&schedule.dayOfWeek, cronDayOfWeek},
+	} {
+		bits, err := field.parse(parts[i])
+		if err != nil {
+			return nil, err
+		}
+		*field.bits = bits
+	}
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	return schedule, nil
+}
+
+// parse parses a comma separated list of values, ranges and steps.
+func (f cronField) parse(expr string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q in %q", stepExpr, expr)
+			}
+		}
+
+		low, high := f.min, f.max
+		if rangeExpr != "*" && rangeExpr != "?" {
+			lowExpr, highExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if low, err = f.value(lowExpr); err != nil {
+				return 0, err
+			}
+			high = low
+			if isRange {
+				if high, err = f.value(highExpr); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				high = f.max
+			}
+			if high < low {
+				return 0, fmt.Errorf("invalid range %q in %q", rangeExpr, expr)
+			}
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// value parses a single number or name of the field.
+func (f cronField) value(expr string) (int, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %q out of range [%d, %d]", expr, f.min, f.max)
+	}
+	return v, nil
+}
+
+// next returns the first time after t that matches the schedule, in the
+// location of t, or the zero time if there is none within five years.
+func (s *cronSchedule) next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Truncate(time.Minute).Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.dayOfMonthAny && s.dayOfWeekAny:
+		return true
+	case s.dayOfMonthAny:
+		return dayOfWeek
+	case s.dayOfWeekAny:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
+
+// waitForStart reports whether an experiment that has not run yet may start,
+// given spec.startAfter or spec.startTime. If it may not, the wait is recorded
+// in the status message and the result to hand back to the controller, which
+// requeues until the start point, is returned.
+func (r *ChaosExperimentReconciler) waitForStart(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var start time.Time
+	switch {
+	case experiment.Status.StartTime != nil:
+		return true, ctrl.Result{}, nil
+	case experiment.Spec.StartTime != nil:
+		start = experiment.Spec.StartTime.Time
+	case experiment.Spec.StartAfter != nil:
+		start = experiment.CreationTimestamp.Add(experiment.Spec.StartAfter.Duration)
+	}
+	if !start.After(time.Now()) {
+		return true, ctrl.Result{}, nil
+	}
+
+	message := fmt.Sprintf("Waiting to start at %s.", start.UTC().Format(time.RFC3339))
+	if experiment.Status.Message != message {
+		experiment.Status.Message = message
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to record start delay of ChaosExperiment")
+			return false, ctrl.Result{}, err
+		}
+	}
+	logger.Info("Experiment is not due to start yet", "Experiment", experiment.Name, "StartTime", start)
+	return false, ctrl.Result{RequeueAfter: time.Until(start)}, nil
+}
+
+// experimentSchedule parses the schedule of an experiment and loads the time
+// zone it is interpreted in.
+func experimentSchedule(experiment *chaosv1alpha1.ChaosExperiment) (*cronSchedule, *time.Location, error) {
+	schedule, err := parseCronSchedule(experiment.Spec.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", experiment.Spec.Schedule, err)
+	}
+	location, err := experimentLocation(experiment)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule, location, nil
+}
+
+// experimentLocation loads the time zone of an experiment, UTC by default.
+func experimentLocation(experiment *chaosv1alpha1.ChaosExperiment) (*time.Location, error) {
+	return loadLocation(experiment.Spec.TimeZone)
+}
+
+// loadLocation loads a time zone given by its IANA name, UTC by default.
+func loadLocation(tz *string) (*time.Location, error) {
+	if tz == nil {
+		return time.UTC, nil
+	}
+	// "Local" would depend on where the operator happens to run.
+	if *tz == "Local" {
+		return nil, fmt.Errorf("invalid time zone %q", *tz)
+	}
+	location, err := time.LoadLocation(*tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", *tz, err)
+	}
+	return location, nil
+}
+
+// weekdays maps the Weekday names of the API to time.Weekday.
+var weekdays = map[chaosv1alpha1.Weekday]time.Weekday{
+	"Sun": time.Sunday, "Mon": time.Monday, "Tue": time.Tuesday, "Wed": time.Wednesday,
+	"Thu": time.Thursday, "Fri": time.Friday, "Sat": time.Saturday,
+}
+
+// windowBounds returns when the window opens and closes if it opens on day,
+// which must be a midnight.
+func windowBounds(window chaosv1alpha1.TimeWindow, day time.Time) (time.Time, time.Time, bool) {
+	if len(window.Days) > 0 && !slices.ContainsFunc(window.Days, func(d chaosv1alpha1.Weekday) bool {
+		return weekdays[d] == day.Weekday()
+	}) {
+		return time.Time{}, time.Time{}, false
+	}
+	var startHour, startMinute, endHour, endMinute int
+	if _, err := fmt.Sscanf(window.Start, "%d:%d", &startHour, &startMinute); err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	if _, err := fmt.Sscanf(window.End, "%d:%d", &endHour, &endMinute); err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	open := time.Date(day.Year(), day.Month(), day.Day(), startHour, startMinute, 0, 0, day.Location())
+	closing := time.Date(day.Year(), day.Month(), day.Day(), endHour, endMinute, 0, 0, day.Location())
+	if !closing.After(open) {
+		closing = closing.AddDate(0, 0, 1)
+	}
+	return open, closing, true
+}
+
+// nextWindowOpen returns the zero time if t lies inside one of the windows,
+// and otherwise when the next window opens, or the zero time as well if no
+// window ever opens. Windows are interpreted in the location of t.
+func nextWindowOpen(windows []chaosv1alpha1.TimeWindow, t time.Time) (time.Time, bool) {
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	var next time.Time
+	// Overnight windows that opened yesterday may still be open; a week later
+	// every window has opened again.
+	for i := -1; i <= 7; i++ {
+		day := today.AddDate(0, 0, i)
+		for _, window := range windows {
+			open, closing, ok := windowBounds(window, day)
+			if !ok {
+				continue
+			}
+			if !t.Before(open) && t.Before(closing) {
+				return time.Time{}, true
+			}
+			if open.After(t) && (next.IsZero() || open.Before(next)) {
+				next = open
+			}
+		}
+	}
+	return next, false
+}
+
+// waitForWindow reports whether the current time lies within one of the
+// allowed windows of the experiment. If it does not, the deferral is recorded
+// in the status message and the result to hand back to the controller, which
+// requeues until the next window opens, is returned.
+func (r *ChaosExperimentReconciler) waitForWindow(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	location, err := experimentLocation(experiment)
+	if err != nil {
+		result, err := r.failExperiment(ctx, experiment, "InvalidSchedule", err.Error())
+		return false, result, err
+	}
+	next, open := nextWindowOpen(experiment.Spec.AllowedWindows, time.Now().In(location))
+	if open {
+		return true, ctrl.Result{}, nil
+	}
+	if next.IsZero() {
+		result, err := r.failExperiment(ctx, experiment, "InvalidSchedule", "None of the allowed windows ever opens.")
+		return false, result, err
+	}
+
+	message := fmt.Sprintf("Outside of the allowed windows, deferred until %s.", next.Format(time.RFC3339))
+	if experiment.Status.Message != message {
+		experiment.Status.Message = message
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to record deferral of ChaosExperiment")
+			return false, ctrl.Result{}, err
+		}
+		r.Recorder.Event(experiment, "Normal", "IterationDeferred", message)
+	}
+	logger.Info("Experiment is outside of its allowed windows", "Experiment", experiment.Name, "NextWindow", next)
+	return false, requeueForFaults(experiment, ctrl.Result{RequeueAfter: time.Until(next)}), nil
+}
+
+// waitForSchedule reports whether a scheduled experiment is due to run an
+// iteration, i.e. whether a scheduled time has passed since the last run, or
+// since the experiment was created if it has not run yet. If it is not due,
+// status.nextScheduledTime is brought up to date and the result to hand back
+// to the controller is returned.
+func (r *ChaosExperimentReconciler) waitForSchedule(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	schedule, location, err := experimentSchedule(experiment)
+	if err != nil {
+		result, err := r.failExperiment(ctx, experiment, "InvalidSchedule", err.Error())
+		return false, result, err
+	}
+
+	since := experiment.CreationTimestamp.Time
+	if experiment.Status.LastRunTime != nil {
+		since = experiment.Status.LastRunTime.Time
+	}
+	next := schedule.next(since.In(location))
+	if next.IsZero() {
+		result, err := r.failExperiment(ctx, experiment, "InvalidSchedule", fmt.Sprintf("Schedule %q never fires.", experiment.Spec.Schedule))
+		return false, result, err
+	}
+	if !next.After(time.Now()) {
+		return true, ctrl.Result{}, nil
+	}
+
+	if experiment.Status.NextScheduledTime == nil || !experiment.Status.NextScheduledTime.Time.Equal(next) {
+		experiment.Status.NextScheduledTime = &metav1.Time{Time: next}
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update next scheduled time of ChaosExperiment")
+			return false, ctrl.Result{}, err
+		}
+	}
+	logger.Info("Scheduled experiment is not due yet", "Experiment", experiment.Name, "NextScheduledTime", next)
+	return false, requeueForFaults(experiment, ctrl.Result{RequeueAfter: time.Until(next)}), nil
+}
+
+// waitForInterval reports whether the next iteration of a recurring
+// experiment without a schedule is due, as recorded in
+// status.nextScheduledTime by the previous iteration. If it is not, the
+// result to hand back to the controller is returned. Experiments that still
+// use spec.duration as their interval are warned about it with every
+// iteration; experiments with neither fail.
+func (r *ChaosExperimentReconciler) waitForInterval(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if _, ok := recurrenceInterval(experiment); !ok {
+		result, err := r.failExperiment(ctx, experiment, "InvalidSchedule", "Recurring experiments require spec.interval or spec.schedule.")
+		return false, result, err
+	}
+	next := experiment.Status.NextScheduledTime
+	if next == nil || !next.After(time.Now()) {
+		if durationAsInterval(experiment) {
+			r.Recorder.Event(experiment, "Warning", "DeprecatedInterval", "spec.duration is used as the interval because spec.interval is not set; this is deprecated, set spec.interval instead.")
+		}
+		return true, ctrl.Result{}, nil
+	}
+	logger.Info("Recurring experiment is not due yet", "Experiment", experiment.Name, "NextScheduledTime", next)
+	return false, requeueForFaults(experiment, ctrl.Result{RequeueAfter: capToLifetime(experiment, time.Until(next.Time))}), nil
+}
+
+// capToLifetime shortens d so that an experiment that has started is
+// reconciled again no later than the end of its lifetime, to complete it.
+func capToLifetime(experiment *chaosv1alpha1.ChaosExperiment, d time.Duration) time.Duration {
+	lifetime, ok := experimentLifetime(experiment)
+	if !ok || experiment.Status.StartTime == nil {
+		return d
+	}
+	return min(d, max(lifetime-time.Since(experiment.Status.StartTime.Time), time.Second))
+}
+
+// nextScheduledRun records the next scheduled time after now in the status of
+// a scheduled experiment and returns how long it is until then.
+func nextScheduledRun(experiment *chaosv1alpha1.ChaosExperiment, now time.Time) (time.Duration, bool) {
+	schedule, location, err := experimentSchedule(experiment)
+	if err != nil {
+		return 0, false
+	}
+	next := schedule.next(now.In(location))
+	if next.IsZero() {
+		return 0, false
+	}
+	experiment.Status.NextScheduledTime = &metav1.Time{Time: next}
+	return next.Sub(now), true
+}
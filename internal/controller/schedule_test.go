@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Cron schedules", func() {
+	next := func(spec string, from time.Time) time.Time {
+		schedule, err := parseCronSchedule(spec)
+		Expect(err).NotTo(HaveOccurred())
+		return schedule.next(from)
+	}
+	// Wednesday.
+	from := time.Date(2025, time.March, 12, 10, 30, 15, 0, time.UTC)
+
+	It("should find the next matching minute", func() {
+		Expect(next("*/15 * * * *", from)).To(Equal(time.Date(2025, time.March, 12, 10, 45, 0, 0, time.UTC)))
+		Expect(next("30 10 * * *", from)).To(Equal(time.Date(2025, time.March, 13, 10, 30, 0, 0, time.UTC)))
+		Expect(next("@hourly", from)).To(Equal(time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)))
+	})
+
+	It("should support weekdays, months and names", func() {
+		Expect(next("0 10 * * 1-5", time.Date(2025, time.March, 14, 11, 0, 0, 0, time.UTC))).
+			To(Equal(time.Date(2025, time.March, 17, 10, 0, 0, 0, time.UTC)))
+		Expect(next("0 9 1 jun *", from)).To(Equal(time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)))
+		Expect(next("0 0 * * 7", from)).To(Equal(time.Date(2025, time.March, 16, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should match either day field when both are restricted", func() {
+		Expect(next("0 0 20 * fri", from)).To(Equal(time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should reject invalid expressions", func() {
+		for _, spec := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "* * * foo *"} {
+			_, err := parseCronSchedule(spec)
+			Expect(err).To(HaveOccurred(), spec)
+		}
+	})
+
+	It("should give up on schedules that never fire", func() {
+		Expect(next("0 0 30 feb *", from).IsZero()).To(BeTrue())
+	})
+
+	It("should interpret the schedule in the configured time zone", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		experiment.Spec.Schedule = "0 10 * * 1-5"
+		experiment.Spec.TimeZone = ptr.To("America/New_York")
+		schedule, location, err := experimentSchedule(experiment)
+		Expect(err).NotTo(HaveOccurred())
+		// 10:00 EDT is 14:00 UTC.
+		Expect(schedule.next(from.In(location)).UTC()).To(Equal(time.Date(2025, time.March, 12, 14, 0, 0, 0, time.UTC)))
+
+		experiment.Spec.TimeZone = ptr.To("Mars/Olympus_Mons")
+		_, _, err = experimentSchedule(experiment)
+		Expect(err).To(HaveOccurred())
+		experiment.Spec.TimeZone = ptr.To("Local")
+		_, _, err = experimentSchedule(experiment)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should only open allowed windows at the configured times", func() {
+		windows := []chaosv1alpha1.TimeWindow{
+			{Days: []chaosv1alpha1.Weekday{"Mon", "Tue", "Wed", "Thu"}, Start: "10:00", End: "16:00"},
+			{Days: []chaosv1alpha1.Weekday{"Sat"}, Start: "22:00", End: "02:00"},
+		}
+		_, open := nextWindowOpen(windows, from)
+		Expect(open).To(BeTrue())
+
+		next, open := nextWindowOpen(windows, time.Date(2025, time.March, 12, 16, 0, 0, 0, time.UTC))
+		Expect(open).To(BeFalse())
+		Expect(next).To(Equal(time.Date(2025, time.March, 13, 10, 0, 0, 0, time.UTC)))
+
+		// Thursday evening waits for the overnight window on Saturday.
+		next, open = nextWindowOpen(windows, time.Date(2025, time.March, 13, 17, 0, 0, 0, time.UTC))
+		Expect(open).To(BeFalse())
+		Expect(next).To(Equal(time.Date(2025, time.March, 15, 22, 0, 0, 0, time.UTC)))
+
+		// The overnight window is still open early on Sunday.
+		_, open = nextWindowOpen(windows, time.Date(2025, time.March, 16, 1, 30, 0, 0, time.UTC))
+		Expect(open).To(BeTrue())
+		next, open = nextWindowOpen(windows, time.Date(2025, time.March, 16, 2, 0, 0, 0, time.UTC))
+		Expect(open).To(BeFalse())
+		Expect(next).To(Equal(time.Date(2025, time.March, 17, 10, 0, 0, 0, time.UTC)))
+	})
+})
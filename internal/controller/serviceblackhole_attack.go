@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// blackholeSelectorKey is the only selector key of a blackholed Service.
+const blackholeSelectorKey = "chaos.shanto.dev/blackhole"
+
+// reconcileServiceBlackholeAttack swaps the selector of a Service for one
+// that matches no pods. The endpoint controllers then empty its
+// EndpointSlices, so consumers get connection refusals while every backend
+// pod keeps running. The original selector is restored when the window ends.
+func (r *ChaosExperimentReconciler) reconcileServiceBlackholeAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("AttackType", "ServiceBlackhole")
+
+	spec := experiment.Spec.Attack.ServiceBlackhole
+	if spec == nil || spec.ServiceName == "" {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "service-blackhole requires attack.serviceBlackhole.serviceName.")
+	}
+
+	namespace := experiment.Spec.Target.Namespace
+	if allowed, result, err := r.authorizeAttack(ctx, experiment, []chaosv1alpha1.AffectedTarget{objectTarget("Service", namespace, spec.ServiceName)}); !allowed {
+		return result, err
+	}
+	service := &corev1.Service{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: spec.ServiceName}, service); err != nil {
+		if errors.IsNotFound(err) {
+			return r.failExperiment(ctx, experiment, "ServiceNotFound", fmt.Sprintf("Service %s/%s not found.", namespace, spec.ServiceName))
+		}
+		logger.Error(err, "Failed to get service", "Namespace", namespace, "Name", spec.ServiceName)
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	if len(service.Spec.Selector) == 0 {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", fmt.Sprintf("Service %s/%s has no selector to blackhole.", namespace, spec.ServiceName))
+	}
+	blackhole := blackholeSelector(experiment)
+
+	// Only capture the selector when it is not already blackholed by an
+	// earlier iteration, which would lose the original one.
+	original := ""
+	if !maps.Equal(service.Spec.Selector, blackhole) {
+		encoded, err := json.Marshal(service.Spec.Selector)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		original = string(encoded)
+	}
+	if original == "" && findFault(experiment, chaosv1alpha1.ServiceBlackholeAttack, "Service", namespace, service.Name) == nil {
+		return r.failExperiment(ctx, experiment, "ServiceAlreadyBlackholed", fmt.Sprintf("Service %s/%s is blackholed but its original selector is unknown.", namespace, service.Name))
+	}
+
+	now := metav1.Now()
+	revertAt := metav1.NewTime(now.Add(attackDuration(experiment, spec.Duration)))
+	if err := r.recordFault(ctx, experiment, chaosv1alpha1.InjectedFault{
+		Attack:     chaosv1alpha1.ServiceBlackholeAttack,
+		Kind:       "Service",
+		Namespace:  namespace,
+		Name:       service.Name,
+		Original:   original,
+		InjectedAt: now,
+		RevertAt:   revertAt,
+	}); err != nil {
+		logger.Error(err, "Failed to record service selector in ChaosExperiment status", "Namespace", namespace, "Name", service.Name)
+		return ctrl.Result{}, err
+	}
+
+	patch := client.MergeFromWithOptions(service.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	service.Spec.Selector = blackhole
+	if err := r.targets(ctx).Patch(ctx, service, patch); err != nil {
+		logger.Error(err, "Failed to blackhole service", "Namespace", namespace, "Name", service.Name)
+		failOnError(experiment, "ServiceBlackholeFailed", err)
+		experiment.Status.Message = "Failed to blackhole target service."
+		r.recordIteration(ctx, experiment, chaosv1alpha1.IterationFailed, experiment.Status.Message, time.Now())
+		r.Recorder.Eventf(experiment, "Warning", "ServiceBlackholeFailed", "Failed to blackhole service %s/%s", namespace, service.Name)
+		if err := r.patchStatus(ctx, experiment); err != nil {
+			logger.Error(err, "Failed to update ChaosExperiment status to Failed after service patch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+
+	logger.Info("Blackholed service", "Namespace", namespace, "Name", service.Name, "RevertAt", revertAt)
+	r.Recorder.Eventf(experiment, "Normal", "ServiceBlackholed", "Service %s/%s has no endpoints until %s.", namespace, service.Name, revertAt.Format(time.RFC3339))
+
+	return r.completeAttackIteration(ctx, experiment, "Service-blackhole attack executed.", []chaosv1alpha1.AffectedTarget{objectTarget("Service", namespace, service.Name)})
+}
+
+// revertServiceBlackhole restores the selector recorded in the fault, as long
+// as the Service still carries the blackhole selector.
+func (r *ChaosExperimentReconciler) revertServiceBlackhole(ctx context.Context, fault chaosv1alpha1.InjectedFault) error {
+	selector := map[string]string{}
+	if err := json.Unmarshal([]byte(fault.Original), &selector); err != nil {
+		return err
+	}
+
+	service := &corev1.Service{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: fault.Namespace, Name: fault.Name}, service); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if _, ok := service.Spec.Selector[blackholeSelectorKey]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(service.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	service.Spec.Selector = selector
+	return r.Patch(ctx, service, patch)
+}
+
+// blackholeSelector returns a selector no pod carries.
+func blackholeSelector(experiment *chaosv1alpha1.ChaosExperiment) map[string]string {
+	return map[string]string{blackholeSelectorKey: experiment.Name}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// SimulatedRun is an iteration a simulated experiment would run.
+type SimulatedRun struct {
+	// Time is when the iteration would start, before any jitter.
+	Time time.Time
+	// Targets are the pods or objects the iteration would affect.
+	Targets []string
+	// Skipped explains why the iteration would not affect anything.
+	Skipped string
+}
+
+// Simulation is what an experiment would do if it were created.
+type Simulation struct {
+	// Blocked explains why the experiment would not run at all.
+	Blocked string
+	// Runs are the iterations the experiment would run, in order.
+	Runs []SimulatedRun
+}
+
+// Simulate works out which targets the first runs iterations of an experiment
+// created at now would affect and when, honoring its start delay, schedule,
+// allowed windows and safeguards. The cluster is only read: writes made while
+// selecting targets are dropped and events are discarded.
+func (r *ChaosExperimentReconciler) Simulate(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, now time.Time, runs int) (*Simulation, error) {
+	experiment = experiment.DeepCopy()
+	experiment.CreationTimestamp = metav1.NewTime(now)
+	experiment.Status = chaosv1alpha1.ChaosExperimentStatus{Phase: chaosv1alpha1.ExperimentRunning}
+	// Iterations that fail to select targets would notify otherwise.
+	experiment.Spec.Notifications = nil
+
+	simulator := &ChaosExperimentReconciler{
+		Client:                readOnlyClient{Client: r.Client},
+		Scheme:                r.Scheme,
+		Recorder:              &record.FakeRecorder{},
+		HelperImage:           r.HelperImage,
+		ProtectedNamespaces:   r.ProtectedNamespaces,
+		RequireNamespaceOptIn: r.RequireNamespaceOptIn,
+	}
+
+	simulation := &Simulation{}
+	if slices.Contains(simulator.ProtectedNamespaces, experiment.Spec.Target.Namespace) {
+		simulation.Blocked = "Namespace " + experiment.Spec.Target.Namespace + " is protected by the operator and cannot be targeted."
+		return simulation, nil
+	}
+	blocked, err := simulator.reconcileNamespaceOptIn(ctx, experiment)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		simulation.Blocked = meta.FindStatusCondition(experiment.Status.Conditions, chaosv1alpha1.ConditionBlocked).Message
+		return simulation, nil
+	}
+	if experiment.Spec.Suspend {
+		simulation.Blocked = "The experiment is suspended."
+		return simulation, nil
+	}
+
+	times, err := simulatedRunTimes(experiment, now, runs)
+	if err != nil {
+		simulation.Blocked = err.Error()
+		return simulation, nil
+	}
+	for i, at := range times {
+		if i > 0 {
+			// Pods are recorded as affected at the wall clock time, so age
+			// them by the time between the iterations for the safeguards.
+			gap := at.Sub(times[i-1])
+			for j := range experiment.Status.AffectedPods {
+				experiment.Status.AffectedPods[j].Time = metav1.NewTime(experiment.Status.AffectedPods[j].Time.Add(-gap))
+			}
+		}
+		experiment.Status.Message = ""
+		targets, _, err := simulator.dryRunTargets(ctx, experiment)
+		if err != nil {
+			return nil, err
+		}
+		run := SimulatedRun{Time: at, Targets: targets}
+		if targets == nil {
+			run.Skipped = experiment.Status.Message
+		}
+		simulation.Runs = append(simulation.Runs, run)
+		if experiment.Status.Phase == chaosv1alpha1.ExperimentFailed {
+			break
+		}
+	}
+	return simulation, nil
+}
+
+// simulatedRunTimes returns when the first runs iterations of an experiment
+// created at now would start. An error explains why it would never start.
+func simulatedRunTimes(experiment *chaosv1alpha1.ChaosExperiment, now time.Time, runs int) ([]time.Time, error) {
+	location, err := experimentLocation(experiment)
+	if err != nil {
+		return nil, err
+	}
+	if limit := experiment.Spec.MaxIterations; limit != nil {
+		runs = min(runs, int(*limit))
+	}
+
+	start := now
+	switch {
+	case experiment.Spec.StartTime != nil:
+		start = experiment.Spec.StartTime.Time
+	case experiment.Spec.StartAfter != nil:
+		start = now.Add(experiment.Spec.StartAfter.Duration)
+	}
+	start = latest(start, now)
+
+	var schedule *cronSchedule
+	if experiment.Spec.Schedule != "" {
+		if schedule, _, err = experimentSchedule(experiment); err != nil {
+			return nil, err
+		}
+	}
+	interval, recurring := recurrenceInterval(experiment)
+	lifetime, limited := experimentLifetime(experiment)
+	if experiment.Spec.Mode == chaosv1alpha1.RecurringMode && schedule == nil && !recurring {
+		return nil, fmt.Errorf("recurring experiments require interval or schedule")
+	}
+
+	var times []time.Time
+	var end time.Time
+	for at := start; len(times) < runs; {
+		if schedule != nil {
+			since := now
+			if len(times) > 0 {
+				since = times[len(times)-1]
+			}
+			next := schedule.next(since.In(location))
+			if next.IsZero() {
+				if len(times) == 0 {
+					return nil, fmt.Errorf("schedule %q never fires", experiment.Spec.Schedule)
+				}
+				break
+			}
+			at = latest(next, at)
+		}
+		if len(experiment.Spec.AllowedWindows) > 0 {
+			next, open := nextWindowOpen(experiment.Spec.AllowedWindows, at.In(location))
+			if !open && next.IsZero() {
+				return nil, fmt.Errorf("none of the allowed windows ever opens")
+			}
+			if !open {
+				at = next
+			}
+		}
+		if len(times) == 0 && limited {
+			end = at.Add(lifetime)
+		}
+		if !end.IsZero() && at.After(end) {
+			break
+		}
+		times = append(times, at)
+		if schedule == nil && !recurring {
+			break
+		}
+		if schedule == nil {
+			at = at.Add(interval)
+		}
+	}
+	return times, nil
+}
+
+// latest returns the later of two times.
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// readOnlyClient reads through the wrapped client and drops all writes.
+type readOnlyClient struct {
+	client.Client
+}
+
+func (readOnlyClient) Create(context.Context, client.Object, ...client.CreateOption) error {
+	return nil
+}
+
+func (readOnlyClient) Update(context.Context, client.Object, ...client.UpdateOption) error {
+	return nil
+}
+
+func (readOnlyClient) Patch(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+	return nil
+}
+
+func (readOnlyClient) Apply(context.Context, runtime.ApplyConfiguration, ...client.ApplyOption) error {
+	return nil
+}
+
+func (readOnlyClient) Delete(context.Context, client.Object, ...client.DeleteOption) error {
+	return nil
+}
+
+func (readOnlyClient) DeleteAllOf(context.Context, client.Object, ...client.DeleteAllOfOption) error {
+	return nil
+}
+
+func (c readOnlyClient) Status() client.SubResourceWriter {
+	return readOnlySubResource{SubResourceReader: c.Client.SubResource("status")}
+}
+
+func (c readOnlyClient) SubResource(subResource string) client.SubResourceClient {
+	return readOnlySubResource{SubResourceReader: c.Client.SubResource(subResource)}
+}
+
+// readOnlySubResource reads a subresource through the wrapped client and
+// drops all writes.
+type readOnlySubResource struct {
+	client.SubResourceReader
+}
+
+func (readOnlySubResource) Create(context.Context, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+	return nil
+}
+
+func (readOnlySubResource) Update(context.Context, client.Object, ...client.SubResourceUpdateOption) error {
+	return nil
+}
+
+func (readOnlySubResource) Patch(context.Context, client.Object, client.Patch, ...client.SubResourcePatchOption) error {
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Simulation", func() {
+	now := time.Date(2025, time.March, 3, 10, 30, 0, 0, time.UTC) // a Monday
+
+	It("should run one-shot experiments once, after their start delay", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			StartAfter: &metav1.Duration{Duration: 10 * time.Minute},
+		}}
+		times, err := simulatedRunTimes(experiment, now, 5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(times).To(Equal([]time.Time{now.Add(10 * time.Minute)}))
+	})
+
+	It("should repeat recurring experiments until their lifetime ends", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode:     chaosv1alpha1.RecurringMode,
+			Interval: &metav1.Duration{Duration: 20 * time.Minute},
+			Duration: &metav1.Duration{Duration: 45 * time.Minute},
+		}}
+		times, err := simulatedRunTimes(experiment, now, 5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(times).To(Equal([]time.Time{now, now.Add(20 * time.Minute), now.Add(40 * time.Minute)}))
+
+		experiment.Spec.MaxIterations = ptr.To[int32](2)
+		times, err = simulatedRunTimes(experiment, now, 5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(times).To(HaveLen(2))
+	})
+
+	It("should follow the schedule and defer runs to the allowed windows", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode:     chaosv1alpha1.RecurringMode,
+			Schedule: "0 * * * *",
+			AllowedWindows: []chaosv1alpha1.TimeWindow{
+				{Days: []chaosv1alpha1.Weekday{"Mon"}, Start: "11:00", End: "12:30"},
+			},
+		}}
+		times, err := simulatedRunTimes(experiment, now, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(times).To(Equal([]time.Time{
+			time.Date(2025, time.March, 3, 11, 0, 0, 0, time.UTC),
+			time.Date(2025, time.March, 3, 12, 0, 0, 0, time.UTC),
+			time.Date(2025, time.March, 10, 11, 0, 0, 0, time.UTC),
+		}))
+	})
+
+	It("should explain schedules that never fire", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Mode:     chaosv1alpha1.RecurringMode,
+			Schedule: "0 0 31 2 *",
+		}}
+		_, err := simulatedRunTimes(experiment, now, 3)
+		Expect(err).To(MatchError(ContainSubstring("never fires")))
+	})
+})
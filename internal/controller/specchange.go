@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// specHash identifies the parts of a spec that decide what a run does.
+// Suspending an experiment and the limits of its history and results are left
+// out, so that changing them does not restart the run.
+func specHash(spec chaosv1alpha1.ChaosExperimentSpec) string {
+	spec.Suspend = false
+	spec.HistoryLimit = nil
+	spec.ResultsLimit = nil
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// reconcileSpecChange records the generation of the spec the operator acts on
+// and restarts an experiment whose spec was changed after it started running,
+// so that no run mixes the old parameters with the new ones. It reports
+// whether the experiment was restarted.
+func (r *ChaosExperimentReconciler) reconcileSpecChange(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	if experiment.Status.ObservedGeneration == experiment.Generation {
+		return false, nil
+	}
+	previous := experiment.Status.SpecHash
+	hash := specHash(experiment.Spec)
+	experiment.Status.ObservedGeneration = experiment.Generation
+	experiment.Status.SpecHash = hash
+	if previous == "" || previous == hash || experiment.Status.Phase == chaosv1alpha1.ExperimentPending {
+		return false, r.patchStatus(ctx, experiment)
+	}
+
+	logger.Info("Spec of ChaosExperiment changed, restarting it", "Experiment", experiment.Name, "Generation", experiment.Generation)
+	// Helper pods undo their changes when they are terminated.
+	if _, err := r.stopHelperPods(ctx, experiment); err != nil {
+		return false, err
+	}
+	if err := r.revertFaults(ctx, experiment, true); err != nil {
+		return false, err
+	}
+
+	experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+	experiment.Status.Reason = ""
+	experiment.Status.Message = "Spec changed, experiment restarted."
+	experiment.Status.StartTime = nil
+	experiment.Status.LastRunTime = nil
+	experiment.Status.NextScheduledTime = nil
+	experiment.Status.CompletionTime = nil
+	experiment.Status.IterationsCompleted = 0
+	experiment.Status.RunID = ""
+	experiment.Status.ConsecutiveFailures = 0
+	experiment.Status.HypothesisCheckTime = nil
+	experiment.Status.DuringProbeTime = nil
+	experiment.Status.ReportRef = nil
+	experiment.Status.Verdict = ""
+	experiment.Status.ProbeResults = nil
+	experiment.Status.LastIteration = nil
+	experiment.Status.LastAffectedTargets = nil
+	setCondition(experiment, chaosv1alpha1.ConditionCompleted, metav1.ConditionFalse, "Restarted", experiment.Status.Message)
+	meta.RemoveStatusCondition(&experiment.Status.Conditions, chaosv1alpha1.ConditionRetriesExhausted)
+	if err := r.patchStatus(ctx, experiment); err != nil {
+		return false, err
+	}
+	r.Recorder.Eventf(experiment, "Normal", "ExperimentRestarted", "ChaosExperiment was restarted because its spec changed (generation %d).", experiment.Generation)
+	r.notify(ctx, experiment, chaosv1alpha1.NotifyRestarted, experiment.Status.Message)
+	return true, nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Spec changes", func() {
+	spec := chaosv1alpha1.ChaosExperimentSpec{
+		Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack},
+		Target: chaosv1alpha1.ExperimentTarget{Namespace: "demo"},
+	}
+
+	It("should not restart experiments that are suspended or resumed", func() {
+		changed := spec
+		changed.Suspend = true
+		changed.HistoryLimit = ptr.To[int32](5)
+		changed.ResultsLimit = ptr.To[int32](5)
+		Expect(specHash(changed)).To(Equal(specHash(spec)))
+	})
+
+	It("should restart experiments whose attack or target changes", func() {
+		changed := spec
+		changed.Attack.Type = chaosv1alpha1.ContainerKillAttack
+		Expect(specHash(changed)).NotTo(Equal(specHash(spec)))
+
+		changed = spec
+		changed.Target.Namespace = "staging"
+		Expect(specHash(changed)).NotTo(Equal(specHash(spec)))
+	})
+})
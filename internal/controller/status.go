@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// statusBaseKey is the context key of the statusBase of a reconcile.
+type statusBaseKey struct{}
+
+// statusBase is the ChaosExperiment being reconciled as it was last read
+// from or written to the API server.
+type statusBase struct {
+	experiment *chaosv1alpha1.ChaosExperiment
+}
+
+// withStatusBase returns a context that remembers the experiment as read
+// from the API server, so that patchStatus only sends what changed since.
+func withStatusBase(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) context.Context {
+	return context.WithValue(ctx, statusBaseKey{}, &statusBase{experiment: experiment.DeepCopy()})
+}
+
+// patchStatus writes the status of the experiment with a merge patch of the
+// changes made since it was last read or written. Unlike an update, the
+// patch does not carry the resourceVersion, so it does not fail when the
+// experiment changed in between, e.g. when its spec was edited. Outside of a
+// reconcile the status is updated.
+func (r *ChaosExperimentReconciler) patchStatus(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) error {
+	base, _ := ctx.Value(statusBaseKey{}).(*statusBase)
+	if base == nil || base.experiment.UID != experiment.UID {
+		return r.Status().Update(ctx, experiment)
+	}
+	// Metadata is ignored by the status subresource, but a differing
+	// resourceVersion would make the patch conditional.
+	base.experiment.ResourceVersion = experiment.ResourceVersion
+	if err := r.Status().Patch(ctx, experiment, client.MergeFrom(base.experiment)); err != nil {
+		return err
+	}
+	base.experiment = experiment.DeepCopy()
+	return nil
+}
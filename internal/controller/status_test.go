@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// statusRecorder records the status writes made through it.
+type statusRecorder struct {
+	client.Client
+	patches []string
+	updates int
+	// err fails every status write.
+	err error
+}
+
+func (c *statusRecorder) Status() client.SubResourceWriter { return statusRecorderWriter{c} }
+
+type statusRecorderWriter struct {
+	*statusRecorder
+}
+
+func (w statusRecorderWriter) Create(context.Context, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+	return nil
+}
+
+func (w statusRecorderWriter) Update(context.Context, client.Object, ...client.SubResourceUpdateOption) error {
+	w.updates++
+	return w.err
+}
+
+func (w statusRecorderWriter) Patch(_ context.Context, obj client.Object, patch client.Patch, _ ...client.SubResourcePatchOption) error {
+	data, err := patch.Data(obj)
+	w.patches = append(w.patches, string(data))
+	if err != nil {
+		return err
+	}
+	return w.err
+}
+
+var _ = Describe("Status writes", func() {
+	var c *statusRecorder
+	var r *ChaosExperimentReconciler
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		c = &statusRecorder{}
+		r = &ChaosExperimentReconciler{Client: c}
+		experiment = &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "demo", UID: "1", ResourceVersion: "1"}}
+		experiment.Status.Phase = chaosv1alpha1.ExperimentPending
+	})
+
+	It("should only patch what changed since the last write", func() {
+		ctx := withStatusBase(context.Background(), experiment)
+
+		experiment.Status.Phase = chaosv1alpha1.ExperimentRunning
+		Expect(r.patchStatus(ctx, experiment)).To(Succeed())
+		experiment.Status.Message = "Attacking."
+		Expect(r.patchStatus(ctx, experiment)).To(Succeed())
+
+		Expect(c.updates).To(BeZero())
+		Expect(c.patches).To(Equal([]string{
+			`{"status":{"phase":"Running"}}`,
+			`{"status":{"message":"Attacking."}}`,
+		}))
+	})
+
+	It("should not make the patch conditional on a changed resourceVersion", func() {
+		ctx := withStatusBase(context.Background(), experiment)
+		experiment.ResourceVersion = "2"
+		experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+		Expect(r.patchStatus(ctx, experiment)).To(Succeed())
+		Expect(c.patches).To(Equal([]string{`{"status":{"phase":"Completed"}}`}))
+	})
+
+	It("should update experiments it has no base for", func() {
+		Expect(r.patchStatus(context.Background(), experiment)).To(Succeed())
+		Expect(c.updates).To(Equal(1))
+		Expect(c.patches).To(BeEmpty())
+	})
+})
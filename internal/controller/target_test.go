@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Target selection", func() {
+	It("should convert the deprecated label selector map", func() {
+		target := chaosv1alpha1.ExperimentTarget{LabelSelector: map[string]string{"app": "web"}}
+		selector, err := target.PodSelector()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector.Matches(labels.Set{"app": "web", "tier": "backend"})).To(BeTrue())
+		Expect(selector.Matches(labels.Set{"app": "db"})).To(BeFalse())
+	})
+
+	It("should support match expressions", func() {
+		target := chaosv1alpha1.ExperimentTarget{Selector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"backend", "worker"}},
+				{Key: "track", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"canary"}},
+			},
+		}}
+		selector, err := target.PodSelector()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector.Matches(labels.Set{"tier": "worker"})).To(BeTrue())
+		Expect(selector.Matches(labels.Set{"tier": "worker", "track": "canary"})).To(BeFalse())
+		Expect(selector.Matches(labels.Set{"tier": "frontend"})).To(BeFalse())
+	})
+
+	It("should refuse selectors that match every pod", func() {
+		_, err := (&chaosv1alpha1.ExperimentTarget{Selector: &metav1.LabelSelector{}}).PodSelector()
+		Expect(err).To(HaveOccurred())
+		_, err = (&chaosv1alpha1.ExperimentTarget{}).PodSelector()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should size the blast radius by percentage", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		Expect(targetPodCount(experiment, 10)).To(Equal(1))
+
+		experiment.Spec.Target.Percentage = ptr.To[int32](30)
+		Expect(targetPodCount(experiment, 10)).To(Equal(3))
+		Expect(targetPodCount(experiment, 11)).To(Equal(4))
+		Expect(targetPodCount(experiment, 1)).To(Equal(1))
+
+		experiment.Spec.Target.Percentage = ptr.To[int32](100)
+		Expect(targetPodCount(experiment, 7)).To(Equal(7))
+	})
+
+	It("should leave protected and excluded pods alone", func() {
+		pods := []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Labels: map[string]string{"app": "web"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "web-2", Labels: map[string]string{"app": "web"},
+				Annotations: map[string]string{ProtectAnnotation: "true"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "web-3", Labels: map[string]string{"app": "web", "role": "leader"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "web-4", Labels: map[string]string{"app": "web"},
+				Annotations: map[string]string{ProtectAnnotation: "false"}}},
+		}
+		target := chaosv1alpha1.ExperimentTarget{ExcludeLabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"role": "leader"},
+		}}
+		exclude, err := target.ExcludeSelector()
+		Expect(err).NotTo(HaveOccurred())
+
+		kept := withoutProtectedPods(pods, exclude)
+		Expect(kept).To(HaveLen(2))
+		Expect(kept[0].Name).To(Equal("web-1"))
+		Expect(kept[1].Name).To(Equal("web-4"))
+	})
+
+	It("should order pods by the selection strategy", func() {
+		now := time.Now()
+		pod := func(name string, age time.Duration) corev1.Pod {
+			return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))}}
+		}
+		names := func(pods []corev1.Pod) []string {
+			var names []string
+			for _, p := range pods {
+				names = append(names, p.Name)
+			}
+			return names
+		}
+		pods := []corev1.Pod{pod("web-b", time.Hour), pod("web-c", time.Minute), pod("web-a", 2*time.Hour)}
+
+		orderPods(chaosv1alpha1.SelectOldest, pods, "")
+		Expect(names(pods)).To(Equal([]string{"web-a", "web-b", "web-c"}))
+		orderPods(chaosv1alpha1.SelectNewest, pods, "")
+		Expect(names(pods)).To(Equal([]string{"web-c", "web-b", "web-a"}))
+
+		orderPods(chaosv1alpha1.SelectRoundRobin, pods, "")
+		Expect(names(pods)).To(Equal([]string{"web-a", "web-b", "web-c"}))
+		orderPods(chaosv1alpha1.SelectRoundRobin, pods, "web-a")
+		Expect(names(pods)).To(Equal([]string{"web-b", "web-c", "web-a"}))
+		// The last picked pod is gone; continue with the next name after it.
+		orderPods(chaosv1alpha1.SelectRoundRobin, pods, "web-bb")
+		Expect(names(pods)).To(Equal([]string{"web-c", "web-a", "web-b"}))
+		orderPods(chaosv1alpha1.SelectRoundRobin, pods, "web-c")
+		Expect(names(pods)).To(Equal([]string{"web-a", "web-b", "web-c"}))
+	})
+
+	It("should replay the random selection of a seed", func() {
+		podsIn := func(names ...string) []corev1.Pod {
+			var pods []corev1.Pod
+			for _, name := range names {
+				pods = append(pods, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}})
+			}
+			return pods
+		}
+		pick := func(seed *int64, iterations int32, pods []corev1.Pod) *chaosv1alpha1.ChaosExperiment {
+			experiment := &chaosv1alpha1.ChaosExperiment{}
+			experiment.Spec.Seed = seed
+			experiment.Status.IterationsCompleted = iterations
+			(&ChaosExperimentReconciler{}).selectPods(experiment, pods, 3, selectionRand(experiment))
+			return experiment
+		}
+
+		first := pick(ptr.To[int64](42), 0, podsIn("web-a", "web-b", "web-c", "web-d", "web-e"))
+		Expect(first.Status.Seed).To(HaveValue(BeEquivalentTo(42)))
+		Expect(first.Status.SelectionOrder).To(HaveLen(3))
+		// The same pods, listed in another order, in another environment.
+		replay := pick(ptr.To[int64](42), 0, podsIn("web-e", "web-c", "web-a", "web-d", "web-b"))
+		Expect(replay.Status.SelectionOrder).To(Equal(first.Status.SelectionOrder))
+
+		// Iterations of the same run pick differently.
+		orders := map[string]bool{}
+		for i := range int32(10) {
+			orders[strings.Join(pick(ptr.To[int64](42), i, podsIn("web-a", "web-b", "web-c", "web-d", "web-e")).Status.SelectionOrder, ",")] = true
+		}
+		Expect(len(orders)).To(BeNumerically(">", 1))
+
+		// Without a seed one is picked and kept for the following iterations.
+		unseeded := pick(nil, 0, podsIn("web-a", "web-b", "web-c"))
+		Expect(unseeded.Status.Seed).NotTo(BeNil())
+		seed := *unseeded.Status.Seed
+		selectionRand(unseeded)
+		Expect(unseeded.Status.Seed).To(HaveValue(Equal(seed)))
+	})
+
+	It("should filter pods by phase and readiness", func() {
+		pod := func(phase corev1.PodPhase, ready corev1.ConditionStatus) *corev1.Pod {
+			return &corev1.Pod{Status: corev1.PodStatus{
+				Phase:      phase,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			}}
+		}
+		runningReady := []chaosv1alpha1.TargetPodCondition{chaosv1alpha1.PodRunning, chaosv1alpha1.PodReady}
+		Expect(podMeetsConditions(pod(corev1.PodRunning, corev1.ConditionTrue), runningReady)).To(BeTrue())
+		Expect(podMeetsConditions(pod(corev1.PodRunning, corev1.ConditionFalse), runningReady)).To(BeFalse())
+		Expect(podMeetsConditions(pod(corev1.PodPending, corev1.ConditionFalse), runningReady)).To(BeFalse())
+
+		terminating := pod(corev1.PodRunning, corev1.ConditionTrue)
+		terminating.DeletionTimestamp = ptr.To(metav1.Now())
+		Expect(podMeetsConditions(terminating, runningReady)).To(BeFalse())
+
+		notReady := []chaosv1alpha1.TargetPodCondition{chaosv1alpha1.PodNotReady}
+		Expect(podMeetsConditions(pod(corev1.PodRunning, corev1.ConditionFalse), notReady)).To(BeTrue())
+		Expect(podMeetsConditions(&corev1.Pod{}, notReady)).To(BeTrue())
+		Expect(podMeetsConditions(pod(corev1.PodRunning, corev1.ConditionTrue), notReady)).To(BeFalse())
+	})
+
+	It("should match pods by field selector", func() {
+		selector, err := parsePodFieldSelector("spec.nodeName=node-3,status.phase!=Pending")
+		Expect(err).NotTo(HaveOccurred())
+		pod := &corev1.Pod{
+			Spec:   corev1.PodSpec{NodeName: "node-3"},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		Expect(selector.Matches(podFields(pod))).To(BeTrue())
+		pod.Status.Phase = corev1.PodPending
+		Expect(selector.Matches(podFields(pod))).To(BeFalse())
+
+		selector, err = parsePodFieldSelector("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector).To(BeNil())
+		_, err = parsePodFieldSelector("spec.priority=10")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// reconcileTimeSkewAttack shifts the wall clock of every process of a target
+// container. The helper image's watchmaker patches the vDSO of the running
+// processes, so neither the node clock nor other containers are affected, and
+// resets the offset when the skew window ends or the helper is terminated.
+func (r *ChaosExperimentReconciler) reconcileTimeSkewAttack(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) (ctrl.Result, error) {
+	spec := experiment.Spec.Attack.TimeSkew
+	if spec == nil || spec.Offset.Duration == 0 {
+		return r.failExperiment(ctx, experiment, "InvalidAttackSpec", "time-skew requires a non-zero attack.timeSkew.offset.")
+	}
+	offset := spec.Offset.Duration
+	timeout := attackDuration(experiment, spec.Duration)
+
+	return r.reconcileContainerAttack(ctx, experiment, spec.ContainerName, func(containerID string) string {
+		return containerPIDsScript(containerID) + timeSkewScript(offset, timeout)
+	}, "TimeSkewed", fmt.Sprintf("had its clock shifted by %s for %s", offset, timeout))
+}
+
+// timeSkewScript returns a shell snippet that shifts CLOCK_REALTIME of every
+// process in $pids by the offset for the given duration.
+func timeSkewScript(offset, d time.Duration) string {
+	return fmt.Sprintf(`skew() { for pid in $pids; do watchmaker -pid $pid -sec_delta $1 -nsec_delta $2 -clk_ids CLOCK_REALTIME; done; }
+trap 'skew 0 0' EXIT
+trap 'exit 0' INT TERM
+skew %d %d
+sleep %d & wait
+`, int64(offset/time.Second), int64(offset%time.Second), int64(d.Seconds()))
+}
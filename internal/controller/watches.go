@@ -0,0 +1,148 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// experimentChanged lets through the experiment events that need a
+// reconcile. Status updates, most of which the controller writes itself, are
+// filtered out: they would otherwise start the next iteration of a running
+// experiment before its interval is over. Annotations still count because
+// they carry abort requests.
+var experimentChanged = predicate.Or[client.Object](
+	predicate.GenerationChangedPredicate{},
+	predicate.AnnotationChangedPredicate{},
+	predicate.LabelChangedPredicate{},
+	predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetDeletionTimestamp().IsZero() && !e.ObjectNew.GetDeletionTimestamp().IsZero()
+		},
+	},
+)
+
+// helperPodFinished lets through the events of helper pods that stop running,
+// which may unblock experiments waiting for their helpers.
+var helperPodFinished = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return podRunning(e.ObjectOld) && !podRunning(e.ObjectNew)
+	},
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// targetPodAppeared lets through the events of pods that may have become a
+// target: new pods and pods whose labels changed.
+var targetPodAppeared = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return e.Object.GetLabels()[ExperimentLabel] == ""
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.ObjectNew.GetLabels()[ExperimentLabel] == "" && !maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+	},
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+// podRunning reports whether the pod has neither finished nor started
+// terminating.
+func podRunning(object client.Object) bool {
+	pod, ok := object.(*corev1.Pod)
+	if !ok {
+		return false
+	}
+	return pod.DeletionTimestamp == nil && pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
+// experimentsForPod maps a pod event to the experiments that are waiting for
+// targets and whose target selects the pod. Experiments that found their
+// targets are not enqueued; they pick up new pods in their next iteration.
+func (r *ChaosExperimentReconciler) experimentsForPod(ctx context.Context, object client.Object) []ctrl.Request {
+	logger := log.FromContext(ctx)
+
+	experiments := &chaosv1alpha1.ChaosExperimentList{}
+	if err := r.List(ctx, experiments, client.MatchingFields{targetNamespaceField: object.GetNamespace()}); err != nil {
+		logger.Error(err, "Failed to list ChaosExperiments for pod event", "Pod", client.ObjectKeyFromObject(object))
+		return nil
+	}
+	candidates := slices.DeleteFunc(experiments.Items, func(experiment chaosv1alpha1.ChaosExperiment) bool {
+		return experiment.Spec.Target.Workload != nil || !selectsPod(&experiment, object)
+	})
+
+	if pod, ok := object.(*corev1.Pod); ok {
+		kind, name, err := r.podWorkload(ctx, pod)
+		if err != nil {
+			logger.Error(err, "Failed to resolve workload of pod", "Pod", client.ObjectKeyFromObject(object))
+		} else if name != "" {
+			workloadExperiments := &chaosv1alpha1.ChaosExperimentList{}
+			if err := r.List(ctx, workloadExperiments, client.MatchingFields{targetWorkloadField: workloadKey(pod.Namespace, kind, name)}); err != nil {
+				logger.Error(err, "Failed to list ChaosExperiments for pod event", "Pod", client.ObjectKeyFromObject(object))
+			} else {
+				candidates = append(candidates, workloadExperiments.Items...)
+			}
+		}
+	}
+
+	var requests []ctrl.Request
+	for i := range candidates {
+		if waitingForTargets(&candidates[i]) {
+			requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&candidates[i])})
+		}
+	}
+	return requests
+}
+
+// podWorkload returns the kind and name of the workload that controls the
+// pod. An empty name means the pod is not managed by a workload that can be
+// targeted.
+func (r *ChaosExperimentReconciler) podWorkload(ctx context.Context, pod *corev1.Pod) (string, string, error) {
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return owner.Kind, owner.Name, nil
+	}
+	return r.owningWorkload(ctx, pod)
+}
+
+// waitingForTargets reports whether the last target lookup of the experiment
+// found no pods.
+func waitingForTargets(experiment *chaosv1alpha1.ChaosExperiment) bool {
+	return meta.IsStatusConditionFalse(experiment.Status.Conditions, chaosv1alpha1.ConditionTargetsFound)
+}
+
+// selectsPod reports whether the target selector of the experiment matches
+// the labels of the pod. It must not be used for workload targets.
+func selectsPod(experiment *chaosv1alpha1.ChaosExperiment, pod metav1.Object) bool {
+	selector, err := experiment.Spec.Target.PodSelector()
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(pod.GetLabels()))
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// experimentLister lists a fixed set of experiments, using the cache indexes
+// for field selectors like the cache does.
+type experimentLister struct {
+	client.Client
+	experiments []chaosv1alpha1.ChaosExperiment
+	replicaSets map[string]*appsv1.ReplicaSet
+}
+
+func (c *experimentLister) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	options := (&client.ListOptions{}).ApplyOptions(opts)
+	indexes := map[string]client.IndexerFunc{targetNamespaceField: targetNamespaceIndex, targetWorkloadField: targetWorkloadIndex}
+	var items []chaosv1alpha1.ChaosExperiment
+	for _, experiment := range c.experiments {
+		if options.FieldSelector == nil || options.FieldSelector.Matches(indexedFields(indexes, &experiment)) {
+			items = append(items, experiment)
+		}
+	}
+	list.(*chaosv1alpha1.ChaosExperimentList).Items = items
+	return nil
+}
+
+func (c *experimentLister) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	replicaSet, ok := c.replicaSets[key.Name]
+	if !ok {
+		return errors.NewNotFound(appsv1.Resource("replicasets"), key.Name)
+	}
+	replicaSet.DeepCopyInto(obj.(*appsv1.ReplicaSet))
+	return nil
+}
+
+// indexedFields returns the first value of each index for the object.
+func indexedFields(indexes map[string]client.IndexerFunc, obj client.Object) fields.Set {
+	set := fields.Set{}
+	for field, index := range indexes {
+		if values := index(obj); len(values) > 0 {
+			set[field] = values[0]
+		}
+	}
+	return set
+}
+
+var _ = Describe("Watches", func() {
+	experimentWith := func(name, namespace string, targetsFound metav1.ConditionStatus) chaosv1alpha1.ChaosExperiment {
+		experiment := chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "chaos"}}
+		experiment.Spec.Target = chaosv1alpha1.ExperimentTarget{
+			Namespace: namespace,
+			Selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		}
+		setCondition(&experiment, chaosv1alpha1.ConditionTargetsFound, targetsFound, "Test", "")
+		return experiment
+	}
+
+	It("should map a pod to the experiments waiting for it", func() {
+		workloadTarget := func(name, workload string) chaosv1alpha1.ChaosExperiment {
+			experiment := experimentWith(name, "default", metav1.ConditionFalse)
+			experiment.Spec.Target.Selector = nil
+			experiment.Spec.Target.Workload = &chaosv1alpha1.WorkloadReference{Kind: "Deployment", Name: workload}
+			return experiment
+		}
+		r := &ChaosExperimentReconciler{Client: &experimentLister{
+			experiments: []chaosv1alpha1.ChaosExperiment{
+				experimentWith("waiting", "default", metav1.ConditionFalse),
+				experimentWith("running", "default", metav1.ConditionTrue),
+				experimentWith("elsewhere", "other", metav1.ConditionFalse),
+				workloadTarget("workload", "web"),
+				workloadTarget("other-workload", "db"),
+			},
+			replicaSets: map[string]*appsv1.ReplicaSet{"web-abc": {ObjectMeta: metav1.ObjectMeta{
+				Name:            "web-abc",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web", Controller: ptr.To(true)}},
+			}}},
+		}}
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-abc-1",
+			Namespace:       "default",
+			Labels:          map[string]string{"app": "web"},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-abc", Controller: ptr.To(true)}},
+		}}
+
+		var names []string
+		for _, request := range r.experimentsForPod(context.Background(), pod) {
+			names = append(names, request.Name)
+		}
+		Expect(names).To(ConsistOf("waiting", "workload"))
+
+		pod.Labels["app"] = "db"
+		pod.OwnerReferences = nil
+		Expect(r.experimentsForPod(context.Background(), pod)).To(BeEmpty())
+	})
+
+	It("should ignore status updates of experiments but not abort requests", func() {
+		old := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "demo", Generation: 1}}
+		updated := old.DeepCopy()
+		updated.Status.Phase = chaosv1alpha1.ExperimentRunning
+		Expect(experimentChanged.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})).To(BeFalse())
+
+		updated.Annotations = map[string]string{chaosv1alpha1.AbortAnnotation: "stop"}
+		Expect(experimentChanged.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})).To(BeTrue())
+
+		deleted := old.DeepCopy()
+		now := metav1.Now()
+		deleted.DeletionTimestamp = &now
+		Expect(experimentChanged.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: deleted})).To(BeTrue())
+	})
+
+	It("should only pass pod events that matter", func() {
+		running := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "helper", Labels: map[string]string{ExperimentLabel: "demo"}}}
+		running.Status.Phase = corev1.PodRunning
+		finished := running.DeepCopy()
+		finished.Status.Phase = corev1.PodSucceeded
+		Expect(helperPodFinished.Update(event.UpdateEvent{ObjectOld: running, ObjectNew: running})).To(BeFalse())
+		Expect(helperPodFinished.Update(event.UpdateEvent{ObjectOld: running, ObjectNew: finished})).To(BeTrue())
+
+		Expect(targetPodAppeared.Create(event.CreateEvent{Object: running})).To(BeFalse())
+		target := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Labels: map[string]string{"app": "web"}}}
+		Expect(targetPodAppeared.Create(event.CreateEvent{Object: target})).To(BeTrue())
+		Expect(targetPodAppeared.Update(event.UpdateEvent{ObjectOld: target, ObjectNew: target.DeepCopy()})).To(BeFalse())
+	})
+})
@@ -0,0 +1,249 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// targetWorkload fetches the Deployment or StatefulSet an attack acts on: the
+// named workload in the target namespace or, when name is empty, the workload
+// owning a randomly picked target pod. The policy authorizes a named workload
+// and else the picked pod. If the workload cannot be resolved, the experiment
+// status is updated accordingly and a nil object is returned together with
+// the result the caller should hand back to the controller.
+func (r *ChaosExperimentReconciler) targetWorkload(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment, kind, name string) (client.Object, string, ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	named := name != ""
+	if !named {
+		target, result, err := r.pickTargetPod(ctx, experiment)
+		if target == nil {
+			return nil, "", result, err
+		}
+		kind, name, err = r.owningWorkload(ctx, target)
+		if err != nil {
+			logger.Error(err, "Failed to resolve workload of target pod", "PodName", target.Name)
+			return nil, "", ctrl.Result{RequeueAfter: time.Second * 30}, err
+		}
+		if name == "" {
+			result, err := r.failExperiment(ctx, experiment, "WorkloadNotFound", fmt.Sprintf("Pod %s/%s is not managed by a Deployment or StatefulSet.", target.Namespace, target.Name))
+			return nil, "", result, err
+		}
+	}
+	if kind == "" {
+		kind = "Deployment"
+	}
+
+	namespace := experiment.Spec.Target.Namespace
+	workload, err := newScalableWorkload(kind)
+	if err != nil {
+		result, err := r.failExperiment(ctx, experiment, "InvalidAttackSpec", err.Error())
+		return nil, "", result, err
+	}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, workload); err != nil {
+		if errors.IsNotFound(err) {
+			result, err := r.failExperiment(ctx, experiment, "WorkloadNotFound", fmt.Sprintf("%s %s/%s not found.", kind, namespace, name))
+			return nil, "", result, err
+		}
+		logger.Error(err, "Failed to get workload", "Kind", kind, "Namespace", namespace, "Name", name)
+		return nil, "", ctrl.Result{RequeueAfter: time.Second * 30}, err
+	}
+	if named {
+		if allowed, result, err := r.authorizeAttack(ctx, experiment, []chaosv1alpha1.AffectedTarget{objectTarget(kind, namespace, name)}); !allowed {
+			return nil, "", result, err
+		}
+	}
+	return workload, kind, ctrl.Result{}, nil
+}
+
+// owningWorkload follows the controller references of the pod up to the
+// Deployment or StatefulSet managing it. An empty name means the pod is not
+// managed by either.
+func (r *ChaosExperimentReconciler) owningWorkload(ctx context.Context, pod *corev1.Pod) (string, string, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "", "", nil
+	}
+	switch owner.Kind {
+	case "StatefulSet":
+		return owner.Kind, owner.Name, nil
+	case "ReplicaSet":
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: owner.Name}, replicaSet); err != nil {
+			return "", "", err
+		}
+		if owner := metav1.GetControllerOf(replicaSet); owner != nil && owner.Kind == "Deployment" {
+			return owner.Kind, owner.Name, nil
+		}
+	}
+	return "", "", nil
+}
+
+// newScalableWorkload returns an empty object of the given workload kind.
+func newScalableWorkload(kind string) (client.Object, error) {
+	switch kind {
+	case "Deployment":
+		return &appsv1.Deployment{}, nil
+	case "StatefulSet":
+		return &appsv1.StatefulSet{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported workload kind %q", kind)
+	}
+}
+
+// workloadReplicas returns a pointer to the replica count of a workload
+// returned by newScalableWorkload, defaulting it to 1 like the API server does.
+func workloadReplicas(workload client.Object) *int32 {
+	var replicas **int32
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		replicas = &w.Spec.Replicas
+	case *appsv1.StatefulSet:
+		replicas = &w.Spec.Replicas
+	}
+	if *replicas == nil {
+		*replicas = new(int32)
+		**replicas = 1
+	}
+	return *replicas
+}
+
+// workloadPodTemplate returns the pod template of a workload returned by
+// newScalableWorkload.
+func workloadPodTemplate(workload client.Object) *corev1.PodTemplateSpec {
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		return &w.Spec.Template
+	case *appsv1.StatefulSet:
+		return &w.Spec.Template
+	}
+	return nil
+}
+
+// getTargetWorkload fetches the workload referenced by a workload target.
+func (r *ChaosExperimentReconciler) getTargetWorkload(ctx context.Context, namespace string, ref *chaosv1alpha1.WorkloadReference) (client.Object, error) {
+	var workload client.Object
+	switch ref.Kind {
+	case "DaemonSet":
+		workload = &appsv1.DaemonSet{}
+	default:
+		var err error
+		if workload, err = newScalableWorkload(ref.Kind); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, workload); err != nil {
+		return nil, err
+	}
+	return workload, nil
+}
+
+// workloadPodSelector returns the pod selector of a workload returned by
+// getTargetWorkload.
+func workloadPodSelector(workload client.Object) (labels.Selector, error) {
+	var selector *metav1.LabelSelector
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		selector = w.Spec.Selector
+	case *appsv1.StatefulSet:
+		selector = w.Spec.Selector
+	case *appsv1.DaemonSet:
+		selector = w.Spec.Selector
+	}
+	if selector == nil {
+		return nil, fmt.Errorf("%s has no pod selector", workload.GetName())
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+// filterWorkloadPods keeps only the pods actually controlled by the workload,
+// so that pods of other workloads with overlapping labels are never targeted.
+// Deployments control their pods through ReplicaSets.
+func (r *ChaosExperimentReconciler) filterWorkloadPods(ctx context.Context, workload client.Object, pods []corev1.Pod) ([]corev1.Pod, error) {
+	owners := map[types.UID]bool{workload.GetUID(): true}
+	if _, ok := workload.(*appsv1.Deployment); ok {
+		replicaSets := &appsv1.ReplicaSetList{}
+		if err := r.List(ctx, replicaSets, client.InNamespace(workload.GetNamespace())); err != nil {
+			return nil, err
+		}
+		owners = map[types.UID]bool{}
+		for _, replicaSet := range replicaSets.Items {
+			if owner := metav1.GetControllerOf(&replicaSet); owner != nil && owner.UID == workload.GetUID() {
+				owners[replicaSet.UID] = true
+			}
+		}
+	}
+
+	owned := make([]corev1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if owner := metav1.GetControllerOf(&pod); owner != nil && owners[owner.UID] {
+			owned = append(owned, pod)
+		}
+	}
+	return owned, nil
+}
+
+// filterPodsByOwnerKind keeps the pods whose top-level controller is of the
+// given kind, or that have no controller when kind is "None". The ReplicaSets
+// are only listed if a pod is controlled by one, to tell Deployment pods apart.
+func (r *ChaosExperimentReconciler) filterPodsByOwnerKind(ctx context.Context, kind string, pods []corev1.Pod) ([]corev1.Pod, error) {
+	var deploymentReplicaSets map[types.UID]bool
+	kept := pods[:0]
+	for _, pod := range pods {
+		owner := metav1.GetControllerOf(&pod)
+		ownerKind := "None"
+		if owner != nil {
+			ownerKind = owner.Kind
+		}
+		if ownerKind == "ReplicaSet" {
+			if deploymentReplicaSets == nil {
+				replicaSets := &appsv1.ReplicaSetList{}
+				if err := r.List(ctx, replicaSets, client.InNamespace(pod.Namespace)); err != nil {
+					return nil, err
+				}
+				deploymentReplicaSets = map[types.UID]bool{}
+				for _, replicaSet := range replicaSets.Items {
+					if owner := metav1.GetControllerOf(&replicaSet); owner != nil && owner.Kind == "Deployment" {
+						deploymentReplicaSets[replicaSet.UID] = true
+					}
+				}
+			}
+			if deploymentReplicaSets[owner.UID] {
+				ownerKind = "Deployment"
+			}
+		}
+		if ownerKind == kind {
+			kept = append(kept, pod)
+		}
+	}
+	return kept, nil
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+var _ = Describe("Workloads", func() {
+	It("should default the replica count like the API server", func() {
+		deployment := &appsv1.Deployment{}
+		Expect(*workloadReplicas(deployment)).To(Equal(int32(1)))
+		*workloadReplicas(deployment) = 0
+		Expect(*deployment.Spec.Replicas).To(Equal(int32(0)))
+	})
+
+	It("should only create supported workload kinds", func() {
+		_, err := newScalableWorkload("StatefulSet")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = newScalableWorkload("DaemonSet")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should replace the tag or digest of an image", func() {
+		Expect(brokenImage("nginx")).To(Equal("nginx:" + brokenImageTag))
+		Expect(brokenImage("registry:5000/team/app:1.2")).To(Equal("registry:5000/team/app:" + brokenImageTag))
+		Expect(brokenImage("app@sha256:abc")).To(Equal("app:" + brokenImageTag))
+	})
+})
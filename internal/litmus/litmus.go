@@ -0,0 +1,478 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package litmus converts LitmusChaos ChaosEngine and ChaosExperiment
+// manifests into ChaosExperiments of this operator.
+//
+// The Litmus types only mirror the fields read from the manifests; no CRDs
+// are generated for them.
+// +kubebuilder:skip
+package litmus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/ptr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// Env is an environment variable tunable of a Litmus experiment.
+type Env struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Engine is the part of a Litmus ChaosEngine the converter reads.
+type Engine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		AppInfo struct {
+			AppNS    string `json:"appns"`
+			AppLabel string `json:"applabel"`
+			AppKind  string `json:"appkind"`
+		} `json:"appinfo"`
+		Experiments []struct {
+			Name string `json:"name"`
+			Spec struct {
+				Components struct {
+					Env []Env `json:"env"`
+				} `json:"components"`
+				Probe []Probe `json:"probe"`
+			} `json:"spec"`
+		} `json:"experiments"`
+	} `json:"spec"`
+}
+
+// Experiment is the part of a Litmus ChaosExperiment the converter reads.
+type Experiment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		Definition struct {
+			Env []Env `json:"env"`
+		} `json:"definition"`
+	} `json:"spec"`
+}
+
+// Probe is a Litmus resilience probe.
+type Probe struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Mode      string `json:"mode"`
+	HTTPProbe *struct {
+		URL    string `json:"url"`
+		Method struct {
+			Get *struct {
+				ResponseCode string `json:"responseCode"`
+			} `json:"get"`
+		} `json:"method"`
+	} `json:"httpProbe/inputs"`
+	PromProbe *struct {
+		Query      string     `json:"query"`
+		Comparator Comparator `json:"comparator"`
+	} `json:"promProbe/inputs"`
+	CmdProbe *struct {
+		Command string `json:"command"`
+		Source  *struct {
+			Image string `json:"image"`
+		} `json:"source"`
+	} `json:"cmdProbe/inputs"`
+	RunProperties struct {
+		ProbeTimeout string `json:"probeTimeout"`
+	} `json:"runProperties"`
+}
+
+// Comparator compares the result of a Litmus probe.
+type Comparator struct {
+	Criteria string `json:"criteria"`
+	Value    string `json:"value"`
+}
+
+// Result holds the converted experiments together with warnings about the
+// parts of the Litmus manifests that could not be carried over.
+type Result struct {
+	Experiments []chaosv1alpha1.ChaosExperiment
+	Warnings    []string
+}
+
+// Convert reads a stream of YAML or JSON manifests and converts every
+// experiment of every ChaosEngine in it. Litmus ChaosExperiments in the
+// stream provide the defaults of the tunables of the experiments of the same
+// name, which the engines override. Other manifests are ignored.
+func Convert(r io.Reader) (*Result, error) {
+	var engines []Engine
+	definitions := map[string][]Env{}
+
+	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var data json.RawMessage
+		if err := decoder.Decode(&data); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		var typeMeta metav1.TypeMeta
+		if len(data) == 0 || string(data) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(data, &typeMeta); err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(typeMeta.APIVersion, "litmuschaos.io/") {
+			continue
+		}
+		switch typeMeta.Kind {
+		case "ChaosEngine":
+			var engine Engine
+			if err := json.Unmarshal(data, &engine); err != nil {
+				return nil, fmt.Errorf("decoding ChaosEngine: %w", err)
+			}
+			engines = append(engines, engine)
+		case "ChaosExperiment":
+			var experiment Experiment
+			if err := json.Unmarshal(data, &experiment); err != nil {
+				return nil, fmt.Errorf("decoding ChaosExperiment: %w", err)
+			}
+			definitions[experiment.Name] = experiment.Spec.Definition.Env
+		}
+	}
+
+	result := &Result{}
+	for _, engine := range engines {
+		for _, litmusExperiment := range engine.Spec.Experiments {
+			env := envMap(definitions[litmusExperiment.Name])
+			for name, value := range envMap(litmusExperiment.Spec.Components.Env) {
+				env[name] = value
+			}
+			name := engine.Name + "-" + litmusExperiment.Name
+			experiment, warnings, err := convertExperiment(engine, litmusExperiment.Name, env, litmusExperiment.Spec.Probe)
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s: skipped: %v", name, err))
+				continue
+			}
+			experiment.Name = name
+			for _, warning := range warnings {
+				result.Warnings = append(result.Warnings, name+": "+warning)
+			}
+			result.Experiments = append(result.Experiments, *experiment)
+		}
+	}
+	return result, nil
+}
+
+// convertExperiment converts an experiment of a ChaosEngine, given its
+// tunables.
+func convertExperiment(engine Engine, name string, env map[string]string, probes []Probe) (*chaosv1alpha1.ChaosExperiment, []string, error) {
+	var warnings []string
+	experiment := &chaosv1alpha1.ChaosExperiment{
+		TypeMeta: metav1.TypeMeta{APIVersion: chaosv1alpha1.GroupVersion.String(), Kind: "ChaosExperiment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: engine.Namespace,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "litmus-import"},
+		},
+	}
+	spec := &experiment.Spec
+
+	namespace := engine.Spec.AppInfo.AppNS
+	if namespace == "" {
+		namespace = engine.Namespace
+	}
+	spec.Target.Namespace = namespace
+	selector, err := parseLabels(engine.Spec.AppInfo.AppLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+	spec.Target.LabelSelector = selector
+	if percentage, ok, err := intEnv(env, "PODS_AFFECTED_PERC"); err != nil {
+		return nil, nil, err
+	} else if ok && percentage > 0 {
+		spec.Target.Percentage = ptr.To(int32(percentage))
+	}
+
+	duration, _, err := secondsEnv(env, "TOTAL_CHAOS_DURATION")
+	if err != nil {
+		return nil, nil, err
+	}
+	container := env["TARGET_CONTAINER"]
+
+	switch name {
+	case "pod-delete":
+		spec.Attack.Type = chaosv1alpha1.PodKillAttack
+		spec.Attack.PodKill = &chaosv1alpha1.PodKillAttackSpec{}
+		if env["FORCE"] == "true" {
+			spec.Attack.PodKill.GracePeriodSeconds = ptr.To[int64](0)
+		}
+		interval, ok, err := secondsEnv(env, "CHAOS_INTERVAL")
+		if err != nil {
+			return nil, nil, err
+		}
+		spec.Duration = duration
+		if ok {
+			spec.Mode = chaosv1alpha1.RecurringMode
+			spec.Interval = interval
+		}
+	case "container-kill":
+		spec.Attack.Type = chaosv1alpha1.ContainerKillAttack
+		spec.Attack.ContainerKill = &chaosv1alpha1.ContainerKillAttackSpec{ContainerName: container}
+	case "pod-cpu-hog":
+		spec.Attack.Type = chaosv1alpha1.CPUStressAttack
+		cpu := &chaosv1alpha1.CPUStressAttackSpec{ContainerName: container, Duration: duration}
+		if cores, ok, err := intEnv(env, "CPU_CORES"); err != nil {
+			return nil, nil, err
+		} else if ok && cores > 0 {
+			cpu.Workers = int32(cores)
+		}
+		if load, ok, err := intEnv(env, "CPU_LOAD"); err != nil {
+			return nil, nil, err
+		} else if ok && load > 0 {
+			cpu.Load = int32(load)
+		}
+		spec.Attack.CPUStress = cpu
+	case "pod-memory-hog":
+		spec.Attack.Type = chaosv1alpha1.MemoryStressAttack
+		megabytes, ok, err := intEnv(env, "MEMORY_CONSUMPTION")
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			megabytes = 500
+		}
+		spec.Attack.MemoryStress = &chaosv1alpha1.MemoryStressAttackSpec{
+			ContainerName: container,
+			Size:          resource.MustParse(strconv.Itoa(megabytes) + "Mi"),
+			Duration:      duration,
+		}
+	case "pod-io-stress":
+		spec.Attack.Type = chaosv1alpha1.IOStressAttack
+		ioStress := &chaosv1alpha1.IOStressAttackSpec{ContainerName: container, Duration: duration}
+		if gigabytes, ok, err := intEnv(env, "FILESYSTEM_UTILIZATION_BYTES"); err != nil {
+			return nil, nil, err
+		} else if ok && gigabytes > 0 {
+			ioStress.Size = ptr.To(resource.MustParse(strconv.Itoa(gigabytes) + "Gi"))
+		}
+		if workers, ok, err := intEnv(env, "NUMBER_OF_WORKERS"); err != nil {
+			return nil, nil, err
+		} else if ok && workers > 0 {
+			ioStress.Workers = int32(workers)
+		}
+		spec.Attack.IOStress = ioStress
+	case "pod-network-latency", "pod-network-loss", "pod-network-corruption", "pod-network-duplication":
+		network, err := networkChaos(name, env)
+		if err != nil {
+			return nil, nil, err
+		}
+		network.Duration = duration
+		spec.Attack.Type = chaosv1alpha1.NetworkChaosAttack
+		spec.Attack.NetworkChaos = network
+	case "node-taint":
+		spec.Attack.Type = chaosv1alpha1.NodeTaintAttack
+		taint, err := parseTaint(env["TAINTS"])
+		if err != nil {
+			return nil, nil, err
+		}
+		taint.Duration = duration
+		spec.Attack.NodeTaint = taint
+	case "kubelet-service-kill":
+		spec.Attack.Type = chaosv1alpha1.KubeletChaosAttack
+		spec.Attack.KubeletChaos = &chaosv1alpha1.KubeletChaosAttackSpec{Action: chaosv1alpha1.KubeletStop, Duration: duration}
+	default:
+		return nil, nil, fmt.Errorf("experiment %s has no counterpart in this operator", name)
+	}
+
+	for _, litmusProbe := range probes {
+		probe, err := convertProbe(litmusProbe)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("probe %s dropped: %v", litmusProbe.Name, err))
+			continue
+		}
+		if spec.Hypothesis == nil {
+			spec.Hypothesis = &chaosv1alpha1.Hypothesis{}
+		}
+		spec.Hypothesis.Probes = append(spec.Hypothesis.Probes, *probe)
+	}
+	return experiment, warnings, nil
+}
+
+// networkChaos converts the tunables of the Litmus pod-network experiments.
+func networkChaos(name string, env map[string]string) (*chaosv1alpha1.NetworkChaosAttackSpec, error) {
+	network := &chaosv1alpha1.NetworkChaosAttackSpec{Interface: env["NETWORK_INTERFACE"]}
+	switch name {
+	case "pod-network-latency":
+		latency, _, err := intEnv(env, "NETWORK_LATENCY")
+		if err != nil {
+			return nil, err
+		}
+		network.Latency = &metav1.Duration{Duration: time.Duration(latency) * time.Millisecond}
+		if jitter, ok, err := intEnv(env, "JITTER"); err != nil {
+			return nil, err
+		} else if ok && jitter > 0 {
+			network.Jitter = &metav1.Duration{Duration: time.Duration(jitter) * time.Millisecond}
+		}
+	case "pod-network-loss":
+		loss, _, err := intEnv(env, "NETWORK_PACKET_LOSS_PERCENTAGE")
+		network.Loss = int32(loss)
+		return network, err
+	case "pod-network-corruption":
+		corrupt, _, err := intEnv(env, "NETWORK_PACKET_CORRUPTION_PERCENTAGE")
+		network.Corrupt = int32(corrupt)
+		return network, err
+	case "pod-network-duplication":
+		duplicate, _, err := intEnv(env, "NETWORK_PACKET_DUPLICATION_PERCENTAGE")
+		network.Duplicate = int32(duplicate)
+		return network, err
+	}
+	return network, nil
+}
+
+// convertProbe converts a Litmus probe into a probe of spec.hypothesis.
+func convertProbe(litmusProbe Probe) (*chaosv1alpha1.Probe, error) {
+	probe := &chaosv1alpha1.Probe{Name: litmusProbe.Name}
+	switch litmusProbe.Mode {
+	case "SOT":
+		probe.When = []chaosv1alpha1.ProbePhase{chaosv1alpha1.ProbePre}
+	case "EOT":
+		probe.When = []chaosv1alpha1.ProbePhase{chaosv1alpha1.ProbePost}
+	case "Edge", "":
+	case "Continuous", "OnChaos":
+		probe.When = []chaosv1alpha1.ProbePhase{chaosv1alpha1.ProbeDuring}
+	default:
+		return nil, fmt.Errorf("unknown mode %s", litmusProbe.Mode)
+	}
+	var timeout *metav1.Duration
+	if litmusProbe.RunProperties.ProbeTimeout != "" {
+		parsed, err := time.ParseDuration(litmusProbe.RunProperties.ProbeTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid probeTimeout: %w", err)
+		}
+		timeout = &metav1.Duration{Duration: parsed}
+	}
+
+	switch {
+	case litmusProbe.Type == "httpProbe" && litmusProbe.HTTPProbe != nil:
+		http := &chaosv1alpha1.HTTPProbe{URL: litmusProbe.HTTPProbe.URL, ExpectedStatus: 200, Timeout: timeout}
+		if get := litmusProbe.HTTPProbe.Method.Get; get != nil && get.ResponseCode != "" {
+			code, err := strconv.Atoi(get.ResponseCode)
+			if err != nil {
+				return nil, fmt.Errorf("invalid responseCode %q", get.ResponseCode)
+			}
+			http.ExpectedStatus = int32(code)
+		} else if litmusProbe.HTTPProbe.Method.Get == nil {
+			return nil, errors.New("only GET requests are supported")
+		}
+		probe.HTTP = http
+	case litmusProbe.Type == "promProbe" && litmusProbe.PromProbe != nil:
+		query := litmusProbe.PromProbe.Query
+		if comparator := litmusProbe.PromProbe.Comparator; comparator.Criteria != "" {
+			switch comparator.Criteria {
+			case "==", "!=", ">", "<", ">=", "<=":
+				query = fmt.Sprintf("(%s) %s %s", query, comparator.Criteria, comparator.Value)
+			default:
+				return nil, fmt.Errorf("unsupported criteria %s", comparator.Criteria)
+			}
+		}
+		probe.PromQL = &chaosv1alpha1.PromQLProbe{Query: query}
+	case litmusProbe.Type == "cmdProbe" && litmusProbe.CmdProbe != nil:
+		if litmusProbe.CmdProbe.Source == nil || litmusProbe.CmdProbe.Source.Image == "" {
+			return nil, errors.New("inline command probes are not supported, set source.image")
+		}
+		probe.Command = &chaosv1alpha1.CommandProbe{
+			Image:   litmusProbe.CmdProbe.Source.Image,
+			Command: []string{"/bin/sh", "-c", litmusProbe.CmdProbe.Command},
+			Timeout: timeout,
+		}
+	default:
+		return nil, fmt.Errorf("probes of type %s are not supported", litmusProbe.Type)
+	}
+	return probe, nil
+}
+
+// parseLabels parses an applabel like "app=nginx,tier=web".
+func parseLabels(applabel string) (map[string]string, error) {
+	if applabel == "" {
+		return nil, errors.New("appinfo.applabel is required")
+	}
+	selector := map[string]string{}
+	for _, pair := range strings.Split(applabel, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("applabel %q is not a list of key=value pairs", applabel)
+		}
+		selector[key] = value
+	}
+	return selector, nil
+}
+
+// parseTaint parses the first taint of a TAINTS tunable like
+// "node.kubernetes.io/chaos=true:NoSchedule".
+func parseTaint(taints string) (*chaosv1alpha1.NodeTaintAttackSpec, error) {
+	first, _, _ := strings.Cut(taints, ",")
+	if first == "" {
+		return &chaosv1alpha1.NodeTaintAttackSpec{}, nil
+	}
+	keyValue, effect, _ := strings.Cut(first, ":")
+	key, value, _ := strings.Cut(keyValue, "=")
+	taint := &chaosv1alpha1.NodeTaintAttackSpec{Key: key, Value: value, Effect: corev1.TaintEffect(effect)}
+	switch taint.Effect {
+	case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		return taint, nil
+	}
+	return nil, fmt.Errorf("invalid taint effect %s", effect)
+}
+
+// envMap turns a list of tunables into a map.
+func envMap(env []Env) map[string]string {
+	values := make(map[string]string, len(env))
+	for _, e := range env {
+		values[e.Name] = e.Value
+	}
+	return values
+}
+
+// intEnv returns the integer value of a tunable, and false if it is empty.
+func intEnv(env map[string]string, name string) (int, bool, error) {
+	value := strings.TrimSpace(env[name])
+	if value == "" {
+		return 0, false, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s must be an integer, got %q", name, value)
+	}
+	return parsed, true, nil
+}
+
+// secondsEnv returns the value of a tunable in seconds as a duration, and
+// false if it is empty.
+func secondsEnv(env map[string]string, name string) (*metav1.Duration, bool, error) {
+	seconds, ok, err := intEnv(env, name)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return &metav1.Duration{Duration: time.Duration(seconds) * time.Second}, true, nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package litmus
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+const manifests = `
+apiVersion: litmuschaos.io/v1alpha1
+kind: ChaosExperiment
+metadata:
+  name: pod-delete
+spec:
+  definition:
+    env:
+    - name: TOTAL_CHAOS_DURATION
+      value: '15'
+    - name: CHAOS_INTERVAL
+      value: '5'
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+---
+apiVersion: litmuschaos.io/v1alpha1
+kind: ChaosEngine
+metadata:
+  name: nginx-chaos
+  namespace: litmus
+spec:
+  appinfo:
+    appns: demo
+    applabel: app=nginx,tier=web
+  experiments:
+  - name: pod-delete
+    spec:
+      components:
+        env:
+        - name: TOTAL_CHAOS_DURATION
+          value: '60'
+        - name: FORCE
+          value: 'true'
+      probe:
+      - name: frontend
+        type: httpProbe
+        mode: Edge
+        httpProbe/inputs:
+          url: http://frontend/healthz
+          method:
+            get:
+              responseCode: "204"
+      - name: errors
+        type: promProbe
+        mode: Continuous
+        promProbe/inputs:
+          query: sum(rate(http_errors_total[1m]))
+          comparator:
+            criteria: <=
+            value: "5"
+      - name: pods
+        type: k8sProbe
+        mode: SOT
+  - name: pod-cpu-hog
+    spec:
+      components:
+        env:
+        - name: CPU_CORES
+          value: '2'
+        - name: TOTAL_CHAOS_DURATION
+          value: '30'
+  - name: pod-dns-error
+`
+
+var _ = Describe("Litmus import", func() {
+	It("should convert the experiments of ChaosEngines", func() {
+		result, err := Convert(strings.NewReader(manifests))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Experiments).To(HaveLen(2))
+
+		podDelete := result.Experiments[0]
+		Expect(podDelete.Name).To(Equal("nginx-chaos-pod-delete"))
+		Expect(podDelete.Namespace).To(Equal("litmus"))
+		Expect(podDelete.Spec.Target.Namespace).To(Equal("demo"))
+		Expect(podDelete.Spec.Target.LabelSelector).To(Equal(map[string]string{"app": "nginx", "tier": "web"}))
+		Expect(podDelete.Spec.Attack.Type).To(Equal(chaosv1alpha1.PodKillAttack))
+		Expect(*podDelete.Spec.Attack.PodKill.GracePeriodSeconds).To(BeZero())
+		Expect(podDelete.Spec.Mode).To(Equal(chaosv1alpha1.RecurringMode))
+		Expect(podDelete.Spec.Duration.Duration).To(Equal(time.Minute))
+		Expect(podDelete.Spec.Interval.Duration).To(Equal(5 * time.Second))
+
+		probes := podDelete.Spec.Hypothesis.Probes
+		Expect(probes).To(HaveLen(2))
+		Expect(probes[0].When).To(BeEmpty())
+		Expect(probes[0].HTTP.ExpectedStatus).To(Equal(int32(204)))
+		Expect(probes[1].When).To(Equal([]chaosv1alpha1.ProbePhase{chaosv1alpha1.ProbeDuring}))
+		Expect(probes[1].PromQL.Query).To(Equal("(sum(rate(http_errors_total[1m]))) <= 5"))
+
+		cpuHog := result.Experiments[1]
+		Expect(cpuHog.Spec.Attack.Type).To(Equal(chaosv1alpha1.CPUStressAttack))
+		Expect(cpuHog.Spec.Attack.CPUStress.Workers).To(Equal(int32(2)))
+		Expect(cpuHog.Spec.Attack.CPUStress.Duration.Duration).To(Equal(30 * time.Second))
+
+		Expect(result.Warnings).To(ConsistOf(
+			ContainSubstring("probe pods dropped"),
+			ContainSubstring("pod-dns-error has no counterpart"),
+		))
+	})
+
+	It("should reject invalid tunables", func() {
+		_, _, err := convertExperiment(Engine{}, "pod-memory-hog", map[string]string{"MEMORY_CONSUMPTION": "lots"}, nil)
+		Expect(err).To(MatchError(ContainSubstring("appinfo.applabel is required")))
+
+		engine := Engine{}
+		engine.Spec.AppInfo.AppLabel = "app=web"
+		_, _, err = convertExperiment(engine, "pod-memory-hog", map[string]string{"MEMORY_CONSUMPTION": "lots"}, nil)
+		Expect(err).To(MatchError(`MEMORY_CONSUMPTION must be an integer, got "lots"`))
+	})
+
+	It("should parse taints", func() {
+		taint, err := parseTaint("chaos=true:NoExecute,other=x:NoSchedule")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taint.Key).To(Equal("chaos"))
+		Expect(taint.Value).To(Equal("true"))
+		Expect(string(taint.Effect)).To(Equal("NoExecute"))
+
+		_, err = parseTaint("chaos=true:Sometimes")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,365 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package management
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+	"kubechaos-operator/internal/runner"
+)
+
+var log = logf.Log.WithName("management")
+
+// userKey is the context key of the authenticated caller.
+type userKey struct{}
+
+// Server serves the ExperimentService. Every call has to carry the bearer
+// token of a Kubernetes user or service account, which is authenticated
+// with a TokenReview; a SubjectAccessReview then checks that the caller may
+// do the same to the experiment through the Kubernetes API. Server
+// implements manager.Runnable.
+type Server struct {
+	// Client creates and changes the experiments.
+	Client client.Client
+
+	// Reviews creates the TokenReviews and SubjectAccessReviews.
+	Reviews client.Client
+
+	// Addr is the address the server listens on, e.g. ":9090".
+	Addr string
+
+	// CertDir holds the serving certificate tls.crt and its key tls.key. If
+	// it is empty, the server does not use TLS.
+	CertDir string
+
+	// PollInterval is how often watched experiments are checked on.
+	// Defaults to 2s.
+	PollInterval time.Duration
+}
+
+// NeedLeaderElection lets every replica of the operator serve requests.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves requests until ctx is done.
+func (s *Server) Start(ctx context.Context) error {
+	opts := []grpc.ServerOption{}
+	if s.CertDir != "" {
+		creds, err := credentials.NewServerTLSFromFile(filepath.Join(s.CertDir, "tls.crt"), filepath.Join(s.CertDir, "tls.key"))
+		if err != nil {
+			return fmt.Errorf("loading serving certificate: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	listener, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	server := s.grpcServer(opts...)
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	log.Info("Serving management API", "Addr", s.Addr, "TLS", s.CertDir != "")
+	return server.Serve(listener)
+}
+
+// grpcServer returns a gRPC server with the service registered.
+func (s *Server) grpcServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ForceServerCodec(Codec{}),
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			ctx, err := s.authenticate(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, err := s.authenticate(stream.Context())
+			if err != nil {
+				return err
+			}
+			return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+		}),
+	)
+	server := grpc.NewServer(opts...)
+	server.RegisterService(&ServiceDesc, s)
+	return server
+}
+
+// authenticatedStream carries the context with the authenticated caller.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// authenticate reviews the bearer token of a call and returns a context
+// with the user it belongs to.
+func (s *Server) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var token string
+	for _, value := range md.Get("authorization") {
+		if strings.HasPrefix(value, "Bearer ") {
+			token = strings.TrimPrefix(value, "Bearer ")
+		}
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	review := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}
+	if err := s.Reviews.Create(ctx, review); err != nil {
+		log.Error(err, "Failed to review token")
+		return nil, status.Error(codes.Unavailable, "reviewing token: "+err.Error())
+	}
+	if !review.Status.Authenticated {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return context.WithValue(ctx, userKey{}, review.Status.User), nil
+}
+
+// authorize checks that the caller may apply verb to the experiment.
+func (s *Server) authorize(ctx context.Context, verb, namespace, name string) error {
+	user, _ := ctx.Value(userKey{}).(authenticationv1.UserInfo)
+	review := &authorizationv1.SubjectAccessReview{Spec: authorizationv1.SubjectAccessReviewSpec{
+		User:   user.Username,
+		Groups: user.Groups,
+		UID:    user.UID,
+		ResourceAttributes: &authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Group:     chaosv1alpha1.GroupVersion.Group,
+			Resource:  "chaosexperiments",
+			Name:      name,
+		},
+	}}
+	for key, values := range user.Extra {
+		if review.Spec.Extra == nil {
+			review.Spec.Extra = map[string]authorizationv1.ExtraValue{}
+		}
+		review.Spec.Extra[key] = authorizationv1.ExtraValue(values)
+	}
+	if err := s.Reviews.Create(ctx, review); err != nil {
+		log.Error(err, "Failed to review access")
+		return status.Error(codes.Unavailable, "reviewing access: "+err.Error())
+	}
+	if !review.Status.Allowed {
+		return status.Errorf(codes.PermissionDenied, "%s may not %s chaosexperiments in namespace %s", user.Username, verb, namespace)
+	}
+	return nil
+}
+
+// CreateExperiment creates the experiment of the request.
+func (s *Server) CreateExperiment(ctx context.Context, req *CreateExperimentRequest) (*Experiment, error) {
+	if req.Experiment == nil || req.Experiment.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "experiment with a namespace is required")
+	}
+	if err := s.authorize(ctx, "create", req.Experiment.Namespace, ""); err != nil {
+		return nil, err
+	}
+	experiment := req.Experiment.DeepCopy()
+	experiment.ResourceVersion = ""
+	experiment.Status = chaosv1alpha1.ChaosExperimentStatus{}
+	if err := s.Client.Create(ctx, experiment); err != nil {
+		return nil, statusOf(err)
+	}
+	log.Info("Created experiment", "Namespace", experiment.Namespace, "Experiment", experiment.Name, "User", userOf(ctx))
+	return experimentOf(experiment), nil
+}
+
+// GetExperiment returns the state of the experiment.
+func (s *Server) GetExperiment(ctx context.Context, req *ExperimentRef) (*Experiment, error) {
+	experiment, err := s.get(ctx, "get", req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return experimentOf(experiment), nil
+}
+
+// PauseExperiment sets spec.suspend of the experiment.
+func (s *Server) PauseExperiment(ctx context.Context, req *ExperimentRef) (*Experiment, error) {
+	return s.patch(ctx, req.Namespace, req.Name, func(experiment *chaosv1alpha1.ChaosExperiment) {
+		experiment.Spec.Suspend = true
+	})
+}
+
+// ResumeExperiment clears spec.suspend of the experiment.
+func (s *Server) ResumeExperiment(ctx context.Context, req *ExperimentRef) (*Experiment, error) {
+	return s.patch(ctx, req.Namespace, req.Name, func(experiment *chaosv1alpha1.ChaosExperiment) {
+		experiment.Spec.Suspend = false
+	})
+}
+
+// AbortExperiment sets the AbortAnnotation of the experiment.
+func (s *Server) AbortExperiment(ctx context.Context, req *AbortExperimentRequest) (*Experiment, error) {
+	return s.patch(ctx, req.Namespace, req.Name, func(experiment *chaosv1alpha1.ChaosExperiment) {
+		if experiment.Annotations == nil {
+			experiment.Annotations = map[string]string{}
+		}
+		experiment.Annotations[chaosv1alpha1.AbortAnnotation] = req.Reason
+	})
+}
+
+// WatchExperiment polls the experiment and sends an event for every run
+// recorded in its history and every other change of its state, until it has
+// finished or the caller goes away.
+func (s *Server) WatchExperiment(req *ExperimentRef, stream grpc.ServerStreamingServer[RunEvent]) error {
+	ctx := stream.Context()
+	interval := s.PollInterval
+	if interval == 0 {
+		interval = 2 * time.Second
+	}
+	verb := "get"
+	var last *Experiment
+	var lastRun time.Time
+	for {
+		experiment, err := s.get(ctx, verb, req.Namespace, req.Name)
+		if err != nil {
+			return err
+		}
+		// Access is only reviewed once per stream.
+		verb = ""
+		current := experimentOf(experiment)
+		for i := range experiment.Status.History {
+			run := experiment.Status.History[i]
+			if !run.Time.After(lastRun) {
+				continue
+			}
+			lastRun = run.Time.Time
+			if err := stream.Send(&RunEvent{Experiment: *current, Run: &run}); err != nil {
+				return err
+			}
+			last = current
+		}
+		if last == nil || *last != *current {
+			if err := stream.Send(&RunEvent{Experiment: *current}); err != nil {
+				return err
+			}
+			last = current
+		}
+		if current.Finished {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// get authorizes verb, unless it is empty, and reads the experiment.
+func (s *Server) get(ctx context.Context, verb, namespace, name string) (*chaosv1alpha1.ChaosExperiment, error) {
+	if namespace == "" || name == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace and name are required")
+	}
+	if verb != "" {
+		if err := s.authorize(ctx, verb, namespace, name); err != nil {
+			return nil, err
+		}
+	}
+	experiment := &chaosv1alpha1.ChaosExperiment{}
+	if err := s.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, experiment); err != nil {
+		return nil, statusOf(err)
+	}
+	return experiment, nil
+}
+
+// patch applies change to the experiment with a merge patch.
+func (s *Server) patch(ctx context.Context, namespace, name string, change func(*chaosv1alpha1.ChaosExperiment)) (*Experiment, error) {
+	experiment, err := s.get(ctx, "patch", namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	original := experiment.DeepCopy()
+	change(experiment)
+	if err := s.Client.Patch(ctx, experiment, client.MergeFrom(original)); err != nil {
+		return nil, statusOf(err)
+	}
+	log.Info("Changed experiment", "Namespace", namespace, "Experiment", name, "User", userOf(ctx))
+	return experimentOf(experiment), nil
+}
+
+// experimentOf returns the state of experiment as the service reports it.
+func experimentOf(experiment *chaosv1alpha1.ChaosExperiment) *Experiment {
+	outcome := runner.OutcomeOf(experiment)
+	return &Experiment{
+		Namespace:  experiment.Namespace,
+		Name:       experiment.Name,
+		Phase:      outcome.Phase,
+		Reason:     experiment.Status.Reason,
+		Verdict:    outcome.Verdict,
+		Iterations: outcome.Iterations,
+		Message:    outcome.Message,
+		Suspended:  experiment.Spec.Suspend,
+		Finished:   runner.Finished(experiment),
+	}
+}
+
+// userOf returns the name of the authenticated caller.
+func userOf(ctx context.Context) string {
+	user, _ := ctx.Value(userKey{}).(authenticationv1.UserInfo)
+	return user.Username
+}
+
+// statusOf maps an error of the Kubernetes API to a gRPC status.
+func statusOf(err error) error {
+	code := codes.Internal
+	switch {
+	case apierrors.IsNotFound(err):
+		code = codes.NotFound
+	case apierrors.IsAlreadyExists(err):
+		code = codes.AlreadyExists
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		code = codes.InvalidArgument
+	case apierrors.IsForbidden(err):
+		code = codes.PermissionDenied
+	case apierrors.IsConflict(err):
+		code = codes.Aborted
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package management
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// stubClient authenticates the token "alice", lets alice do anything but
+// patch experiments in the namespace "prod", and keeps one experiment whose
+// status advances through the given states, one per Get.
+type stubClient struct {
+	client.Client
+	mu         sync.Mutex
+	experiment *chaosv1alpha1.ChaosExperiment
+	states     []chaosv1alpha1.ChaosExperimentStatus
+}
+
+func (c *stubClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch obj := obj.(type) {
+	case *authenticationv1.TokenReview:
+		if obj.Spec.Token == "alice" {
+			obj.Status.Authenticated = true
+			obj.Status.User = authenticationv1.UserInfo{Username: "alice"}
+		}
+	case *authorizationv1.SubjectAccessReview:
+		attributes := obj.Spec.ResourceAttributes
+		obj.Status.Allowed = obj.Spec.User == "alice" && (attributes.Verb != "patch" || attributes.Namespace != "prod")
+	case *chaosv1alpha1.ChaosExperiment:
+		if obj.Name == "" {
+			obj.Name = obj.GenerateName + "x7k2p"
+		}
+		c.experiment = obj.DeepCopy()
+	}
+	return nil
+}
+
+func (c *stubClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.experiment == nil || c.experiment.Namespace != key.Namespace || c.experiment.Name != key.Name {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "chaos.shanto.dev", Resource: "chaosexperiments"}, key.Name)
+	}
+	if len(c.states) > 0 {
+		c.experiment.Status = c.states[0]
+		c.states = c.states[1:]
+	}
+	c.experiment.DeepCopyInto(obj.(*chaosv1alpha1.ChaosExperiment))
+	return nil
+}
+
+func (c *stubClient) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.experiment = obj.(*chaosv1alpha1.ChaosExperiment).DeepCopy()
+	return nil
+}
+
+var _ = Describe("Management API", func() {
+	var c *stubClient
+	var mgmt *Client
+	var ctx context.Context
+
+	BeforeEach(func() {
+		c = &stubClient{}
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		server := (&Server{Client: c, Reviews: c, PollInterval: time.Millisecond}).grpcServer()
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		DeferCleanup(server.Stop)
+
+		conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		mgmt = NewClient(conn)
+		ctx = metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer alice")
+	})
+
+	create := func(namespace string) *Experiment {
+		experiment := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, GenerateName: "campaign-"}}
+		created, err := mgmt.CreateExperiment(ctx, &CreateExperimentRequest{Experiment: experiment})
+		Expect(err).NotTo(HaveOccurred())
+		return created
+	}
+
+	It("should reject calls without a valid token", func() {
+		_, err := mgmt.GetExperiment(context.Background(), &ExperimentRef{Namespace: "demo", Name: "checkout"})
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+		badCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer mallory")
+		_, err = mgmt.GetExperiment(badCtx, &ExperimentRef{Namespace: "demo", Name: "checkout"})
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+
+	It("should create, pause, resume and abort experiments", func() {
+		created := create("demo")
+		Expect(created.Name).To(Equal("campaign-x7k2p"))
+		ref := &ExperimentRef{Namespace: "demo", Name: created.Name}
+
+		paused, err := mgmt.PauseExperiment(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paused.Suspended).To(BeTrue())
+
+		resumed, err := mgmt.ResumeExperiment(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed.Suspended).To(BeFalse())
+
+		_, err = mgmt.AbortExperiment(ctx, &AbortExperimentRequest{Namespace: "demo", Name: created.Name, Reason: "campaign stopped"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.experiment.Annotations).To(HaveKeyWithValue(chaosv1alpha1.AbortAnnotation, "campaign stopped"))
+
+		_, err = mgmt.GetExperiment(ctx, &ExperimentRef{Namespace: "demo", Name: "missing"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should deny what the caller may not do through the Kubernetes API", func() {
+		created := create("prod")
+		_, err := mgmt.AbortExperiment(ctx, &AbortExperimentRequest{Namespace: "prod", Name: created.Name})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		Expect(c.experiment.Annotations).NotTo(HaveKey(chaosv1alpha1.AbortAnnotation))
+	})
+
+	It("should stream the runs of an experiment until it has finished", func() {
+		created := create("demo")
+		first := metav1.NewTime(time.Now().Add(-time.Minute))
+		second := metav1.NewTime(time.Now())
+		running := chaosv1alpha1.ChaosExperimentStatus{
+			Phase:   chaosv1alpha1.ExperimentRunning,
+			History: []chaosv1alpha1.IterationRecord{{Time: first, Result: chaosv1alpha1.IterationSucceeded}},
+		}
+		completed := *running.DeepCopy()
+		completed.Phase = chaosv1alpha1.ExperimentCompleted
+		completed.History = append(completed.History, chaosv1alpha1.IterationRecord{Time: second, Result: chaosv1alpha1.IterationFailed})
+		c.states = []chaosv1alpha1.ChaosExperimentStatus{running, running, completed}
+
+		stream, err := mgmt.WatchExperiment(ctx, &ExperimentRef{Namespace: "demo", Name: created.Name})
+		Expect(err).NotTo(HaveOccurred())
+		var events []*RunEvent
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+			events = append(events, event)
+		}
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Run.Result).To(Equal(chaosv1alpha1.IterationSucceeded))
+		Expect(events[0].Experiment.Phase).To(Equal(chaosv1alpha1.ExperimentRunning))
+		Expect(events[1].Run.Result).To(Equal(chaosv1alpha1.IterationFailed))
+		Expect(events[1].Experiment.Phase).To(Equal(chaosv1alpha1.ExperimentCompleted))
+		Expect(events[1].Experiment.Finished).To(BeTrue())
+	})
+})
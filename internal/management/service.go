@@ -0,0 +1,215 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package management serves a gRPC API for automation that orchestrates
+// chaos campaigns: it creates, pauses, resumes and aborts ChaosExperiments
+// and streams the runs of an experiment as they happen.
+//
+// The service is described by hand rather than generated from a .proto
+// file, and its messages are encoded as JSON: clients call it with the
+// content subtype "json", i.e. the content type application/grpc+json.
+package management
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// ServiceName is the full name of the gRPC service.
+const ServiceName = "chaos.v1alpha1.ExperimentService"
+
+// ExperimentRef identifies an experiment.
+type ExperimentRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// CreateExperimentRequest creates an experiment. Its name may be left empty
+// if it has a generateName.
+type CreateExperimentRequest struct {
+	Experiment *chaosv1alpha1.ChaosExperiment `json:"experiment"`
+}
+
+// AbortExperimentRequest aborts an experiment for the given reason.
+type AbortExperimentRequest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Experiment is the state of an experiment as the service reports it.
+type Experiment struct {
+	Namespace  string                        `json:"namespace"`
+	Name       string                        `json:"name"`
+	Phase      chaosv1alpha1.ExperimentPhase `json:"phase,omitempty"`
+	Reason     string                        `json:"reason,omitempty"`
+	Verdict    chaosv1alpha1.Verdict         `json:"verdict,omitempty"`
+	Iterations int32                         `json:"iterations,omitempty"`
+	Message    string                        `json:"message,omitempty"`
+	Suspended  bool                          `json:"suspended,omitempty"`
+	// Finished is true once the experiment will not run again and its
+	// hypothesis has been checked.
+	Finished bool `json:"finished,omitempty"`
+}
+
+// RunEvent is sent by WatchExperiment whenever the state of the experiment
+// changes. Run is set if the event reports an iteration that ran.
+type RunEvent struct {
+	Experiment Experiment                     `json:"experiment"`
+	Run        *chaosv1alpha1.IterationRecord `json:"run,omitempty"`
+}
+
+// ExperimentService is the interface of the service.
+type ExperimentService interface {
+	CreateExperiment(context.Context, *CreateExperimentRequest) (*Experiment, error)
+	GetExperiment(context.Context, *ExperimentRef) (*Experiment, error)
+	PauseExperiment(context.Context, *ExperimentRef) (*Experiment, error)
+	ResumeExperiment(context.Context, *ExperimentRef) (*Experiment, error)
+	AbortExperiment(context.Context, *AbortExperimentRequest) (*Experiment, error)
+	// WatchExperiment sends the current state of the experiment and its runs,
+	// then every change until the experiment has finished.
+	WatchExperiment(*ExperimentRef, grpc.ServerStreamingServer[RunEvent]) error
+}
+
+// Codec encodes the messages of the service as JSON.
+type Codec struct{}
+
+// Marshal encodes v as JSON.
+func (Codec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes JSON into v.
+func (Codec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// Name is the content subtype of the codec.
+func (Codec) Name() string {
+	return "json"
+}
+
+// unaryHandler adapts a method of the service to a grpc.MethodHandler.
+func unaryHandler[Req any](method string, call func(ExperimentService, context.Context, *Req) (*Experiment, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			req := new(Req)
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			handler := func(ctx context.Context, req any) (any, error) {
+				return call(srv.(ExperimentService), ctx, req.(*Req))
+			}
+			if interceptor == nil {
+				return handler(ctx, req)
+			}
+			return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + ServiceName + "/" + method}, handler)
+		},
+	}
+}
+
+// ServiceDesc describes the service for grpc.Server.RegisterService.
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*ExperimentService)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryHandler("CreateExperiment", ExperimentService.CreateExperiment),
+		unaryHandler("GetExperiment", ExperimentService.GetExperiment),
+		unaryHandler("PauseExperiment", ExperimentService.PauseExperiment),
+		unaryHandler("ResumeExperiment", ExperimentService.ResumeExperiment),
+		unaryHandler("AbortExperiment", ExperimentService.AbortExperiment),
+	},
+	Streams: []grpc.StreamDesc{{
+		StreamName:    "WatchExperiment",
+		ServerStreams: true,
+		Handler: func(srv any, stream grpc.ServerStream) error {
+			req := &ExperimentRef{}
+			if err := stream.RecvMsg(req); err != nil {
+				return err
+			}
+			return srv.(ExperimentService).WatchExperiment(req, &grpc.GenericServerStream[ExperimentRef, RunEvent]{ServerStream: stream})
+		},
+	}},
+}
+
+// Client calls the service. The token of the caller is sent as a bearer
+// token in the "authorization" metadata of each call, e.g. with
+// metadata.AppendToOutgoingContext, or with per-RPC credentials of the
+// connection.
+type Client struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewClient returns a client of the service on conn.
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{conn: conn}
+}
+
+func (c *Client) invoke(ctx context.Context, method string, req any, opts ...grpc.CallOption) (*Experiment, error) {
+	experiment := &Experiment{}
+	opts = append([]grpc.CallOption{grpc.ForceCodec(Codec{})}, opts...)
+	if err := c.conn.Invoke(ctx, "/"+ServiceName+"/"+method, req, experiment, opts...); err != nil {
+		return nil, err
+	}
+	return experiment, nil
+}
+
+// CreateExperiment creates an experiment.
+func (c *Client) CreateExperiment(ctx context.Context, req *CreateExperimentRequest, opts ...grpc.CallOption) (*Experiment, error) {
+	return c.invoke(ctx, "CreateExperiment", req, opts...)
+}
+
+// GetExperiment returns the state of an experiment.
+func (c *Client) GetExperiment(ctx context.Context, req *ExperimentRef, opts ...grpc.CallOption) (*Experiment, error) {
+	return c.invoke(ctx, "GetExperiment", req, opts...)
+}
+
+// PauseExperiment suspends an experiment.
+func (c *Client) PauseExperiment(ctx context.Context, req *ExperimentRef, opts ...grpc.CallOption) (*Experiment, error) {
+	return c.invoke(ctx, "PauseExperiment", req, opts...)
+}
+
+// ResumeExperiment resumes a suspended experiment.
+func (c *Client) ResumeExperiment(ctx context.Context, req *ExperimentRef, opts ...grpc.CallOption) (*Experiment, error) {
+	return c.invoke(ctx, "ResumeExperiment", req, opts...)
+}
+
+// AbortExperiment requests an experiment to be aborted.
+func (c *Client) AbortExperiment(ctx context.Context, req *AbortExperimentRequest, opts ...grpc.CallOption) (*Experiment, error) {
+	return c.invoke(ctx, "AbortExperiment", req, opts...)
+}
+
+// WatchExperiment streams the runs of an experiment until it has finished.
+func (c *Client) WatchExperiment(ctx context.Context, req *ExperimentRef, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunEvent], error) {
+	opts = append([]grpc.CallOption{grpc.ForceCodec(Codec{})}, opts...)
+	stream, err := c.conn.NewStream(ctx, &ServiceDesc.Streams[0], "/"+ServiceName+"/WatchExperiment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	client := &grpc.GenericClientStream[ExperimentRef, RunEvent]{ClientStream: stream}
+	if err := client.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := client.CloseSend(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
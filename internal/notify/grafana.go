@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Grafana writes notifications as annotations through the Grafana HTTP API.
+// Annotations are tagged with "chaos", the experiment, the attack type and
+// each affected target, e.g. "experiment:kill-nginx", "attack:pod-kill" and
+// "target:demo/nginx-7d9c".
+type Grafana struct {
+	// URL is the base URL of Grafana.
+	URL string
+	// Token is the API token used to write annotations.
+	Token string
+	// DashboardUID limits the annotation to one dashboard, if set.
+	DashboardUID string
+	// Tags are added to the derived tags.
+	Tags []string
+}
+
+// grafanaAnnotation is the body of POST /api/annotations.
+type grafanaAnnotation struct {
+	DashboardUID string   `json:"dashboardUID,omitempty"`
+	Time         int64    `json:"time,omitempty"`
+	Tags         []string `json:"tags"`
+	Text         string   `json:"text"`
+}
+
+// Notify writes an annotation for the notification.
+func (g *Grafana) Notify(ctx context.Context, notification Notification) error {
+	annotation := grafanaAnnotation{
+		DashboardUID: g.DashboardUID,
+		Tags:         append(grafanaTags(notification), g.Tags...),
+		Text:         notification.Message,
+	}
+	if !notification.Time.IsZero() {
+		annotation.Time = notification.Time.UnixMilli()
+	}
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+g.Token)
+	return post(ctx, strings.TrimSuffix(g.URL, "/")+"/api/annotations", "application/json", header, annotation)
+}
+
+// grafanaTags derives the tags of the annotation for the notification.
+func grafanaTags(notification Notification) []string {
+	tags := []string{
+		"chaos",
+		"experiment:" + notification.Experiment,
+		"namespace:" + notification.Namespace,
+		"attack:" + string(notification.Attack),
+	}
+	for _, target := range notification.Targets {
+		tags = append(tags, "target:"+target)
+	}
+	return tags
+}
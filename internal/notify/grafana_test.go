@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Grafana", func() {
+	var server *httptest.Server
+	var request *http.Request
+	var body map[string]any
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			body = nil
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			w.WriteHeader(http.StatusOK)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should write an annotation tagged with the experiment, attack and targets", func() {
+		now := time.UnixMilli(1700000000000)
+		grafana := &Grafana{URL: server.URL + "/", Token: "token", DashboardUID: "svc", Tags: []string{"team:payments"}}
+		Expect(grafana.Notify(context.Background(), Notification{
+			Event:      chaosv1alpha1.NotifyAttackExecuted,
+			Experiment: "kill-nginx",
+			Namespace:  "demo",
+			Attack:     chaosv1alpha1.PodKillAttack,
+			Targets:    []string{"demo/nginx-1", "demo/nginx-2"},
+			Message:    "Killed 2 pods.",
+			Time:       now,
+		})).To(Succeed())
+		Expect(request.URL.Path).To(Equal("/api/annotations"))
+		Expect(request.Header.Get("Authorization")).To(Equal("Bearer token"))
+		Expect(body).To(HaveKeyWithValue("dashboardUID", "svc"))
+		Expect(body).To(HaveKeyWithValue("time", BeNumerically("==", now.UnixMilli())))
+		Expect(body).To(HaveKeyWithValue("text", "Killed 2 pods."))
+		Expect(body["tags"]).To(ConsistOf("chaos", "experiment:kill-nginx", "namespace:demo", "attack:pod-kill",
+			"target:demo/nginx-1", "target:demo/nginx-2", "team:payments"))
+	})
+})
@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify delivers notifications about the lifecycle of chaos
+// experiments to external channels.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// Notification describes something that happened to an experiment.
+type Notification struct {
+	// Event is what happened.
+	Event chaosv1alpha1.NotificationEvent `json:"event"`
+	// Experiment and Namespace name the experiment.
+	Experiment string `json:"experiment"`
+	Namespace  string `json:"namespace"`
+	// Attack is the attack type of the experiment.
+	Attack chaosv1alpha1.AttackType `json:"attack"`
+	// Phase is the phase of the experiment after the event.
+	Phase chaosv1alpha1.ExperimentPhase `json:"phase"`
+	// Iteration is the number of iterations the experiment has completed.
+	Iteration int32 `json:"iteration"`
+	// Targets lists what the last iteration affected, for AttackExecuted.
+	Targets []string `json:"targets,omitempty"`
+	// Message describes the event.
+	Message string `json:"message"`
+	// Time is when the event happened.
+	Time time.Time `json:"time"`
+}
+
+// Notifier delivers notifications to a channel.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// Wants reports whether a channel configured for the given events wants the
+// event. An empty list selects every event.
+func Wants(events []chaosv1alpha1.NotificationEvent, event chaosv1alpha1.NotificationEvent) bool {
+	return len(events) == 0 || slices.Contains(events, event)
+}
+
+// httpClient is used to deliver notifications.
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// post posts the body encoded as JSON to the URL, with the given content type
+// and extra headers, and fails unless the endpoint accepts it.
+func post(ctx context.Context, url, contentType string, header http.Header, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s returned %s: %s", req.URL.Host, resp.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// render executes a message template for the notification. Templates can
+// join lists with the join function, e.g. {{join .Targets ", "}}.
+func render(text string, notification Notification) (string, error) {
+	tmpl, err := template.New("message").Funcs(template.FuncMap{"join": strings.Join}).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, notification); err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	return out.String(), nil
+}
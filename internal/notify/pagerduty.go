@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"fmt"
+)
+
+// PagerDutyEventsURL is the endpoint of the PagerDuty Events API v2.
+const PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// maxSummaryLength is the longest summary PagerDuty accepts.
+const maxSummaryLength = 1024
+
+// PagerDuty triggers incidents through the PagerDuty Events API v2. All
+// notifications of an experiment share a dedup key, so that they are grouped
+// into a single open incident.
+type PagerDuty struct {
+	// RoutingKey is the integration key of the PagerDuty service.
+	RoutingKey string
+	// Severity is the severity of the incidents; "error" if empty.
+	Severity string
+	// URL is the Events API endpoint; PagerDutyEventsURL if empty.
+	URL string
+}
+
+// pagerDutyEvent is a trigger event of the PagerDuty Events API v2.
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string       `json:"summary"`
+	Source        string       `json:"source"`
+	Severity      string       `json:"severity"`
+	Timestamp     string       `json:"timestamp,omitempty"`
+	Component     string       `json:"component"`
+	Group         string       `json:"group"`
+	Class         string       `json:"class"`
+	CustomDetails Notification `json:"custom_details"`
+}
+
+// Notify triggers an incident for the notification.
+func (p *PagerDuty) Notify(ctx context.Context, notification Notification) error {
+	url := p.URL
+	if url == "" {
+		url = PagerDutyEventsURL
+	}
+	severity := p.Severity
+	if severity == "" {
+		severity = "error"
+	}
+	summary := fmt.Sprintf("Chaos experiment %s/%s %s: %s", notification.Namespace, notification.Experiment, notification.Event, notification.Message)
+	if len(summary) > maxSummaryLength {
+		summary = summary[:maxSummaryLength]
+	}
+	event := pagerDutyEvent{
+		RoutingKey:  p.RoutingKey,
+		EventAction: "trigger",
+		DedupKey:    fmt.Sprintf("chaos.shanto.dev/%s/%s", notification.Namespace, notification.Experiment),
+		Payload: pagerDutyPayload{
+			Summary:       summary,
+			Source:        notification.Namespace + "/" + notification.Experiment,
+			Severity:      severity,
+			Component:     string(notification.Attack),
+			Group:         notification.Namespace,
+			Class:         string(notification.Event),
+			CustomDetails: notification,
+		},
+	}
+	if !notification.Time.IsZero() {
+		event.Payload.Timestamp = notification.Time.UTC().Format("2006-01-02T15:04:05.000Z")
+	}
+	return post(ctx, url, "application/json", nil, event)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("PagerDuty", func() {
+	var server *httptest.Server
+	var body map[string]any
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body = nil
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	notification := Notification{
+		Event:      chaosv1alpha1.NotifyFailed,
+		Experiment: "kill-nginx",
+		Namespace:  "demo",
+		Attack:     chaosv1alpha1.PodKillAttack,
+		Phase:      chaosv1alpha1.ExperimentFailed,
+		Message:    "No pods match the target.",
+	}
+
+	It("should trigger an incident deduplicated per experiment", func() {
+		pagerDuty := &PagerDuty{URL: server.URL, RoutingKey: "routing-key"}
+		Expect(pagerDuty.Notify(context.Background(), notification)).To(Succeed())
+		Expect(body).To(HaveKeyWithValue("routing_key", "routing-key"))
+		Expect(body).To(HaveKeyWithValue("event_action", "trigger"))
+		Expect(body).To(HaveKeyWithValue("dedup_key", "chaos.shanto.dev/demo/kill-nginx"))
+		Expect(body["payload"]).To(HaveKeyWithValue("severity", "error"))
+		Expect(body["payload"]).To(HaveKeyWithValue("summary", "Chaos experiment demo/kill-nginx Failed: No pods match the target."))
+		Expect(body["payload"]).To(HaveKeyWithValue("component", "pod-kill"))
+	})
+
+	It("should use the configured severity and truncate long summaries", func() {
+		pagerDuty := &PagerDuty{URL: server.URL, RoutingKey: "routing-key", Severity: "critical"}
+		long := notification
+		long.Message = strings.Repeat("x", 2000)
+		Expect(pagerDuty.Notify(context.Background(), long)).To(Succeed())
+		Expect(body["payload"]).To(HaveKeyWithValue("severity", "critical"))
+		Expect(body["payload"].(map[string]any)["summary"]).To(HaveLen(maxSummaryLength))
+	})
+})
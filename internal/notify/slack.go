@@ -0,0 +1,47 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+)
+
+// DefaultSlackTemplate is the message template used when a Slack channel
+// does not configure one.
+const DefaultSlackTemplate = `*{{.Experiment}}* in {{.Namespace}}: {{.Event}} ({{.Attack}}, iteration {{.Iteration}}). {{.Message}}` +
+	`{{if .Targets}} Targets: {{join .Targets ", "}}.{{end}}`
+
+// Slack posts notifications to a Slack incoming webhook.
+type Slack struct {
+	// WebhookURL is the URL of the incoming webhook.
+	WebhookURL string
+	// Template is the message template; DefaultSlackTemplate if empty.
+	Template string
+}
+
+// Notify posts the rendered message to the webhook.
+func (s *Slack) Notify(ctx context.Context, notification Notification) error {
+	tmpl := s.Template
+	if tmpl == "" {
+		tmpl = DefaultSlackTemplate
+	}
+	text, err := render(tmpl, notification)
+	if err != nil {
+		return err
+	}
+	return post(ctx, s.WebhookURL, "application/json", nil, map[string]string{"text": text})
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Slack", func() {
+	var server *httptest.Server
+	var received []map[string]string
+	status := http.StatusOK
+
+	BeforeEach(func() {
+		received = nil
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]string
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			received = append(received, body)
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	notification := Notification{
+		Event:      chaosv1alpha1.NotifyAttackExecuted,
+		Experiment: "kill-nginx",
+		Namespace:  "demo",
+		Attack:     chaosv1alpha1.PodKillAttack,
+		Iteration:  2,
+		Targets:    []string{"demo/nginx-a", "demo/nginx-b"},
+		Message:    "Pod-kill attack executed.",
+	}
+
+	It("should post the default message", func() {
+		slack := &Slack{WebhookURL: server.URL}
+		Expect(slack.Notify(context.Background(), notification)).To(Succeed())
+		Expect(received).To(HaveLen(1))
+		Expect(received[0]["text"]).To(Equal("*kill-nginx* in demo: AttackExecuted (pod-kill, iteration 2). Pod-kill attack executed. Targets: demo/nginx-a, demo/nginx-b."))
+	})
+
+	It("should render custom templates", func() {
+		slack := &Slack{WebhookURL: server.URL, Template: "{{.Experiment}} {{.Event}}"}
+		Expect(slack.Notify(context.Background(), notification)).To(Succeed())
+		Expect(received[0]["text"]).To(Equal("kill-nginx AttackExecuted"))
+	})
+
+	It("should reject invalid templates", func() {
+		slack := &Slack{WebhookURL: server.URL, Template: "{{.Experiment"}
+		Expect(slack.Notify(context.Background(), notification)).To(MatchError(ContainSubstring("invalid template")))
+		Expect(received).To(BeEmpty())
+	})
+
+	It("should fail when the webhook refuses the message", func() {
+		status = http.StatusForbidden
+		slack := &Slack{WebhookURL: server.URL}
+		Expect(slack.Notify(context.Background(), notification)).To(MatchError(ContainSubstring("403")))
+	})
+
+	It("should select all events unless configured otherwise", func() {
+		Expect(Wants(nil, chaosv1alpha1.NotifyStarted)).To(BeTrue())
+		Expect(Wants([]chaosv1alpha1.NotificationEvent{chaosv1alpha1.NotifyFailed}, chaosv1alpha1.NotifyStarted)).To(BeFalse())
+		Expect(Wants([]chaosv1alpha1.NotificationEvent{chaosv1alpha1.NotifyFailed}, chaosv1alpha1.NotifyFailed)).To(BeTrue())
+	})
+})
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// CloudEventTypePrefix prefixes the lower-cased event in the type attribute
+// of CloudEvents, e.g. "dev.shanto.chaos.experiment.completed".
+const CloudEventTypePrefix = "dev.shanto.chaos.experiment."
+
+// Webhook posts notifications to an HTTP endpoint.
+type Webhook struct {
+	// URL is the endpoint.
+	URL string
+	// Format is how notifications are posted; CloudEvents if empty.
+	Format chaosv1alpha1.WebhookFormat
+	// Authorization is the value of the Authorization header, if any.
+	Authorization string
+}
+
+// cloudEvent is a CloudEvent 1.0 in structured JSON mode.
+type cloudEvent struct {
+	SpecVersion     string       `json:"specversion"`
+	ID              string       `json:"id"`
+	Source          string       `json:"source"`
+	Type            string       `json:"type"`
+	Subject         string       `json:"subject"`
+	Time            time.Time    `json:"time"`
+	DataContentType string       `json:"datacontenttype"`
+	Data            Notification `json:"data"`
+}
+
+// Notify posts the notification to the endpoint.
+func (w *Webhook) Notify(ctx context.Context, notification Notification) error {
+	header := http.Header{}
+	if w.Authorization != "" {
+		header.Set("Authorization", w.Authorization)
+	}
+	if w.Format == chaosv1alpha1.JSONFormat {
+		return post(ctx, w.URL, "application/json", header, notification)
+	}
+	return post(ctx, w.URL, "application/cloudevents+json", header, newCloudEvent(notification))
+}
+
+// newCloudEvent wraps the notification in a CloudEvent whose source is the
+// experiment.
+func newCloudEvent(notification Notification) cloudEvent {
+	return cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              string(uuid.NewUUID()),
+		Source:          fmt.Sprintf("/apis/chaos.shanto.dev/v1alpha1/namespaces/%s/chaosexperiments/%s", notification.Namespace, notification.Experiment),
+		Type:            CloudEventTypePrefix + strings.ToLower(string(notification.Event)),
+		Subject:         notification.Experiment,
+		Time:            notification.Time,
+		DataContentType: "application/json",
+		Data:            notification,
+	}
+}
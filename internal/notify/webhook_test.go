@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("Webhook", func() {
+	var server *httptest.Server
+	var request *http.Request
+	var body map[string]any
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			body = nil
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	notification := Notification{
+		Event:      chaosv1alpha1.NotifyCompleted,
+		Experiment: "kill-nginx",
+		Namespace:  "demo",
+		Attack:     chaosv1alpha1.PodKillAttack,
+		Phase:      chaosv1alpha1.ExperimentCompleted,
+		Message:    "Experiment completed after 3 iterations.",
+	}
+
+	It("should post CloudEvents by default", func() {
+		webhook := &Webhook{URL: server.URL, Authorization: "Bearer secret"}
+		Expect(webhook.Notify(context.Background(), notification)).To(Succeed())
+		Expect(request.Header.Get("Content-Type")).To(Equal("application/cloudevents+json"))
+		Expect(request.Header.Get("Authorization")).To(Equal("Bearer secret"))
+		Expect(body).To(HaveKeyWithValue("specversion", "1.0"))
+		Expect(body).To(HaveKeyWithValue("type", "dev.shanto.chaos.experiment.completed"))
+		Expect(body).To(HaveKeyWithValue("source", "/apis/chaos.shanto.dev/v1alpha1/namespaces/demo/chaosexperiments/kill-nginx"))
+		Expect(body).To(HaveKeyWithValue("id", Not(BeEmpty())))
+		Expect(body["data"]).To(HaveKeyWithValue("phase", "Completed"))
+	})
+
+	It("should post plain JSON when asked to", func() {
+		webhook := &Webhook{URL: server.URL, Format: chaosv1alpha1.JSONFormat}
+		Expect(webhook.Notify(context.Background(), notification)).To(Succeed())
+		Expect(request.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(request.Header.Get("Authorization")).To(BeEmpty())
+		Expect(body).To(HaveKeyWithValue("event", "Completed"))
+		Expect(body).To(HaveKeyWithValue("message", "Experiment completed after 3 iterations."))
+	})
+})
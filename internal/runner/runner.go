@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package runner creates a ChaosExperiment and waits for its outcome, so that
+// workflow steps and CI jobs can run an experiment to completion and act on
+// its verdict.
+package runner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// DefaultGenerateName is the name prefix of experiments that set neither a
+// name nor a generateName.
+const DefaultGenerateName = "chaos-run-"
+
+// Options configures Run.
+type Options struct {
+	// PollInterval is how often the experiment is checked on. Defaults to 5s.
+	PollInterval time.Duration
+
+	// Timeout bounds the wait for the outcome. Zero waits until ctx is done.
+	Timeout time.Duration
+
+	// Delete deletes the experiment once its outcome is known, which reverts
+	// any fault it left behind.
+	Delete bool
+
+	// Replace deletes an experiment of the same name before the experiment is
+	// created, and waits until it is gone, so that a pipeline can run the same
+	// manifest again.
+	Replace bool
+
+	// OnUpdate, if set, is called with the experiment whenever its status has
+	// changed while waiting.
+	OnUpdate func(*chaosv1alpha1.ChaosExperiment)
+}
+
+// Outcome is the result of an experiment run.
+type Outcome struct {
+	// Name of the created experiment.
+	Name string
+	// Phase the experiment ended in.
+	Phase chaosv1alpha1.ExperimentPhase
+	// Verdict of the steady-state hypothesis, empty without one.
+	Verdict chaosv1alpha1.Verdict
+	// Message of the experiment status.
+	Message string
+	// Iterations the experiment ran.
+	Iterations int32
+}
+
+// Succeeded reports whether the experiment completed and its hypothesis, if
+// any, held.
+func (o *Outcome) Succeeded() bool {
+	return o.Phase == chaosv1alpha1.ExperimentCompleted && o.Verdict != chaosv1alpha1.VerdictFailed
+}
+
+// Finished reports whether the experiment has an outcome: it completed, was
+// aborted or failed, and no check of its hypothesis is pending. Recurring
+// experiments that failed an iteration have an outcome as well, even though
+// they keep running.
+func Finished(experiment *chaosv1alpha1.ChaosExperiment) bool {
+	switch experiment.Status.Phase {
+	case chaosv1alpha1.ExperimentCompleted, chaosv1alpha1.ExperimentAborted, chaosv1alpha1.ExperimentFailed:
+	default:
+		return false
+	}
+	return experiment.Status.HypothesisCheckTime == nil && experiment.Status.DuringProbeTime == nil
+}
+
+// OutcomeOf returns the outcome recorded in the status of the experiment.
+func OutcomeOf(experiment *chaosv1alpha1.ChaosExperiment) *Outcome {
+	return &Outcome{
+		Name:       experiment.Name,
+		Phase:      experiment.Status.Phase,
+		Verdict:    experiment.Status.Verdict,
+		Message:    experiment.Status.Message,
+		Iterations: experiment.Status.IterationsCompleted,
+	}
+}
+
+// Run creates the experiment and waits until it has finished. If the wait is
+// cut short, by ctx or by the timeout, the experiment is deleted, so that it
+// does not keep attacking, and an error is returned.
+func Run(ctx context.Context, c client.Client, experiment *chaosv1alpha1.ChaosExperiment, opts Options) (*Outcome, error) {
+	if experiment.Name == "" && experiment.GenerateName == "" {
+		experiment.GenerateName = DefaultGenerateName
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	if opts.Replace && experiment.Name != "" {
+		if err := replace(ctx, c, experiment, interval); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.Create(ctx, experiment); err != nil {
+		return nil, fmt.Errorf("creating experiment: %w", err)
+	}
+	waitCtx := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	key := client.ObjectKeyFromObject(experiment)
+	var last *chaosv1alpha1.ChaosExperimentStatus
+	err := wait.PollUntilContextCancel(waitCtx, interval, false, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, key, experiment); err != nil {
+			if errors.IsNotFound(err) {
+				return false, fmt.Errorf("experiment %s was deleted", key.Name)
+			}
+			// Transient errors are retried until the wait ends.
+			return false, nil
+		}
+		if opts.OnUpdate != nil && (last == nil || !equality.Semantic.DeepEqual(*last, experiment.Status)) {
+			last = experiment.Status.DeepCopy()
+			opts.OnUpdate(experiment)
+		}
+		return Finished(experiment), nil
+	})
+	if err != nil {
+		// The caller's context may be done as well, so a fresh one bounds the
+		// cleanup.
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if deleteErr := c.Delete(cleanupCtx, experiment); deleteErr != nil && !errors.IsNotFound(deleteErr) {
+			return nil, fmt.Errorf("waiting for experiment %s: %w, and deleting it: %v", key.Name, err, deleteErr)
+		}
+		return nil, fmt.Errorf("waiting for experiment %s: %w", key.Name, err)
+	}
+
+	outcome := OutcomeOf(experiment)
+	if opts.Delete {
+		if err := c.Delete(ctx, experiment); err != nil && !errors.IsNotFound(err) {
+			return outcome, fmt.Errorf("deleting experiment %s: %w", key.Name, err)
+		}
+	}
+	return outcome, nil
+}
+
+// replace deletes the experiment of the same name, if there is one, and waits
+// until it is gone.
+func replace(ctx context.Context, c client.Client, experiment *chaosv1alpha1.ChaosExperiment, interval time.Duration) error {
+	existing := &chaosv1alpha1.ChaosExperiment{}
+	existing.Name = experiment.Name
+	existing.Namespace = experiment.Namespace
+	if err := c.Delete(ctx, existing); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("deleting experiment %s: %w", experiment.Name, err)
+	}
+	key := client.ObjectKeyFromObject(existing)
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		err := c.Get(ctx, key, existing)
+		return errors.IsNotFound(err), nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for experiment %s to be deleted: %w", experiment.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// stubClient serves an experiment whose status advances through the given
+// states, one per Get, and records deletions. A deleted experiment is gone
+// until it is created again.
+type stubClient struct {
+	client.Client
+	states  []chaosv1alpha1.ChaosExperimentStatus
+	created bool
+	deleted bool
+}
+
+func (c *stubClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	if obj.GetName() == "" {
+		obj.SetName(obj.GetGenerateName() + "x7k2p")
+	}
+	c.created = true
+	return nil
+}
+
+func (c *stubClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if c.deleted && !c.created {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "chaos.shanto.dev", Resource: "chaosexperiments"}, key.Name)
+	}
+	experiment := obj.(*chaosv1alpha1.ChaosExperiment)
+	experiment.Name = key.Name
+	if len(c.states) > 0 {
+		experiment.Status = c.states[0]
+		c.states = c.states[1:]
+	}
+	return nil
+}
+
+func (c *stubClient) Delete(context.Context, client.Object, ...client.DeleteOption) error {
+	c.deleted = true
+	return nil
+}
+
+var _ = Describe("Runner", func() {
+	It("should only finish once the hypothesis has been checked", func() {
+		experiment := &chaosv1alpha1.ChaosExperiment{}
+		Expect(Finished(experiment)).To(BeFalse())
+
+		experiment.Status.Phase = chaosv1alpha1.ExperimentCompleted
+		experiment.Status.HypothesisCheckTime = &metav1.Time{Time: time.Now()}
+		Expect(Finished(experiment)).To(BeFalse())
+
+		experiment.Status.HypothesisCheckTime = nil
+		Expect(Finished(experiment)).To(BeTrue())
+	})
+
+	It("should succeed only if the experiment completed and its hypothesis held", func() {
+		Expect((&Outcome{Phase: chaosv1alpha1.ExperimentCompleted}).Succeeded()).To(BeTrue())
+		Expect((&Outcome{Phase: chaosv1alpha1.ExperimentCompleted, Verdict: chaosv1alpha1.VerdictPassed}).Succeeded()).To(BeTrue())
+		Expect((&Outcome{Phase: chaosv1alpha1.ExperimentCompleted, Verdict: chaosv1alpha1.VerdictFailed}).Succeeded()).To(BeFalse())
+		Expect((&Outcome{Phase: chaosv1alpha1.ExperimentAborted}).Succeeded()).To(BeFalse())
+	})
+
+	It("should create the experiment and wait for its outcome", func() {
+		c := &stubClient{states: []chaosv1alpha1.ChaosExperimentStatus{
+			{Phase: chaosv1alpha1.ExperimentRunning},
+			{Phase: chaosv1alpha1.ExperimentCompleted, Verdict: chaosv1alpha1.VerdictFailed, IterationsCompleted: 1},
+		}}
+		experiment := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Namespace: "demo"}}
+
+		outcome, err := Run(context.Background(), c, experiment, Options{PollInterval: time.Millisecond, Delete: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome.Name).To(Equal(DefaultGenerateName + "x7k2p"))
+		Expect(outcome.Verdict).To(Equal(chaosv1alpha1.VerdictFailed))
+		Expect(outcome.Iterations).To(Equal(int32(1)))
+		Expect(c.deleted).To(BeTrue())
+	})
+
+	It("should report status changes and replace an earlier run", func() {
+		c := &stubClient{states: []chaosv1alpha1.ChaosExperimentStatus{
+			{Phase: chaosv1alpha1.ExperimentRunning},
+			{Phase: chaosv1alpha1.ExperimentRunning},
+			{Phase: chaosv1alpha1.ExperimentCompleted},
+		}}
+		experiment := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "demo"}}
+
+		var phases []chaosv1alpha1.ExperimentPhase
+		outcome, err := Run(context.Background(), c, experiment, Options{
+			PollInterval: time.Millisecond,
+			Replace:      true,
+			OnUpdate: func(experiment *chaosv1alpha1.ChaosExperiment) {
+				phases = append(phases, experiment.Status.Phase)
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome.Succeeded()).To(BeTrue())
+		Expect(c.deleted).To(BeTrue())
+		Expect(phases).To(Equal([]chaosv1alpha1.ExperimentPhase{chaosv1alpha1.ExperimentRunning, chaosv1alpha1.ExperimentCompleted}))
+	})
+
+	It("should delete the experiment when the wait times out", func() {
+		c := &stubClient{}
+		experiment := &chaosv1alpha1.ChaosExperiment{ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "demo"}}
+
+		_, err := Run(context.Background(), c, experiment, Options{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond})
+		Expect(err).To(MatchError(ContainSubstring("waiting for experiment checkout")))
+		Expect(c.deleted).To(BeTrue())
+	})
+})
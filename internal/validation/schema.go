@@ -0,0 +1,386 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation checks manifests of the custom resources of the operator
+// offline, the way the API server checks them against the schemas of their
+// CustomResourceDefinitions.
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/cel-go/cel"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// Schemas holds the schemas of the versions of custom resources.
+type Schemas struct {
+	schemas map[schema.GroupVersionKind]*apiextensionsv1.JSONSchemaProps
+	rules   map[string]cel.Program
+	env     *cel.Env
+}
+
+// Load reads the CustomResourceDefinitions among the YAML files in fsys.
+func Load(fsys fs.FS) (*Schemas, error) {
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	s := &Schemas{
+		schemas: map[schema.GroupVersionKind]*apiextensionsv1.JSONSchemaProps{},
+		rules:   map[string]cel.Program{},
+		env:     env,
+	}
+	paths, err := fs.Glob(fsys, "*/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	rootPaths, err := fs.Glob(fsys, "*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range append(rootPaths, paths...) {
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(data, crd); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", path, err)
+		}
+		if crd.Kind != "CustomResourceDefinition" {
+			continue
+		}
+		for _, version := range crd.Spec.Versions {
+			if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+				continue
+			}
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.Kind}
+			s.schemas[gvk] = version.Schema.OpenAPIV3Schema
+		}
+	}
+	return s, nil
+}
+
+// Knows reports whether there is a schema for gvk.
+func (s *Schemas) Knows(gvk schema.GroupVersionKind) bool {
+	return s.schemas[gvk] != nil
+}
+
+// Validate checks obj, a manifest decoded from JSON or YAML, against the
+// schema of its kind: it fills in the defaults of the schema like the API
+// server does, and reports unknown fields, values of the wrong type or out of
+// bounds, missing required fields and failing validation rules. Rules that
+// compare against oldSelf only apply to updates and are not checked.
+func (s *Schemas) Validate(obj map[string]any) field.ErrorList {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+	root := s.schemas[gvk]
+	if root == nil {
+		return field.ErrorList{field.NotSupported[string](field.NewPath("kind"), kind, nil)}
+	}
+	obj = normalize(obj).(map[string]any)
+
+	var errs field.ErrorList
+	metadata, _ := obj["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	generateName, _ := metadata["generateName"].(string)
+	switch {
+	case name == "" && generateName == "":
+		errs = append(errs, field.Required(field.NewPath("metadata", "name"), "name or generateName is required"))
+	case name != "":
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), name, msg))
+		}
+	}
+	for key, value := range obj {
+		if key == "apiVersion" || key == "kind" || key == "metadata" {
+			continue
+		}
+		property, ok := root.Properties[key]
+		if !ok {
+			errs = append(errs, field.Forbidden(field.NewPath(key), "unknown field"))
+			continue
+		}
+		errs = append(errs, s.validate(field.NewPath(key), &property, value)...)
+	}
+	return errs
+}
+
+// validate checks value against props.
+func (s *Schemas) validate(path *field.Path, props *apiextensionsv1.JSONSchemaProps, value any) field.ErrorList {
+	if value == nil {
+		// The API server drops nulls of fields that are not nullable.
+		return nil
+	}
+	var errs field.ErrorList
+	switch {
+	case props.XIntOrString:
+		switch value.(type) {
+		case int64, string:
+		default:
+			return field.ErrorList{field.Invalid(path, value, "must be an integer or a string")}
+		}
+	case props.Type == "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return field.ErrorList{field.Invalid(path, value, "must be of type object")}
+		}
+		errs = append(errs, s.validateObject(path, props, object)...)
+	case props.Type == "array":
+		array, ok := value.([]any)
+		if !ok {
+			return field.ErrorList{field.Invalid(path, value, "must be of type array")}
+		}
+		if props.MinItems != nil && int64(len(array)) < *props.MinItems {
+			errs = append(errs, field.Invalid(path, len(array), fmt.Sprintf("must have at least %d items", *props.MinItems)))
+		}
+		if props.MaxItems != nil && int64(len(array)) > *props.MaxItems {
+			errs = append(errs, field.TooMany(path, len(array), int(*props.MaxItems)))
+		}
+		if props.Items != nil && props.Items.Schema != nil {
+			for i, item := range array {
+				errs = append(errs, s.validate(path.Index(i), props.Items.Schema, item)...)
+			}
+		}
+	case props.Type == "string":
+		str, ok := value.(string)
+		if !ok {
+			return field.ErrorList{field.Invalid(path, value, "must be of type string")}
+		}
+		errs = append(errs, validateString(path, props, str)...)
+	case props.Type == "integer":
+		number, ok := value.(int64)
+		if !ok {
+			return field.ErrorList{field.Invalid(path, value, "must be of type integer")}
+		}
+		errs = append(errs, validateNumber(path, props, float64(number))...)
+	case props.Type == "number":
+		switch number := value.(type) {
+		case int64:
+			errs = append(errs, validateNumber(path, props, float64(number))...)
+		case float64:
+			errs = append(errs, validateNumber(path, props, number)...)
+		default:
+			return field.ErrorList{field.Invalid(path, value, "must be of type number")}
+		}
+	case props.Type == "boolean":
+		if _, ok := value.(bool); !ok {
+			return field.ErrorList{field.Invalid(path, value, "must be of type boolean")}
+		}
+	}
+	if len(props.Enum) > 0 {
+		errs = append(errs, validateEnum(path, props.Enum, value)...)
+	}
+	if len(errs) == 0 {
+		errs = append(errs, s.validateRules(path, props, value)...)
+	}
+	return errs
+}
+
+// validateObject checks the fields of an object and fills in their defaults.
+func (s *Schemas) validateObject(path *field.Path, props *apiextensionsv1.JSONSchemaProps, object map[string]any) field.ErrorList {
+	var errs field.ErrorList
+	for name, property := range props.Properties {
+		if _, ok := object[name]; !ok && property.Default != nil {
+			var value any
+			if err := json.Unmarshal(property.Default.Raw, &value); err == nil {
+				object[name] = normalize(value)
+			}
+		}
+	}
+	for _, name := range props.Required {
+		if _, ok := object[name]; !ok {
+			errs = append(errs, field.Required(path.Child(name), ""))
+		}
+	}
+	if props.MinProperties != nil && int64(len(object)) < *props.MinProperties {
+		errs = append(errs, field.Invalid(path, len(object), fmt.Sprintf("must have at least %d properties", *props.MinProperties)))
+	}
+	if props.MaxProperties != nil && int64(len(object)) > *props.MaxProperties {
+		errs = append(errs, field.TooMany(path, len(object), int(*props.MaxProperties)))
+	}
+	for key, value := range object {
+		if property, ok := props.Properties[key]; ok {
+			errs = append(errs, s.validate(path.Child(key), &property, value)...)
+			continue
+		}
+		switch {
+		case props.AdditionalProperties != nil && props.AdditionalProperties.Schema != nil:
+			errs = append(errs, s.validate(path.Key(key), props.AdditionalProperties.Schema, value)...)
+		case props.AdditionalProperties != nil && props.AdditionalProperties.Allows,
+			props.XPreserveUnknownFields != nil && *props.XPreserveUnknownFields,
+			props.XEmbeddedResource,
+			len(props.Properties) == 0:
+		default:
+			errs = append(errs, field.Forbidden(path.Child(key), "unknown field"))
+		}
+	}
+	return errs
+}
+
+// validateString checks the bounds, pattern and format of a string.
+func validateString(path *field.Path, props *apiextensionsv1.JSONSchemaProps, str string) field.ErrorList {
+	var errs field.ErrorList
+	length := int64(utf8.RuneCountInString(str))
+	if props.MinLength != nil && length < *props.MinLength {
+		errs = append(errs, field.Invalid(path, str, fmt.Sprintf("should be at least %d chars long", *props.MinLength)))
+	}
+	if props.MaxLength != nil && length > *props.MaxLength {
+		errs = append(errs, field.TooLong(path, str, int(*props.MaxLength)))
+	}
+	if props.Pattern != "" {
+		pattern, err := regexp.Compile(props.Pattern)
+		if err == nil && !pattern.MatchString(str) {
+			errs = append(errs, field.Invalid(path, str, fmt.Sprintf("should match '%s'", props.Pattern)))
+		}
+	}
+	if props.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			errs = append(errs, field.Invalid(path, str, "must be a date-time in RFC 3339 format"))
+		}
+	}
+	return errs
+}
+
+// validateNumber checks the bounds of a number.
+func validateNumber(path *field.Path, props *apiextensionsv1.JSONSchemaProps, number float64) field.ErrorList {
+	var errs field.ErrorList
+	if props.Minimum != nil && (number < *props.Minimum || props.ExclusiveMinimum && number == *props.Minimum) {
+		errs = append(errs, field.Invalid(path, number, fmt.Sprintf("should be greater than or equal to %v", *props.Minimum)))
+	}
+	if props.Maximum != nil && (number > *props.Maximum || props.ExclusiveMaximum && number == *props.Maximum) {
+		errs = append(errs, field.Invalid(path, number, fmt.Sprintf("should be less than or equal to %v", *props.Maximum)))
+	}
+	return errs
+}
+
+// validateEnum checks that value is one of the values of enum.
+func validateEnum(path *field.Path, enum []apiextensionsv1.JSON, value any) field.ErrorList {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	var supported []string
+	for _, allowed := range enum {
+		if string(allowed.Raw) == string(encoded) {
+			return nil
+		}
+		supported = append(supported, strings.Trim(string(allowed.Raw), `"`))
+	}
+	return field.ErrorList{field.NotSupported(path, value, supported)}
+}
+
+// validateRules evaluates the x-kubernetes-validations of props.
+func (s *Schemas) validateRules(path *field.Path, props *apiextensionsv1.JSONSchemaProps, value any) field.ErrorList {
+	var errs field.ErrorList
+	for _, rule := range props.XValidations {
+		if strings.Contains(rule.Rule, "oldSelf") {
+			continue
+		}
+		program, err := s.program(rule.Rule)
+		if err != nil {
+			errs = append(errs, field.InternalError(path, fmt.Errorf("compiling rule %q: %w", rule.Rule, err)))
+			continue
+		}
+		result, _, err := program.Eval(map[string]any{"self": value})
+		if err != nil {
+			errs = append(errs, field.InternalError(path, fmt.Errorf("evaluating rule %q: %w", rule.Rule, err)))
+			continue
+		}
+		if result.Value() == true {
+			continue
+		}
+		message := rule.Message
+		if message == "" {
+			message = fmt.Sprintf("failed rule: %s", rule.Rule)
+		}
+		rulePath := path
+		if rule.FieldPath != "" {
+			rulePath = field.NewPath(path.String() + rule.FieldPath)
+		}
+		errs = append(errs, field.Invalid(rulePath, typeOf(value), message))
+	}
+	return errs
+}
+
+// program returns the compiled program of a rule.
+func (s *Schemas) program(rule string) (cel.Program, error) {
+	if program, ok := s.rules[rule]; ok {
+		return program, nil
+	}
+	ast, issues := s.env.Compile(rule)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	program, err := s.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	s.rules[rule] = program
+	return program, nil
+}
+
+// normalize turns the numbers of a decoded manifest that are integral into
+// int64, so that they can be told apart from numbers with a fraction.
+func normalize(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, item := range value {
+			value[key] = normalize(item)
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = normalize(item)
+		}
+	case float64:
+		if value == float64(int64(value)) {
+			return int64(value)
+		}
+	}
+	return value
+}
+
+// typeOf names the type of value the way the API server does in errors of
+// validation rules.
+func typeOf(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case int64:
+		return "integer"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return "null"
+}
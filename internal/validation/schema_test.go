@@ -0,0 +1,154 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubechaos-operator/config/crd"
+)
+
+const widgetCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+  versions:
+  - name: v1
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          apiVersion:
+            type: string
+          kind:
+            type: string
+          metadata:
+            type: object
+          spec:
+            type: object
+            required: [size]
+            x-kubernetes-validations:
+            - rule: "!has(self.color) || self.size > 1"
+              message: only widgets larger than 1 have a color
+            properties:
+              size:
+                type: integer
+                minimum: 1
+              color:
+                type: string
+                enum: [red, blue]
+              shape:
+                type: string
+                default: round
+                pattern: ^[a-z]+$
+              labels:
+                type: object
+                additionalProperties:
+                  type: string
+`
+
+var _ = Describe("Schemas", func() {
+	var schemas *Schemas
+
+	BeforeEach(func() {
+		var err error
+		schemas, err = Load(fstest.MapFS{"bases/widgets.yaml": {Data: []byte(widgetCRD)}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	widget := func(spec map[string]any) map[string]any {
+		return map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata":   map[string]any{"name": "gear"},
+			"spec":       spec,
+		}
+	}
+
+	messages := func(errs field.ErrorList) []string {
+		var messages []string
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return messages
+	}
+
+	It("should accept valid objects and fill in defaults", func() {
+		obj := widget(map[string]any{"size": float64(2), "color": "red", "labels": map[string]any{"team": "checkout"}})
+		Expect(schemas.Validate(obj)).To(BeEmpty())
+		Expect(obj["spec"]).To(HaveKeyWithValue("shape", "round"))
+	})
+
+	It("should report what the API server rejects", func() {
+		errs := schemas.Validate(widget(map[string]any{
+			"size":   float64(0),
+			"color":  "green",
+			"shape":  "Square",
+			"weight": float64(3),
+			"labels": map[string]any{"team": float64(1)},
+		}))
+		Expect(messages(errs)).To(ConsistOf(
+			ContainSubstring("spec.size: Invalid value: 0: should be greater than or equal to 1"),
+			ContainSubstring(`spec.color: Unsupported value: "green"`),
+			ContainSubstring("spec.shape: Invalid value: \"Square\": should match '^[a-z]+$'"),
+			ContainSubstring("spec.weight: Forbidden: unknown field"),
+			ContainSubstring("spec.labels[team]: Invalid value: 1: must be of type string"),
+		))
+
+		Expect(messages(schemas.Validate(widget(map[string]any{"size": 1.5})))).To(ConsistOf(ContainSubstring("must be of type integer")))
+		Expect(messages(schemas.Validate(widget(map[string]any{})))).To(ConsistOf("spec.size: Required value"))
+	})
+
+	It("should evaluate validation rules", func() {
+		errs := schemas.Validate(widget(map[string]any{"size": float64(1), "color": "red"}))
+		Expect(messages(errs)).To(ConsistOf(`spec: Invalid value: "object": only widgets larger than 1 have a color`))
+	})
+
+	It("should require a valid name and a known kind", func() {
+		obj := widget(map[string]any{"size": float64(1)})
+		obj["metadata"] = map[string]any{"name": "Gear"}
+		Expect(messages(schemas.Validate(obj))).To(ConsistOf(ContainSubstring("metadata.name: Invalid value")))
+
+		obj["kind"] = "Gadget"
+		Expect(messages(schemas.Validate(obj))).To(ConsistOf(`kind: Unsupported value: "Gadget"`))
+	})
+
+	It("should load the CustomResourceDefinitions of the operator", func() {
+		schemas, err := Load(crd.Bases)
+		Expect(err).NotTo(HaveOccurred())
+		experiment := map[string]any{
+			"apiVersion": "chaos.shanto.dev/v1alpha1",
+			"kind":       "ChaosExperiment",
+			"metadata":   map[string]any{"name": "pod-kill"},
+			"spec": map[string]any{
+				"attack":   map[string]any{"type": "pod-kill"},
+				"target":   map[string]any{"namespace": "demo", "labelSelector": map[string]any{"app": "nginx"}},
+				"mode":     "one-shot",
+				"schedule": "*/5 * * * *",
+			},
+		}
+		Expect(messages(schemas.Validate(experiment))).To(ConsistOf(ContainSubstring("schedule requires mode recurring")))
+	})
+})
@@ -0,0 +1,168 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var chaosexperimentlog = logf.Log.WithName("chaosexperiment-resource")
+
+// ExperimentDefaults are the organization-wide defaults the operator applies
+// to the experiments created in the cluster. Unset fields are not defaulted.
+type ExperimentDefaults struct {
+	// Mode defaults spec.mode. Experiments without a mode are one-shot.
+	Mode chaosv1alpha1.ExperimentMode
+
+	// SelectionStrategy defaults spec.target.selectionStrategy. Experiments
+	// without a strategy pick their targets at random.
+	SelectionStrategy chaosv1alpha1.SelectionStrategy
+
+	// GracePeriodSeconds defaults spec.attack.podKill.gracePeriodSeconds.
+	GracePeriodSeconds *int64
+
+	// Safeguards defaults the fields of spec.safeguards.
+	Safeguards *chaosv1alpha1.Safeguards
+}
+
+// SetupChaosExperimentWebhookWithManager registers the webhook for ChaosExperiment in the manager.
+func SetupChaosExperimentWebhookWithManager(mgr ctrl.Manager, defaults ExperimentDefaults) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&chaosv1alpha1.ChaosExperiment{}).
+		WithDefaulter(&ChaosExperimentCustomDefaulter{Defaults: defaults, Client: mgr.GetClient()}).
+		WithValidator(&ChaosExperimentCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-chaos-shanto-dev-v1alpha1-chaosexperiment,mutating=true,failurePolicy=fail,sideEffects=None,groups=chaos.shanto.dev,resources=chaosexperiments,verbs=create;update,versions=v1alpha1,name=mchaosexperiment-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ChaosExperimentCustomDefaulter struct is responsible for setting default values on the custom resource of the
+// Kind ChaosExperiment when those are created or updated.
+type ChaosExperimentCustomDefaulter struct {
+	// Defaults are the defaults configured for the operator.
+	Defaults ExperimentDefaults
+
+	// Client reads the ChaosExperimentTemplates experiments instantiate.
+	Client client.Reader
+}
+
+var _ webhook.CustomDefaulter = &ChaosExperimentCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind ChaosExperiment.
+func (d *ChaosExperimentCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	chaosexperiment, ok := obj.(*chaosv1alpha1.ChaosExperiment)
+	if !ok {
+		return fmt.Errorf("expected an ChaosExperiment object but got %T", obj)
+	}
+	chaosexperimentlog.Info("Defaulting for ChaosExperiment", "name", chaosexperiment.GetName())
+
+	req, err := admission.RequestFromContext(ctx)
+	// Templates are instantiated once, when the experiment is created.
+	if chaosexperiment.Spec.TemplateRef != nil && (err != nil || req.Operation == admissionv1.Create) {
+		if err := d.instantiateTemplate(ctx, &chaosexperiment.Spec); err != nil {
+			return err
+		}
+	}
+	d.applyDefaults(&chaosexperiment.Spec)
+	if err == nil {
+		if err := recordCreator(chaosexperiment, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordCreator sets the CreatedByAnnotation to the user creating the
+// experiment, and restores the annotation of the stored experiment on updates,
+// so that the audit log can trust it.
+func recordCreator(experiment *chaosv1alpha1.ChaosExperiment, req admission.Request) error {
+	creator := req.UserInfo.Username
+	if req.Operation == admissionv1.Update {
+		old := &metav1.PartialObjectMetadata{}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return fmt.Errorf("decoding the stored ChaosExperiment: %w", err)
+		}
+		creator = old.Annotations[chaosv1alpha1.CreatedByAnnotation]
+	}
+	if creator == "" {
+		delete(experiment.Annotations, chaosv1alpha1.CreatedByAnnotation)
+		return nil
+	}
+	if experiment.Annotations == nil {
+		experiment.Annotations = map[string]string{}
+	}
+	experiment.Annotations[chaosv1alpha1.CreatedByAnnotation] = creator
+	return nil
+}
+
+// applyDefaults fills in the fields of the spec the user left empty.
+func (d *ChaosExperimentCustomDefaulter) applyDefaults(spec *chaosv1alpha1.ChaosExperimentSpec) {
+	defaults := d.Defaults
+
+	if spec.Mode == "" {
+		spec.Mode = defaults.Mode
+		if spec.Mode == "" {
+			spec.Mode = chaosv1alpha1.OneShotMode
+		}
+	}
+	if spec.Target.SelectionStrategy == "" {
+		spec.Target.SelectionStrategy = defaults.SelectionStrategy
+		if spec.Target.SelectionStrategy == "" {
+			spec.Target.SelectionStrategy = chaosv1alpha1.SelectRandom
+		}
+	}
+
+	if spec.Attack.Type == chaosv1alpha1.PodKillAttack {
+		if spec.Attack.PodKill == nil {
+			spec.Attack.PodKill = &chaosv1alpha1.PodKillAttackSpec{}
+		}
+		if spec.Attack.PodKill.DeletionMethod == "" {
+			spec.Attack.PodKill.DeletionMethod = chaosv1alpha1.DeletePod
+		}
+		if spec.Attack.PodKill.GracePeriodSeconds == nil && defaults.GracePeriodSeconds != nil {
+			spec.Attack.PodKill.GracePeriodSeconds = new(int64)
+			*spec.Attack.PodKill.GracePeriodSeconds = *defaults.GracePeriodSeconds
+		}
+	}
+
+	if safeguards := defaults.Safeguards; safeguards != nil {
+		if spec.Safeguards == nil {
+			spec.Safeguards = &chaosv1alpha1.Safeguards{}
+		}
+		if spec.Safeguards.MaxAffectedPercentage == nil && safeguards.MaxAffectedPercentage != nil {
+			spec.Safeguards.MaxAffectedPercentage = new(int32)
+			*spec.Safeguards.MaxAffectedPercentage = *safeguards.MaxAffectedPercentage
+		}
+		if spec.Safeguards.Window == nil && safeguards.Window != nil {
+			spec.Safeguards.Window = &metav1.Duration{Duration: safeguards.Window.Duration}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+var _ = Describe("ChaosExperiment Webhook", func() {
+	var (
+		obj       *chaosv1alpha1.ChaosExperiment
+		defaulter ChaosExperimentCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack},
+		}}
+		defaulter = ChaosExperimentCustomDefaulter{}
+	})
+
+	Context("When creating ChaosExperiment under Defaulting Webhook", func() {
+		It("Should apply the built-in defaults to a minimal experiment", func() {
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.Mode).To(Equal(chaosv1alpha1.OneShotMode))
+			Expect(obj.Spec.Target.SelectionStrategy).To(Equal(chaosv1alpha1.SelectRandom))
+			Expect(obj.Spec.Attack.PodKill).NotTo(BeNil())
+			Expect(obj.Spec.Attack.PodKill.DeletionMethod).To(Equal(chaosv1alpha1.DeletePod))
+			Expect(obj.Spec.Attack.PodKill.GracePeriodSeconds).To(BeNil())
+			Expect(obj.Spec.Safeguards).To(BeNil())
+		})
+
+		It("Should apply the defaults configured for the operator", func() {
+			defaulter.Defaults = ExperimentDefaults{
+				Mode:               chaosv1alpha1.RecurringMode,
+				SelectionStrategy:  chaosv1alpha1.SelectRoundRobin,
+				GracePeriodSeconds: ptr.To[int64](0),
+				Safeguards: &chaosv1alpha1.Safeguards{
+					MaxAffectedPercentage: ptr.To[int32](25),
+					Window:                &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			}
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.Mode).To(Equal(chaosv1alpha1.RecurringMode))
+			Expect(obj.Spec.Target.SelectionStrategy).To(Equal(chaosv1alpha1.SelectRoundRobin))
+			Expect(obj.Spec.Attack.PodKill.GracePeriodSeconds).To(HaveValue(BeEquivalentTo(0)))
+			Expect(obj.Spec.Safeguards.MaxAffectedPercentage).To(HaveValue(BeEquivalentTo(25)))
+			Expect(obj.Spec.Safeguards.Window.Duration).To(Equal(2 * time.Hour))
+
+			// The configured defaults are copies, not shared with the experiment.
+			*obj.Spec.Safeguards.MaxAffectedPercentage = 50
+			Expect(*defaulter.Defaults.Safeguards.MaxAffectedPercentage).To(BeEquivalentTo(25))
+		})
+
+		It("Should keep the values set by the user", func() {
+			obj.Spec.Mode = chaosv1alpha1.OneShotMode
+			obj.Spec.Attack.PodKill = &chaosv1alpha1.PodKillAttackSpec{GracePeriodSeconds: ptr.To[int64](30)}
+			obj.Spec.Safeguards = &chaosv1alpha1.Safeguards{MaxAffectedPercentage: ptr.To[int32](10)}
+			defaulter.Defaults = ExperimentDefaults{
+				Mode:               chaosv1alpha1.RecurringMode,
+				GracePeriodSeconds: ptr.To[int64](0),
+				Safeguards:         &chaosv1alpha1.Safeguards{MaxAffectedPercentage: ptr.To[int32](25)},
+			}
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.Mode).To(Equal(chaosv1alpha1.OneShotMode))
+			Expect(obj.Spec.Attack.PodKill.GracePeriodSeconds).To(HaveValue(BeEquivalentTo(30)))
+			Expect(obj.Spec.Safeguards.MaxAffectedPercentage).To(HaveValue(BeEquivalentTo(10)))
+		})
+
+		It("Should record the user creating the experiment", func() {
+			obj.Annotations = map[string]string{chaosv1alpha1.CreatedByAnnotation: "mallory"}
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				UserInfo:  authenticationv1.UserInfo{Username: "alice"},
+			}})
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Annotations).To(HaveKeyWithValue(chaosv1alpha1.CreatedByAnnotation, "alice"))
+		})
+
+		It("Should keep the recorded creator on updates", func() {
+			obj.Annotations = map[string]string{chaosv1alpha1.CreatedByAnnotation: "mallory"}
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  authenticationv1.UserInfo{Username: "mallory"},
+				OldObject: runtime.RawExtension{Raw: []byte(`{"metadata":{"annotations":{"chaos.shanto.dev/created-by":"alice"}}}`)},
+			}})
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Annotations).To(HaveKeyWithValue(chaosv1alpha1.CreatedByAnnotation, "alice"))
+		})
+	})
+})
@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-chaos-shanto-dev-v1alpha1-chaosexperiment,mutating=false,failurePolicy=fail,sideEffects=None,groups=chaos.shanto.dev,resources=chaosexperiments,verbs=create;update,versions=v1alpha1,name=vchaosexperiment-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ChaosExperimentCustomValidator rejects experiments that violate one of the
+// ChaosPolicies covering their target namespace.
+type ChaosExperimentCustomValidator struct {
+	// Client reads the ChaosPolicies and the target namespaces.
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &ChaosExperimentCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ChaosExperiment.
+func (v *ChaosExperimentCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	experiment, ok := obj.(*chaosv1alpha1.ChaosExperiment)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChaosExperiment object but got %T", obj)
+	}
+	return nil, v.checkPolicies(ctx, experiment)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ChaosExperiment.
+// Only spec changes are checked, so that experiments admitted before a policy
+// was tightened can still be annotated, e.g. to abort them, and deleted.
+func (v *ChaosExperimentCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*chaosv1alpha1.ChaosExperiment)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChaosExperiment object for the oldObj but got %T", oldObj)
+	}
+	experiment, ok := newObj.(*chaosv1alpha1.ChaosExperiment)
+	if !ok {
+		return nil, fmt.Errorf("expected a ChaosExperiment object for the newObj but got %T", newObj)
+	}
+	if equality.Semantic.DeepEqual(old.Spec, experiment.Spec) {
+		return nil, nil
+	}
+	return nil, v.checkPolicies(ctx, experiment)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ChaosExperiment.
+func (v *ChaosExperimentCustomValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// checkPolicies returns an error listing how the experiment violates the
+// ChaosPolicies covering its target namespace. The windows of the policies
+// are left to the controller.
+func (v *ChaosExperimentCustomValidator) checkPolicies(ctx context.Context, experiment *chaosv1alpha1.ChaosExperiment) error {
+	var policies chaosv1alpha1.ChaosPolicyList
+	if err := v.Client.List(ctx, &policies); err != nil {
+		return fmt.Errorf("listing ChaosPolicies: %w", err)
+	}
+	if len(policies.Items) == 0 {
+		return nil
+	}
+	namespace := &corev1.Namespace{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: experiment.Spec.Target.Namespace}, namespace); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("getting namespace %q: %w", experiment.Spec.Target.Namespace, err)
+	}
+
+	var violations []string
+	for _, policy := range policies.Items {
+		if !policy.Covers(namespace.Labels) {
+			continue
+		}
+		for _, violation := range policy.Violations(&experiment.Spec) {
+			violations = append(violations, fmt.Sprintf("ChaosPolicy %s: %s", policy.Name, violation))
+		}
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("the experiment violates the chaos policies of namespace %s: %s", experiment.Spec.Target.Namespace, strings.Join(violations, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// policyReader serves ChaosPolicies and the labels of namespaces.
+type policyReader struct {
+	client.Reader
+	policies   []chaosv1alpha1.ChaosPolicy
+	namespaces map[string]map[string]string
+}
+
+func (r policyReader) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	list.(*chaosv1alpha1.ChaosPolicyList).Items = r.policies
+	return nil
+}
+
+func (r policyReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	obj.(*corev1.Namespace).Labels = r.namespaces[key.Name]
+	return nil
+}
+
+var _ = Describe("ChaosExperiment Validating Webhook", func() {
+	var validator ChaosExperimentCustomValidator
+	var experiment *chaosv1alpha1.ChaosExperiment
+
+	BeforeEach(func() {
+		validator = ChaosExperimentCustomValidator{Client: policyReader{
+			policies: []chaosv1alpha1.ChaosPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "payments"},
+				Spec: chaosv1alpha1.ChaosPolicySpec{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+					AllowedAttacks:    []chaosv1alpha1.AttackType{chaosv1alpha1.PodKillAttack},
+					MaxPercentage:     ptr.To[int32](25),
+				},
+			}},
+			namespaces: map[string]map[string]string{"shop": {"team": "payments"}},
+		}}
+		experiment = &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			Target: chaosv1alpha1.ExperimentTarget{Namespace: "shop", Percentage: ptr.To[int32](20)},
+			Attack: chaosv1alpha1.ExperimentAttack{Type: chaosv1alpha1.PodKillAttack},
+		}}
+	})
+
+	It("Should admit experiments the covering policies permit", func() {
+		_, err := validator.ValidateCreate(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+
+		experiment.Spec.Target.Namespace = "search"
+		experiment.Spec.Attack.Type = chaosv1alpha1.NodeTaintAttack
+		_, err = validator.ValidateCreate(context.Background(), experiment)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should reject experiments that violate a covering policy", func() {
+		experiment.Spec.Attack.Type = chaosv1alpha1.NodeTaintAttack
+		experiment.Spec.Target.Percentage = ptr.To[int32](50)
+		_, err := validator.ValidateCreate(context.Background(), experiment)
+		Expect(err).To(MatchError("the experiment violates the chaos policies of namespace shop: " +
+			"ChaosPolicy payments: attack type node-taint is not allowed; ChaosPolicy payments: target.percentage 50 exceeds 25"))
+	})
+
+	It("Should only check updates that change the spec", func() {
+		old := experiment.DeepCopy()
+		old.Spec.Target.Percentage = ptr.To[int32](50)
+		updated := old.DeepCopy()
+		updated.Annotations = map[string]string{chaosv1alpha1.AbortAnnotation: "stop"}
+		_, err := validator.ValidateUpdate(context.Background(), old, updated)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = validator.ValidateUpdate(context.Background(), experiment, old)
+		Expect(err).To(MatchError(ContainSubstring("target.percentage 50 exceeds 25")))
+	})
+})
@@ -0,0 +1,240 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=chaos.shanto.dev,resources=chaosexperimenttemplates,verbs=get;list;watch
+
+// parameterReference matches a reference to a template parameter.
+var parameterReference = regexp.MustCompile(`\$\(([a-zA-Z][a-zA-Z0-9_]*)\)`)
+
+// instantiateTemplate fills in the fields of the spec left empty from the
+// ChaosExperimentTemplate it refers to.
+func (d *ChaosExperimentCustomDefaulter) instantiateTemplate(ctx context.Context, spec *chaosv1alpha1.ChaosExperimentSpec) error {
+	if d.Client == nil {
+		return fmt.Errorf("templates are not available")
+	}
+	template := &chaosv1alpha1.ChaosExperimentTemplate{}
+	if err := d.Client.Get(ctx, types.NamespacedName{Name: spec.TemplateRef.Name}, template); err != nil {
+		return fmt.Errorf("getting ChaosExperimentTemplate %q: %w", spec.TemplateRef.Name, err)
+	}
+	instance, err := instantiate(template, spec)
+	if err != nil {
+		return fmt.Errorf("instantiating ChaosExperimentTemplate %q: %w", template.Name, err)
+	}
+	*spec = *instance
+	return nil
+}
+
+// instantiate renders the template with the parameter values of the spec and
+// overlays the fields the spec sets.
+func instantiate(template *chaosv1alpha1.ChaosExperimentTemplate, spec *chaosv1alpha1.ChaosExperimentSpec) (*chaosv1alpha1.ChaosExperimentSpec, error) {
+	values, err := parameterValues(template.Spec.Parameters, spec.TemplateRef.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	var experiment any
+	if err := json.Unmarshal(template.Spec.Experiment.Raw, &experiment); err != nil {
+		return nil, fmt.Errorf("invalid experiment: %w", err)
+	}
+	if experiment, err = substitute(experiment, values); err != nil {
+		return nil, err
+	}
+	rendered, err := json.Marshal(experiment)
+	if err != nil {
+		return nil, err
+	}
+	instance := &chaosv1alpha1.ChaosExperimentSpec{}
+	decoder := json.NewDecoder(bytes.NewReader(rendered))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(instance); err != nil {
+		return nil, fmt.Errorf("invalid experiment: %w", err)
+	}
+
+	// Fields set in the experiment take precedence over the template.
+	own, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var fields any
+	if err := json.Unmarshal(own, &fields); err != nil {
+		return nil, err
+	}
+	if own, err = json.Marshal(pruneEmpty(fields)); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(own, instance); err != nil {
+		return nil, err
+	}
+	instance.TemplateRef = spec.TemplateRef
+	return instance, nil
+}
+
+// parameterValues checks the values given for the parameters against their
+// declarations and returns the value of every parameter, as an int64 for
+// Integer parameters and as a string otherwise.
+func parameterValues(parameters []chaosv1alpha1.TemplateParameter, given map[string]intstr.IntOrString) (map[string]any, error) {
+	declared := map[string]bool{}
+	values := map[string]any{}
+	for _, parameter := range parameters {
+		declared[parameter.Name] = true
+		value, ok := given[parameter.Name]
+		if !ok {
+			if parameter.Default == nil {
+				return nil, fmt.Errorf("parameter %q is required", parameter.Name)
+			}
+			value = intstr.FromString(*parameter.Default)
+		}
+		typed, err := parameterValue(parameter, value)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", parameter.Name, err)
+		}
+		values[parameter.Name] = typed
+	}
+	for name := range given {
+		if !declared[name] {
+			return nil, fmt.Errorf("unknown parameter %q", name)
+		}
+	}
+	return values, nil
+}
+
+// parameterValue converts a value to the type of the parameter.
+func parameterValue(parameter chaosv1alpha1.TemplateParameter, value intstr.IntOrString) (any, error) {
+	switch parameter.Type {
+	case chaosv1alpha1.IntegerParameter:
+		n := int64(value.IntVal)
+		if value.Type == intstr.String {
+			var err error
+			if n, err = strconv.ParseInt(value.StrVal, 10, 64); err != nil {
+				return nil, fmt.Errorf("%q is not an integer", value.StrVal)
+			}
+		}
+		if parameter.Minimum != nil && n < *parameter.Minimum {
+			return nil, fmt.Errorf("%d is less than the minimum %d", n, *parameter.Minimum)
+		}
+		if parameter.Maximum != nil && n > *parameter.Maximum {
+			return nil, fmt.Errorf("%d is more than the maximum %d", n, *parameter.Maximum)
+		}
+		return n, nil
+	case chaosv1alpha1.DurationParameter:
+		if _, err := time.ParseDuration(value.String()); err != nil {
+			return nil, fmt.Errorf("%q is not a duration", value.String())
+		}
+		return value.String(), nil
+	default:
+		return value.String(), nil
+	}
+}
+
+// substitute replaces the parameter references in the strings of a decoded
+// JSON document. A string that is only a reference takes the type of the
+// value.
+func substitute(node any, values map[string]any) (any, error) {
+	switch node := node.(type) {
+	case string:
+		var err error
+		if match := parameterReference.FindStringSubmatch(node); match != nil && match[0] == node {
+			value, ok := values[match[1]]
+			if !ok {
+				return nil, fmt.Errorf("unknown parameter %q", match[1])
+			}
+			return value, nil
+		}
+		substituted := parameterReference.ReplaceAllStringFunc(node, func(reference string) string {
+			name := parameterReference.FindStringSubmatch(reference)[1]
+			value, ok := values[name]
+			if !ok {
+				err = fmt.Errorf("unknown parameter %q", name)
+			}
+			return fmt.Sprint(value)
+		})
+		return substituted, err
+	case map[string]any:
+		for key, value := range node {
+			substituted, err := substitute(value, values)
+			if err != nil {
+				return nil, err
+			}
+			node[key] = substituted
+		}
+		return node, nil
+	case []any:
+		for i, value := range node {
+			substituted, err := substitute(value, values)
+			if err != nil {
+				return nil, err
+			}
+			node[i] = substituted
+		}
+		return node, nil
+	default:
+		return node, nil
+	}
+}
+
+// pruneEmpty drops empty strings, false, zero numbers and empty objects and
+// lists from a decoded JSON document, so that only the fields that are set
+// remain.
+func pruneEmpty(node any) any {
+	switch node := node.(type) {
+	case map[string]any:
+		for key, value := range node {
+			if value = pruneEmpty(value); value == nil {
+				delete(node, key)
+			} else {
+				node[key] = value
+			}
+		}
+		if len(node) == 0 {
+			return nil
+		}
+		return node
+	case []any:
+		if len(node) == 0 {
+			return nil
+		}
+		return node
+	case string:
+		if node == "" {
+			return nil
+		}
+	case bool:
+		if !node {
+			return nil
+		}
+	case float64:
+		if node == 0 {
+			return nil
+		}
+	}
+	return node
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	chaosv1alpha1 "kubechaos-operator/api/v1alpha1"
+)
+
+// templateReader serves a fixed set of ChaosExperimentTemplates.
+type templateReader struct {
+	client.Reader
+	templates map[string]*chaosv1alpha1.ChaosExperimentTemplate
+}
+
+func (r templateReader) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	template, ok := r.templates[key.Name]
+	if !ok {
+		return apierrors.NewNotFound(chaosv1alpha1.GroupVersion.WithResource("chaosexperimenttemplates").GroupResource(), key.Name)
+	}
+	template.DeepCopyInto(obj.(*chaosv1alpha1.ChaosExperimentTemplate))
+	return nil
+}
+
+var _ = Describe("ChaosExperimentTemplate instantiation", func() {
+	var defaulter ChaosExperimentCustomDefaulter
+
+	BeforeEach(func() {
+		defaulter = ChaosExperimentCustomDefaulter{Client: templateReader{templates: map[string]*chaosv1alpha1.ChaosExperimentTemplate{
+			"kill-some-pods": {
+				ObjectMeta: metav1.ObjectMeta{Name: "kill-some-pods"},
+				Spec: chaosv1alpha1.ChaosExperimentTemplateSpec{
+					Parameters: []chaosv1alpha1.TemplateParameter{
+						{Name: "namespace", Type: chaosv1alpha1.StringParameter},
+						{Name: "percentage", Type: chaosv1alpha1.IntegerParameter, Default: ptr.To("10"), Minimum: ptr.To[int64](1), Maximum: ptr.To[int64](50)},
+						{Name: "duration", Type: chaosv1alpha1.DurationParameter, Default: ptr.To("10m")},
+					},
+					Experiment: runtime.RawExtension{Raw: []byte(`{
+						"target": {"namespace": "$(namespace)", "labelSelector": {"app": "$(namespace)-web"}, "percentage": "$(percentage)"},
+						"attack": {"type": "pod-kill"},
+						"mode": "recurring",
+						"interval": "1m",
+						"duration": "$(duration)"
+					}`)},
+				},
+			},
+		}}}
+	})
+
+	experiment := func(parameters map[string]intstr.IntOrString) *chaosv1alpha1.ChaosExperiment {
+		return &chaosv1alpha1.ChaosExperiment{Spec: chaosv1alpha1.ChaosExperimentSpec{
+			TemplateRef: &chaosv1alpha1.ExperimentTemplateRef{Name: "kill-some-pods", Parameters: parameters},
+		}}
+	}
+
+	It("should fill in the spec with the parameter values substituted", func() {
+		obj := experiment(map[string]intstr.IntOrString{"namespace": intstr.FromString("shop"), "percentage": intstr.FromInt32(25)})
+		Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+		Expect(obj.Spec.Target.Namespace).To(Equal("shop"))
+		Expect(obj.Spec.Target.LabelSelector).To(Equal(map[string]string{"app": "shop-web"}))
+		Expect(obj.Spec.Target.Percentage).To(HaveValue(BeEquivalentTo(25)))
+		Expect(obj.Spec.Attack.Type).To(Equal(chaosv1alpha1.PodKillAttack))
+		Expect(obj.Spec.Attack.PodKill).NotTo(BeNil(), "defaults apply after instantiation")
+		Expect(obj.Spec.Duration.Duration).To(Equal(10 * time.Minute))
+		Expect(obj.Spec.TemplateRef.Name).To(Equal("kill-some-pods"))
+	})
+
+	It("should keep the fields set in the experiment", func() {
+		obj := experiment(map[string]intstr.IntOrString{"namespace": intstr.FromString("shop")})
+		obj.Spec.Mode = chaosv1alpha1.OneShotMode
+		obj.Spec.Target.Percentage = ptr.To[int32](5)
+		Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+		Expect(obj.Spec.Mode).To(Equal(chaosv1alpha1.OneShotMode))
+		Expect(obj.Spec.Target.Namespace).To(Equal("shop"))
+		Expect(obj.Spec.Target.Percentage).To(HaveValue(BeEquivalentTo(5)))
+	})
+
+	It("should reject invalid parameter values", func() {
+		for _, parameters := range []map[string]intstr.IntOrString{
+			{},
+			{"namespace": intstr.FromString("shop"), "percentage": intstr.FromInt32(80)},
+			{"namespace": intstr.FromString("shop"), "percentage": intstr.FromString("many")},
+			{"namespace": intstr.FromString("shop"), "duration": intstr.FromString("soon")},
+			{"namespace": intstr.FromString("shop"), "color": intstr.FromString("red")},
+		} {
+			Expect(defaulter.Default(context.Background(), experiment(parameters))).NotTo(Succeed(), "%v", parameters)
+		}
+	})
+
+	It("should reject unknown templates", func() {
+		obj := experiment(nil)
+		obj.Spec.TemplateRef.Name = "missing"
+		Expect(defaulter.Default(context.Background(), obj)).To(MatchError(ContainSubstring("missing")))
+	})
+})